@@ -10,14 +10,39 @@ import (
 	"syscall"
 	"time"
 
+	"soccer-manager-api/internal/app/academy"
+	"soccer-manager-api/internal/app/account"
+	"soccer-manager-api/internal/app/admin"
 	"soccer-manager-api/internal/app/auth"
+	"soccer-manager-api/internal/app/availability"
+	"soccer-manager-api/internal/app/bot"
+	"soccer-manager-api/internal/app/contract"
+	"soccer-manager-api/internal/app/draft"
+	"soccer-manager-api/internal/app/facility"
+	"soccer-manager-api/internal/app/finance"
+	"soccer-manager-api/internal/app/league"
+	"soccer-manager-api/internal/app/lineup"
+	"soccer-manager-api/internal/app/match"
+	"soccer-manager-api/internal/app/morale"
 	"soccer-manager-api/internal/app/player"
+	"soccer-manager-api/internal/app/ranking"
+	"soccer-manager-api/internal/app/scouting"
+	"soccer-manager-api/internal/app/season"
+	"soccer-manager-api/internal/app/stats"
 	"soccer-manager-api/internal/app/team"
+	"soccer-manager-api/internal/app/training"
 	"soccer-manager-api/internal/app/transfer"
+	"soccer-manager-api/internal/domain"
+	infraCache "soccer-manager-api/internal/infrastructure/cache"
+	memoryCache "soccer-manager-api/internal/infrastructure/cache/memory"
 	redisCache "soccer-manager-api/internal/infrastructure/cache/redis"
 	"soccer-manager-api/internal/infrastructure/config"
+	fileMailer "soccer-manager-api/internal/infrastructure/mailer/file"
+	smtpMailer "soccer-manager-api/internal/infrastructure/mailer/smtp"
 	"soccer-manager-api/internal/infrastructure/persistence/postgres"
+	"soccer-manager-api/internal/infrastructure/scheduler"
 	httpTransport "soccer-manager-api/internal/infrastructure/transport/http"
+	"soccer-manager-api/pkg/jwt"
 	"soccer-manager-api/pkg/logger"
 
 	"github.com/jmoiron/sqlx"
@@ -69,20 +94,92 @@ func main() {
 	teamRepo := postgres.NewTeamRepository(db)
 	playerRepo := postgres.NewPlayerRepository(db)
 	transferRepo := postgres.NewTransferRepository(db)
+	lineupRepo := postgres.NewLineupRepository(db)
+	seasonRepo := postgres.NewSeasonRepository(db)
+	academyRepo := postgres.NewAcademyRepository(db)
+	trainingRepo := postgres.NewTrainingRepository(db)
+	absenceRepo := postgres.NewAbsenceRepository(db)
+	contractRepo := postgres.NewContractRepository(db)
+	financeRepo := postgres.NewFinanceRepository(db)
+	sponsorshipRepo := postgres.NewSponsorshipRepository(db)
+	matchRepo := postgres.NewMatchRepository(db)
+	facilityRepo := postgres.NewFacilityRepository(db)
+	statsRepo := postgres.NewStatsRepository(db)
+	moraleRepo := postgres.NewMoraleRepository(db)
+	ratingRepo := postgres.NewRatingRepository(db)
+	leagueRepo := postgres.NewLeagueRepository(db)
+	draftRepo := postgres.NewDraftRepository(db)
+	scoutingRepo := postgres.NewScoutingRepository(db)
+	sessionRepo := postgres.NewSessionRepository(db)
+	emailTokenRepo := postgres.NewEmailTokenRepository(db)
+	auditRepo := postgres.NewAuditRepository(db)
+	accountRepo := postgres.NewAccountRepository(db)
+	recoveryCodeRepo := postgres.NewRecoveryCodeRepository(db)
+	jobRunRepo := postgres.NewJobRunRepository(db)
+	transactor := postgres.NewTransactor(db)
+
+	jwtKeys := jwt.NewHMACKeySet(cfg.JWT.Secret)
+	if cfg.JWT.KeysDir != "" {
+		jwtKeys, err = jwt.LoadKeySet(cfg.JWT.KeysDir, cfg.JWT.SigningKeyID)
+		if err != nil {
+			logger.Logger.Fatal("Failed to load JWT keys", zap.Error(err))
+		}
+		logger.Logger.Info("Loaded JWT keys", zap.String("signing_key_id", jwtKeys.SigningKey().ID))
+	}
+
+	mailer := fileMailer.NewFileMailer(cfg.Mail.FilePath)
+	if cfg.Mail.Driver == "smtp" {
+		mailer = smtpMailer.NewSMTPMailer(cfg.Mail.SMTPHost, cfg.Mail.SMTPPort, cfg.Mail.SMTPUsername, cfg.Mail.SMTPPassword, cfg.Mail.From)
+	}
 
 	cache := redisCache.NewRedisCache(rdb)
+	revocationCache := cache
+	if cfg.JWT.RevocationStore == "memory" {
+		revocationCache = memoryCache.NewMemoryCache()
+	}
+	revocations := infraCache.NewRevocationStore(revocationCache, time.Duration(cfg.JWT.AccessTokenMinutes)*time.Minute)
+	challenges := infraCache.NewChallengeStore(revocationCache, domain.TwoFactorChallengeTTL)
+	loginThrottle := infraCache.NewLoginThrottle(revocationCache, cfg.Login.MaxAttempts, cfg.Login.MaxAttemptsPerIP, time.Duration(cfg.Login.LockoutMinutes)*time.Minute)
 
 	authUseCase := auth.NewAuthUseCase(
 		userRepo,
 		teamRepo,
 		playerRepo,
-		cfg.JWT.Secret,
-		cfg.JWT.ExpirationHours,
+		contractRepo,
+		draftRepo,
+		sessionRepo,
+		recoveryCodeRepo,
+		transactor,
+		revocations,
+		challenges,
+		loginThrottle,
+		jwtKeys,
+		cfg.JWT.AccessTokenMinutes,
+		cfg.JWT.RefreshTokenDays,
+		cfg.JWT.SessionMaxDays,
 	)
 
-	teamUseCase := team.NewTeamUseCase(teamRepo, playerRepo, cache)
-	playerUseCase := player.NewPlayerUseCase(playerRepo, teamRepo, cache)
-	transferUseCase := transfer.NewTransferUseCase(transferRepo, teamRepo, playerRepo, cache)
+	accountUseCase := account.NewAccountUseCase(userRepo, emailTokenRepo, auditRepo, accountRepo, recoveryCodeRepo, teamRepo, transactor, authUseCase, mailer, cache, cfg.App.BaseURL, cfg.App.DeletionGraceDays)
+	adminUseCase := admin.NewAdminUseCase(userRepo, teamRepo, playerRepo, transferRepo, lineupRepo, contractRepo, financeRepo, auditRepo, transactor, authUseCase, cache)
+	teamUseCase := team.NewTeamUseCase(teamRepo, playerRepo, authUseCase, cache)
+	scoutingUseCase := scouting.NewScoutingUseCase(scoutingRepo, teamRepo, playerRepo, financeRepo, transactor, cache)
+	playerUseCase := player.NewPlayerUseCase(playerRepo, teamRepo, statsRepo, scoutingUseCase, cache)
+	moraleUseCase := morale.NewMoraleUseCase(moraleRepo, playerRepo, teamRepo, transferRepo, cache)
+	transferUseCase := transfer.NewTransferUseCase(transferRepo, teamRepo, playerRepo, lineupRepo, contractRepo, leagueRepo, financeRepo, transactor, moraleUseCase, scoutingUseCase, cache)
+	lineupUseCase := lineup.NewLineupUseCase(lineupRepo, teamRepo, playerRepo, cache)
+	seasonUseCase := season.NewSeasonUseCase(seasonRepo, playerRepo, transferRepo, lineupRepo, contractRepo, transactor, scoutingUseCase, cache)
+	academyUseCase := academy.NewAcademyUseCase(academyRepo, teamRepo, playerRepo, contractRepo, transactor, cache)
+	trainingUseCase := training.NewTrainingUseCase(trainingRepo, teamRepo, playerRepo, facilityRepo, scoutingUseCase, cache)
+	availabilityUseCase := availability.NewAvailabilityUseCase(absenceRepo, playerRepo, lineupRepo, facilityRepo, cache)
+	contractUseCase := contract.NewContractUseCase(contractRepo, financeRepo, teamRepo, playerRepo, transferRepo, lineupRepo, transactor, moraleUseCase, scoutingUseCase, cache)
+	financeUseCase := finance.NewFinanceUseCase(financeRepo, sponsorshipRepo, matchRepo, contractRepo, facilityRepo, teamRepo, transactor, cache)
+	statsUseCase := stats.NewStatsUseCase(statsRepo, matchRepo, seasonRepo, lineupRepo, teamRepo, playerRepo, cache)
+	rankingUseCase := ranking.NewRankingUseCase(teamRepo, ratingRepo, matchRepo, transactor, cache)
+	leagueUseCase := league.NewLeagueUseCase(leagueRepo, teamRepo, cache)
+	draftUseCase := draft.NewDraftUseCase(draftRepo, teamRepo, playerRepo, contractRepo, transactor, cache)
+	matchUseCase := match.NewMatchUseCase(matchRepo, teamRepo, facilityRepo, transactor, financeUseCase, availabilityUseCase, statsUseCase, moraleUseCase, rankingUseCase)
+	facilityUseCase := facility.NewFacilityUseCase(facilityRepo, academyRepo, financeRepo, teamRepo, transactor, cache)
+	botUseCase := bot.NewBotUseCase(userRepo, teamRepo, playerRepo, transferRepo, lineupRepo, contractRepo, matchRepo, transactor, authUseCase, lineupUseCase, transferUseCase, cache)
 
 	router := httpTransport.SetupRouter(
 		cfg,
@@ -90,6 +187,26 @@ func main() {
 		teamUseCase,
 		playerUseCase,
 		transferUseCase,
+		lineupUseCase,
+		seasonUseCase,
+		academyUseCase,
+		trainingUseCase,
+		availabilityUseCase,
+		contractUseCase,
+		financeUseCase,
+		matchUseCase,
+		facilityUseCase,
+		statsUseCase,
+		botUseCase,
+		moraleUseCase,
+		rankingUseCase,
+		leagueUseCase,
+		draftUseCase,
+		scoutingUseCase,
+		revocations,
+		jwtKeys,
+		accountUseCase,
+		adminUseCase,
 	)
 
 	addr := fmt.Sprintf("%s:%s", cfg.Server.Host, cfg.Server.Port)
@@ -98,6 +215,61 @@ func main() {
 		Handler: router,
 	}
 
+	jobs := scheduler.New(jobRunRepo)
+	jobs.Every("season_rollover", time.Duration(cfg.Jobs.SeasonRolloverIntervalHours)*time.Hour, func(ctx context.Context) error {
+		_, err := seasonUseCase.Rollover(ctx)
+		return err
+	})
+	jobs.Every("academy_intake", time.Duration(cfg.Jobs.AcademyIntakeIntervalHours)*time.Hour, func(ctx context.Context) error {
+		_, err := academyUseCase.RunIntake(ctx)
+		return err
+	})
+	jobs.Every("training", time.Duration(cfg.Jobs.TrainingIntervalHours)*time.Hour, func(ctx context.Context) error {
+		_, err := trainingUseCase.RunTraining(ctx)
+		return err
+	})
+	jobs.Every("recovery", time.Duration(cfg.Jobs.RecoveryIntervalHours)*time.Hour, func(ctx context.Context) error {
+		_, err := availabilityUseCase.RunRecovery(ctx)
+		return err
+	})
+	jobs.Every("payroll", time.Duration(cfg.Jobs.PayrollIntervalHours)*time.Hour, func(ctx context.Context) error {
+		_, err := contractUseCase.RunPayroll(ctx)
+		return err
+	})
+	jobs.Every("contract_expiry", time.Duration(cfg.Jobs.ContractExpiryIntervalHours)*time.Hour, func(ctx context.Context) error {
+		_, err := contractUseCase.RunExpiry(ctx)
+		return err
+	})
+	jobs.Every("sponsorship_payments", time.Duration(cfg.Jobs.SponsorshipIntervalHours)*time.Hour, func(ctx context.Context) error {
+		_, err := financeUseCase.RunSponsorshipPayments(ctx)
+		return err
+	})
+	jobs.Every("construction", time.Duration(cfg.Jobs.ConstructionIntervalHours)*time.Hour, func(ctx context.Context) error {
+		_, err := facilityUseCase.RunConstruction(ctx)
+		return err
+	})
+	jobs.Every("bots", time.Duration(cfg.Jobs.BotIntervalHours)*time.Hour, func(ctx context.Context) error {
+		_, err := botUseCase.RunBots(ctx)
+		return err
+	})
+	jobs.Every("scouting", time.Duration(cfg.Jobs.ScoutingIntervalHours)*time.Hour, func(ctx context.Context) error {
+		_, err := scoutingUseCase.RunScouting(ctx)
+		return err
+	})
+	jobs.Every("account_deletion", time.Duration(cfg.Jobs.AccountDeletionIntervalHours)*time.Hour, func(ctx context.Context) error {
+		_, err := accountUseCase.RunDeletions(ctx)
+		return err
+	})
+	jobs.Every("matchday", time.Duration(cfg.Jobs.MatchdayIntervalHours)*time.Hour, func(ctx context.Context) error {
+		_, err := matchUseCase.RunMatchday(ctx)
+		return err
+	})
+	jobs.Every("morale_recovery", time.Duration(cfg.Jobs.MoraleRecoveryIntervalHours)*time.Hour, func(ctx context.Context) error {
+		_, err := moraleUseCase.RunRecovery(ctx)
+		return err
+	})
+	jobs.Start(context.Background())
+
 	go func() {
 		logger.Logger.Info("Server starting", zap.String("address", addr))
 		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
@@ -110,6 +282,8 @@ func main() {
 	<-quit
 	logger.Logger.Info("Shutting down server...")
 
+	jobs.Stop()
+
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
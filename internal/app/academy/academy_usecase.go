@@ -0,0 +1,218 @@
+package academy
+
+import (
+	"context"
+
+	"soccer-manager-api/internal/domain"
+	infraCache "soccer-manager-api/internal/infrastructure/cache"
+	"soccer-manager-api/internal/ports/cache"
+	"soccer-manager-api/internal/ports/repository"
+	"soccer-manager-api/pkg/logger"
+	"soccer-manager-api/pkg/namegen"
+
+	"go.uber.org/zap"
+)
+
+
+type AcademyUseCase struct {
+	academyRepo  repository.AcademyRepository
+	teamRepo     repository.TeamRepository
+	playerRepo   repository.PlayerRepository
+	contractRepo repository.ContractRepository
+	transactor   repository.Transactor
+	cache        cache.Cache
+	cacheHelper  *infraCache.CacheHelper
+}
+
+
+func NewAcademyUseCase(
+	academyRepo repository.AcademyRepository,
+	teamRepo repository.TeamRepository,
+	playerRepo repository.PlayerRepository,
+	contractRepo repository.ContractRepository,
+	transactor repository.Transactor,
+	cache cache.Cache,
+) *AcademyUseCase {
+	return &AcademyUseCase{
+		academyRepo:  academyRepo,
+		teamRepo:     teamRepo,
+		playerRepo:   playerRepo,
+		contractRepo: contractRepo,
+		transactor:   transactor,
+		cache:        cache,
+		cacheHelper:  infraCache.NewCacheHelper(cache),
+	}
+}
+
+
+func (uc *AcademyUseCase) GetAcademy(ctx context.Context, teamID string) (*domain.AcademyWithProspects, error) {
+	team, err := uc.teamRepo.GetByID(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	academy, err := uc.getOrCreateAcademy(ctx, team)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.withProspects(ctx, academy)
+}
+
+
+func (uc *AcademyUseCase) PromoteProspect(ctx context.Context, teamID, prospectID string) (*domain.Player, error) {
+	team, prospect, err := uc.getOwnedProspect(ctx, teamID, prospectID)
+	if err != nil {
+		return nil, err
+	}
+
+
+	player := prospect.Promote()
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		playerCount, err := uc.teamRepo.LockSquadSize(ctx, team.ID.String())
+		if err != nil {
+			return err
+		}
+		if playerCount >= domain.MaxPlayers {
+			return domain.ErrTeamFull
+		}
+
+		if err := uc.academyRepo.DeleteProspect(ctx, prospect.ID.String()); err != nil {
+			return err
+		}
+		if err := uc.playerRepo.Create(ctx, player); err != nil {
+			return err
+		}
+		return uc.contractRepo.Create(ctx, domain.NewStandardContract(player, team.ID))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+
+	uc.cacheHelper.InvalidateTeamCache(ctx, team.ID.String())
+
+	return player, nil
+}
+
+
+func (uc *AcademyUseCase) ReleaseProspect(ctx context.Context, teamID, prospectID string) error {
+	_, _, err := uc.getOwnedProspect(ctx, teamID, prospectID)
+	if err != nil {
+		return err
+	}
+
+	return uc.academyRepo.DeleteProspect(ctx, prospectID)
+}
+
+
+func (uc *AcademyUseCase) RunIntake(ctx context.Context) (int, error) {
+	teams, err := uc.teamRepo.List(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	generated := 0
+	for _, team := range teams {
+		count, err := uc.runTeamIntake(ctx, team)
+		if err != nil {
+			return generated, err
+		}
+		generated += count
+	}
+
+	logger.Logger.Info("Academy intake completed", zap.Int("teams", len(teams)), zap.Int("prospects", generated))
+
+	return generated, nil
+}
+
+func (uc *AcademyUseCase) runTeamIntake(ctx context.Context, team *domain.Team) (int, error) {
+	generated := 0
+	err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		academy, err := uc.getOrCreateAcademy(ctx, team)
+		if err != nil {
+			return err
+		}
+
+		existing, err := uc.academyRepo.GetProspectsByTeamID(ctx, team.ID.String())
+		if err != nil {
+			return err
+		}
+
+		size := academy.IntakeSize()
+		if free := domain.MaxProspects - len(existing); free < size {
+			size = free
+		}
+		if size <= 0 {
+			return nil
+		}
+
+		prospects := make([]*domain.YouthProspect, 0, size)
+		for i := 0; i < size; i++ {
+			prospects = append(prospects, domain.NewYouthProspect(
+				team.ID,
+				academy.Level,
+				namegen.FirstName(),
+				namegen.LastName(),
+				namegen.Country(),
+			))
+		}
+		if err := uc.academyRepo.CreateProspects(ctx, prospects); err != nil {
+			return err
+		}
+
+		academy.RecordIntake()
+		if err := uc.academyRepo.Save(ctx, academy); err != nil {
+			return err
+		}
+		generated = len(prospects)
+		return nil
+	})
+	return generated, err
+}
+
+func (uc *AcademyUseCase) getOrCreateAcademy(ctx context.Context, team *domain.Team) (*domain.Academy, error) {
+	academy, err := uc.academyRepo.GetByTeamID(ctx, team.ID.String())
+	if err == domain.ErrAcademyNotFound {
+		academy = domain.NewAcademy(team.ID)
+		err = uc.academyRepo.Save(ctx, academy)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return academy, nil
+}
+
+func (uc *AcademyUseCase) getOwnedProspect(ctx context.Context, teamID, prospectID string) (*domain.Team, *domain.YouthProspect, error) {
+	team, err := uc.teamRepo.GetByID(ctx, teamID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	prospect, err := uc.academyRepo.GetProspectByID(ctx, prospectID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if prospect.TeamID != team.ID {
+		return nil, nil, domain.ErrProspectNotFound
+	}
+
+	return team, prospect, nil
+}
+
+func (uc *AcademyUseCase) withProspects(ctx context.Context, academy *domain.Academy) (*domain.AcademyWithProspects, error) {
+	prospects, err := uc.academyRepo.GetProspectsByTeamID(ctx, academy.TeamID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	result := &domain.AcademyWithProspects{
+		Academy:   *academy,
+		Prospects: prospects,
+	}
+	if academy.CanUpgrade() {
+		result.UpgradeCost = academy.UpgradeCost()
+	}
+	return result, nil
+}
@@ -0,0 +1,569 @@
+package account
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"soccer-manager-api/internal/app/auth"
+	"soccer-manager-api/internal/domain"
+	infraCache "soccer-manager-api/internal/infrastructure/cache"
+	"soccer-manager-api/internal/ports/cache"
+	"soccer-manager-api/internal/ports/mailer"
+	"soccer-manager-api/internal/ports/repository"
+	"soccer-manager-api/pkg/localization"
+	"soccer-manager-api/pkg/logger"
+	"soccer-manager-api/pkg/password"
+	"soccer-manager-api/pkg/totp"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+
+const totpIssuer = "Soccer Manager"
+
+
+type AccountUseCase struct {
+	userRepo         repository.UserRepository
+	emailTokenRepo   repository.EmailTokenRepository
+	auditRepo        repository.AuditRepository
+	accountRepo      repository.AccountRepository
+	recoveryCodeRepo repository.RecoveryCodeRepository
+	teamRepo         repository.TeamRepository
+	transactor       repository.Transactor
+	authUseCase      *auth.AuthUseCase
+	mailer           mailer.Mailer
+	cacheHelper      *infraCache.CacheHelper
+	baseURL          string
+	deletionGrace    time.Duration
+}
+
+
+func NewAccountUseCase(
+	userRepo repository.UserRepository,
+	emailTokenRepo repository.EmailTokenRepository,
+	auditRepo repository.AuditRepository,
+	accountRepo repository.AccountRepository,
+	recoveryCodeRepo repository.RecoveryCodeRepository,
+	teamRepo repository.TeamRepository,
+	transactor repository.Transactor,
+	authUseCase *auth.AuthUseCase,
+	mailer mailer.Mailer,
+	cache cache.Cache,
+	baseURL string,
+	deletionGraceDays int,
+) *AccountUseCase {
+	return &AccountUseCase{
+		userRepo:         userRepo,
+		emailTokenRepo:   emailTokenRepo,
+		auditRepo:        auditRepo,
+		accountRepo:      accountRepo,
+		recoveryCodeRepo: recoveryCodeRepo,
+		teamRepo:         teamRepo,
+		transactor:       transactor,
+		authUseCase:      authUseCase,
+		mailer:           mailer,
+		cacheHelper:      infraCache.NewCacheHelper(cache),
+		baseURL:          baseURL,
+		deletionGrace:    time.Duration(deletionGraceDays) * 24 * time.Hour,
+	}
+}
+
+
+type ForgotPasswordRequest struct {
+	Email string `json:"email" binding:"required,email"`
+}
+
+
+type ResetPasswordRequest struct {
+	Token    string `json:"token" binding:"required"`
+	Password string `json:"password" binding:"required,min=6"`
+}
+
+
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password" binding:"required"`
+	NewPassword     string `json:"new_password" binding:"required,min=6"`
+}
+
+
+type ChangeEmailRequest struct {
+	Email           string `json:"email" binding:"required,email"`
+	CurrentPassword string `json:"current_password" binding:"required"`
+}
+
+
+type DeleteAccountRequest struct {
+	CurrentPassword string `json:"current_password" binding:"required"`
+}
+
+
+type SetupTwoFactorRequest struct {
+	CurrentPassword string `json:"current_password" binding:"required"`
+}
+
+
+type TwoFactorCodeRequest struct {
+	Code string `json:"code" binding:"required"`
+}
+
+
+type DisableTwoFactorRequest struct {
+	CurrentPassword string `json:"current_password" binding:"required"`
+	Code            string `json:"code" binding:"required"`
+}
+
+
+func (uc *AccountUseCase) SendVerification(ctx context.Context, user *domain.User, lang string) error {
+	if user.IsVerified() {
+		return domain.ErrEmailAlreadyVerified
+	}
+
+	token, raw, err := domain.NewEmailToken(user.ID, user.Email, domain.EmailTokenVerifyEmail)
+	if err != nil {
+		return err
+	}
+	if err := uc.emailTokenRepo.Create(ctx, token); err != nil {
+		return err
+	}
+
+
+	link := uc.baseURL + "/api/v1/auth/verify-email?token=" + url.QueryEscape(raw)
+	return uc.mailer.Send(ctx, mailer.Message{
+		To:      user.Email,
+		Subject: localization.GetMessage(lang, "email.verify.subject"),
+		Body: localization.FormatMessage(lang, "email.verify.body", map[string]string{
+			"link":  link,
+			"hours": strconv.Itoa(int(domain.VerifyEmailTokenTTL / time.Hour)),
+		}),
+	})
+}
+
+
+func (uc *AccountUseCase) ResendVerification(ctx context.Context, userID, lang string) error {
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	return uc.SendVerification(ctx, user, lang)
+}
+
+
+func (uc *AccountUseCase) VerifyEmail(ctx context.Context, rawToken string) (*domain.User, error) {
+	token, user, err := uc.redeem(ctx, rawToken, domain.EmailTokenVerifyEmail)
+	if err != nil {
+		return nil, err
+	}
+	if !token.IssuedTo(user.Email) {
+		return nil, domain.ErrInvalidEmailToken
+	}
+
+	user.VerifyEmail()
+	if err := uc.emailTokenRepo.Consume(ctx, token, user); err != nil {
+		return nil, err
+	}
+
+	logger.Logger.Info("Email verified", zap.String("user_id", user.ID.String()))
+	return user, nil
+}
+
+
+func (uc *AccountUseCase) ForgotPassword(ctx context.Context, req ForgotPasswordRequest, lang string) error {
+	user, err := uc.userRepo.GetByEmail(ctx, req.Email)
+	if err == domain.ErrUserNotFound {
+		logger.Logger.Info("Password reset requested for unknown email", zap.String("email", req.Email))
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if user.IsBanned() {
+		return nil
+	}
+
+
+	token, raw, err := domain.NewEmailToken(user.ID, user.Email, domain.EmailTokenResetPassword)
+	if err != nil {
+		return err
+	}
+	if err := uc.emailTokenRepo.Create(ctx, token); err != nil {
+		return err
+	}
+
+
+	return uc.mailer.Send(ctx, mailer.Message{
+		To:      user.Email,
+		Subject: localization.GetMessage(lang, "email.reset.subject"),
+		Body: localization.FormatMessage(lang, "email.reset.body", map[string]string{
+			"token":   raw,
+			"minutes": strconv.Itoa(int(domain.ResetPasswordTokenTTL / time.Minute)),
+		}),
+	})
+}
+
+
+func (uc *AccountUseCase) ResetPassword(ctx context.Context, req ResetPasswordRequest, client domain.SessionClient) error {
+	token, user, err := uc.redeem(ctx, req.Token, domain.EmailTokenResetPassword)
+	if err != nil {
+		return err
+	}
+
+	passwordHash, err := password.HashPassword(req.Password)
+	if err != nil {
+		return err
+	}
+	user.PasswordHash = passwordHash
+	user.UpdatedAt = time.Now()
+	if token.IssuedTo(user.Email) {
+		user.VerifyEmail()
+	}
+
+
+	if err := uc.emailTokenRepo.Consume(ctx, token, user); err != nil {
+		return err
+	}
+	uc.audit(ctx, user.ID, domain.AuditPasswordReset, client, "")
+	if err := uc.authUseCase.RevokeUserTokens(ctx, user.ID.String()); err != nil {
+		return err
+	}
+
+	logger.Logger.Info("Password reset", zap.String("user_id", user.ID.String()))
+	return nil
+}
+
+
+func (uc *AccountUseCase) ChangePassword(ctx context.Context, userID string, req ChangePasswordRequest, client domain.SessionClient) (*auth.AuthResponse, error) {
+	user, err := uc.authenticate(ctx, userID, req.CurrentPassword)
+	if err != nil {
+		return nil, err
+	}
+
+	passwordHash, err := password.HashPassword(req.NewPassword)
+	if err != nil {
+		return nil, err
+	}
+	user.PasswordHash = passwordHash
+	user.UpdatedAt = time.Now()
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return nil, err
+	}
+
+
+	uc.audit(ctx, user.ID, domain.AuditPasswordChanged, client, "")
+	return uc.restartSessions(ctx, user, client)
+}
+
+
+func (uc *AccountUseCase) ChangeEmail(ctx context.Context, userID string, req ChangeEmailRequest, client domain.SessionClient, lang string) (*auth.AuthResponse, error) {
+	user, err := uc.authenticate(ctx, userID, req.CurrentPassword)
+	if err != nil {
+		return nil, err
+	}
+
+	email := strings.TrimSpace(req.Email)
+	existing, err := uc.userRepo.GetByEmail(ctx, email)
+	if err != nil && err != domain.ErrUserNotFound {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, domain.ErrUserAlreadyExists
+	}
+
+
+	previous := user.Email
+	user.Email = email
+	user.EmailVerifiedAt = nil
+	user.UpdatedAt = time.Now()
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.userRepo.Update(ctx, user); err != nil {
+			return err
+		}
+		return uc.emailTokenRepo.ExpireByUserID(ctx, user.ID.String())
+	})
+	if err != nil {
+		return nil, err
+	}
+	uc.audit(ctx, user.ID, domain.AuditEmailChanged, client, previous+" -> "+email)
+
+
+	err = uc.mailer.Send(ctx, mailer.Message{
+		To:      previous,
+		Subject: localization.GetMessage(lang, "email.changed.subject"),
+		Body:    localization.FormatMessage(lang, "email.changed.body", map[string]string{"email": email}),
+	})
+	if err != nil {
+		logger.Logger.Error("Failed to notify previous email address", zap.String("user_id", user.ID.String()), zap.Error(err))
+	}
+	if err := uc.SendVerification(ctx, user, lang); err != nil {
+		logger.Logger.Error("Failed to send verification email", zap.String("user_id", user.ID.String()), zap.Error(err))
+	}
+
+	return uc.restartSessions(ctx, user, client)
+}
+
+
+func (uc *AccountUseCase) ScheduleDeletion(ctx context.Context, userID string, req DeleteAccountRequest, client domain.SessionClient, lang string) (*domain.User, error) {
+	user, err := uc.authenticate(ctx, userID, req.CurrentPassword)
+	if err != nil {
+		return nil, err
+	}
+	if user.IsDeletionScheduled() {
+		return nil, domain.ErrDeletionScheduled
+	}
+
+	user.ScheduleDeletion(uc.deletionGrace)
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return nil, err
+	}
+	date := user.DeletionScheduledAt.Format("2006-01-02")
+	uc.audit(ctx, user.ID, domain.AuditDeletionScheduled, client, date)
+
+
+	if err := uc.authUseCase.RevokeUserTokens(ctx, user.ID.String()); err != nil {
+		return nil, err
+	}
+	err = uc.mailer.Send(ctx, mailer.Message{
+		To:      user.Email,
+		Subject: localization.GetMessage(lang, "email.deletion.subject"),
+		Body:    localization.FormatMessage(lang, "email.deletion.body", map[string]string{"date": date}),
+	})
+	if err != nil {
+		logger.Logger.Error("Failed to send deletion notice", zap.String("user_id", user.ID.String()), zap.Error(err))
+	}
+
+	logger.Logger.Info("Account deletion scheduled", zap.String("user_id", user.ID.String()), zap.Time("deletion_scheduled_at", *user.DeletionScheduledAt))
+	return user, nil
+}
+
+
+func (uc *AccountUseCase) Export(ctx context.Context, userID string, client domain.SessionClient) (*domain.AccountExport, error) {
+	export, err := uc.accountRepo.Export(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.audit(ctx, export.UserID, domain.AuditPersonalDataExport, client, "")
+	return export, nil
+}
+
+
+func (uc *AccountUseCase) RunDeletions(ctx context.Context) (int, error) {
+	users, err := uc.userRepo.ListDueForDeletion(ctx, time.Now())
+	if err != nil {
+		return 0, err
+	}
+
+	deleted := 0
+	for _, user := range users {
+		if err := uc.purge(ctx, user); err != nil {
+			return deleted, err
+		}
+		deleted++
+	}
+
+	logger.Logger.Info("Scheduled account deletions processed", zap.Int("deleted", deleted))
+	return deleted, nil
+}
+
+
+func (uc *AccountUseCase) SetupTwoFactor(ctx context.Context, userID string, req SetupTwoFactorRequest) (*domain.TwoFactorSetup, error) {
+	user, err := uc.authenticate(ctx, userID, req.CurrentPassword)
+	if err != nil {
+		return nil, err
+	}
+	if user.HasTwoFactor() {
+		return nil, domain.ErrTwoFactorAlreadyEnabled
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+	user.SetTOTPSecret(secret)
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return nil, err
+	}
+
+
+	codes, err := uc.issueRecoveryCodes(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.TwoFactorSetup{
+		Secret:        secret,
+		URI:           totp.URI(totpIssuer, user.Email, secret),
+		RecoveryCodes: codes,
+	}, nil
+}
+
+
+func (uc *AccountUseCase) ConfirmTwoFactor(ctx context.Context, userID string, req TwoFactorCodeRequest, client domain.SessionClient) (*domain.User, error) {
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.HasTwoFactor() {
+		return nil, domain.ErrTwoFactorAlreadyEnabled
+	}
+
+	if err := uc.authUseCase.CheckTwoFactorCode(ctx, user, req.Code); err != nil {
+		return nil, err
+	}
+	user.EnableTwoFactor(user.TOTPLastStep)
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return nil, err
+	}
+
+	uc.audit(ctx, user.ID, domain.AuditTwoFactorEnabled, client, "")
+	return user, nil
+}
+
+
+func (uc *AccountUseCase) DisableTwoFactor(ctx context.Context, userID string, req DisableTwoFactorRequest, client domain.SessionClient) (*domain.User, error) {
+	user, err := uc.authenticate(ctx, userID, req.CurrentPassword)
+	if err != nil {
+		return nil, err
+	}
+	if !user.HasTwoFactor() {
+		return nil, domain.ErrTwoFactorNotEnabled
+	}
+
+	if err := uc.authUseCase.CheckTwoFactorCode(ctx, user, req.Code); err != nil {
+		return nil, err
+	}
+	user.DisableTwoFactor()
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return nil, err
+	}
+	if err := uc.recoveryCodeRepo.DeleteByUserID(ctx, user.ID.String()); err != nil {
+		return nil, err
+	}
+
+	uc.audit(ctx, user.ID, domain.AuditTwoFactorDisabled, client, "")
+	return user, nil
+}
+
+
+func (uc *AccountUseCase) RegenerateRecoveryCodes(ctx context.Context, userID string, req TwoFactorCodeRequest, client domain.SessionClient) ([]string, error) {
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !user.HasTwoFactor() {
+		return nil, domain.ErrTwoFactorNotEnabled
+	}
+
+	if err := uc.authUseCase.CheckTwoFactorCode(ctx, user, req.Code); err != nil {
+		return nil, err
+	}
+	codes, err := uc.issueRecoveryCodes(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.audit(ctx, user.ID, domain.AuditRecoveryCodesReset, client, "")
+	return codes, nil
+}
+
+
+func (uc *AccountUseCase) RequireVerified(ctx context.Context, userID string) error {
+	if _, err := uuid.Parse(userID); err != nil {
+		return domain.ErrUserNotFound
+	}
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !user.IsVerified() {
+		return domain.ErrEmailNotVerified
+	}
+	return nil
+}
+
+func (uc *AccountUseCase) authenticate(ctx context.Context, userID, currentPassword string) (*domain.User, error) {
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !password.CheckPasswordHash(currentPassword, user.PasswordHash) {
+		return nil, domain.ErrInvalidCredentials
+	}
+	return user, nil
+}
+
+func (uc *AccountUseCase) restartSessions(ctx context.Context, user *domain.User, client domain.SessionClient) (*auth.AuthResponse, error) {
+	if err := uc.authUseCase.RevokeUserTokens(ctx, user.ID.String()); err != nil {
+		return nil, err
+	}
+	return uc.authUseCase.StartSession(ctx, user, client)
+}
+
+func (uc *AccountUseCase) issueRecoveryCodes(ctx context.Context, userID uuid.UUID) ([]string, error) {
+	codes := make([]string, 0, domain.RecoveryCodeCount)
+	records := make([]*domain.RecoveryCode, 0, domain.RecoveryCodeCount)
+	for i := 0; i < domain.RecoveryCodeCount; i++ {
+		code, err := domain.GenerateRecoveryCode()
+		if err != nil {
+			return nil, err
+		}
+		hash, err := password.HashPassword(domain.NormalizeRecoveryCode(code))
+		if err != nil {
+			return nil, err
+		}
+		codes = append(codes, code)
+		records = append(records, domain.NewRecoveryCode(userID, hash))
+	}
+
+	if err := uc.recoveryCodeRepo.Replace(ctx, userID.String(), records); err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+func (uc *AccountUseCase) purge(ctx context.Context, user *domain.User) error {
+	teams, err := uc.teamRepo.ListByUserID(ctx, user.ID.String())
+	if err != nil {
+		return err
+	}
+	if err := uc.accountRepo.Purge(ctx, user.ID.String()); err != nil {
+		return err
+	}
+
+	for _, team := range teams {
+		uc.cacheHelper.InvalidateTeamCache(ctx, team.ID.String())
+	}
+	uc.cacheHelper.InvalidateTransferListCache(ctx)
+
+	logger.Logger.Info("Account deleted", zap.String("user_id", user.ID.String()), zap.Int("teams", len(teams)))
+	return nil
+}
+
+func (uc *AccountUseCase) audit(ctx context.Context, userID uuid.UUID, action domain.AuditAction, client domain.SessionClient, details string) {
+	entry := domain.NewAuditEntry(userID, action, client, details)
+	if err := uc.auditRepo.Create(ctx, entry); err != nil {
+		logger.Logger.Error("Failed to record audit entry", zap.String("user_id", userID.String()), zap.String("action", string(action)), zap.Error(err))
+	}
+}
+
+func (uc *AccountUseCase) redeem(ctx context.Context, rawToken string, purpose domain.EmailTokenPurpose) (*domain.EmailToken, *domain.User, error) {
+	token, err := uc.emailTokenRepo.GetByHash(ctx, domain.HashToken(rawToken), purpose)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !token.IsUsable(time.Now()) {
+		return nil, nil, domain.ErrInvalidEmailToken
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, token.UserID.String())
+	if err == domain.ErrUserNotFound {
+		return nil, nil, domain.ErrInvalidEmailToken
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return token, user, nil
+}
@@ -0,0 +1,400 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	"soccer-manager-api/internal/app/auth"
+	"soccer-manager-api/internal/domain"
+	infraCache "soccer-manager-api/internal/infrastructure/cache"
+	"soccer-manager-api/internal/ports/cache"
+	"soccer-manager-api/internal/ports/repository"
+	"soccer-manager-api/pkg/logger"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+
+type AdminUseCase struct {
+	userRepo     repository.UserRepository
+	teamRepo     repository.TeamRepository
+	playerRepo   repository.PlayerRepository
+	transferRepo repository.TransferRepository
+	lineupRepo   repository.LineupRepository
+	contractRepo repository.ContractRepository
+	financeRepo  repository.FinanceRepository
+	auditRepo    repository.AuditRepository
+	transactor   repository.Transactor
+	authUseCase  *auth.AuthUseCase
+	cacheHelper  *infraCache.CacheHelper
+}
+
+
+func NewAdminUseCase(
+	userRepo repository.UserRepository,
+	teamRepo repository.TeamRepository,
+	playerRepo repository.PlayerRepository,
+	transferRepo repository.TransferRepository,
+	lineupRepo repository.LineupRepository,
+	contractRepo repository.ContractRepository,
+	financeRepo repository.FinanceRepository,
+	auditRepo repository.AuditRepository,
+	transactor repository.Transactor,
+	authUseCase *auth.AuthUseCase,
+	cache cache.Cache,
+) *AdminUseCase {
+	return &AdminUseCase{
+		userRepo:     userRepo,
+		teamRepo:     teamRepo,
+		playerRepo:   playerRepo,
+		transferRepo: transferRepo,
+		lineupRepo:   lineupRepo,
+		contractRepo: contractRepo,
+		financeRepo:  financeRepo,
+		auditRepo:    auditRepo,
+		transactor:   transactor,
+		authUseCase:  authUseCase,
+		cacheHelper:  infraCache.NewCacheHelper(cache),
+	}
+}
+
+
+type Actor struct {
+	UserID string
+	Role   domain.Role
+	Client domain.SessionClient
+}
+
+
+type ReasonRequest struct {
+	Reason string `json:"reason" binding:"required,max=500"`
+}
+
+
+type SetRoleRequest struct {
+	Role string `json:"role" binding:"required"`
+}
+
+
+type AdjustBudgetRequest struct {
+	Amount float64 `json:"amount" binding:"required"`
+	Reason string  `json:"reason" binding:"required,max=500"`
+}
+
+
+func (uc *AdminUseCase) ListUsers(ctx context.Context, filter domain.UserFilter) ([]*domain.User, error) {
+	if filter.Role != "" && !filter.Role.IsValid() {
+		return nil, domain.ErrInvalidRole
+	}
+	if filter.Status != "" && filter.Status != "active" && filter.Status != "suspended" {
+		return nil, domain.ErrInvalidUserStatus
+	}
+
+	if filter.Limit <= 0 {
+		filter.Limit = domain.DefaultUserSearchLimit
+	}
+	if filter.Limit > domain.MaxUserSearchLimit {
+		filter.Limit = domain.MaxUserSearchLimit
+	}
+	if filter.Offset < 0 {
+		filter.Offset = 0
+	}
+
+	return uc.userRepo.Search(ctx, filter)
+}
+
+
+func (uc *AdminUseCase) GetUser(ctx context.Context, userID string) (*domain.UserDetails, error) {
+	user, err := uc.getUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	teams, err := uc.teamRepo.ListByUserID(ctx, user.ID.String())
+	if err != nil {
+		return nil, err
+	}
+	return &domain.UserDetails{User: user, Teams: teams}, nil
+}
+
+
+func (uc *AdminUseCase) SuspendUser(ctx context.Context, actor Actor, userID string, req ReasonRequest) (*domain.User, error) {
+	target, err := uc.getUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := actor.canManage(target); err != nil {
+		return nil, err
+	}
+
+
+	user, err := uc.authUseCase.BanUser(ctx, target.ID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	uc.audit(ctx, actor, user.ID, domain.AuditUserSuspended, req.Reason)
+	return user, nil
+}
+
+
+func (uc *AdminUseCase) UnsuspendUser(ctx context.Context, actor Actor, userID string) (*domain.User, error) {
+	target, err := uc.getUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := actor.canManage(target); err != nil {
+		return nil, err
+	}
+
+
+	user, err := uc.authUseCase.UnbanUser(ctx, target.ID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	uc.audit(ctx, actor, user.ID, domain.AuditUserUnsuspended, "")
+	return user, nil
+}
+
+
+func (uc *AdminUseCase) SetRole(ctx context.Context, actor Actor, userID string, req SetRoleRequest) (*domain.User, error) {
+	role := domain.Role(req.Role)
+	if !role.IsValid() {
+		return nil, domain.ErrInvalidRole
+	}
+
+	user, err := uc.getUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := actor.canManage(user); err != nil {
+		return nil, err
+	}
+	if user.Role == role {
+		return user, nil
+	}
+
+
+	previous := user.Role
+	user.SetRole(role)
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return nil, err
+	}
+
+
+	if err := uc.authUseCase.RevokeUserTokens(ctx, user.ID.String()); err != nil {
+		return nil, err
+	}
+
+	uc.audit(ctx, actor, user.ID, domain.AuditRoleChanged, fmt.Sprintf("%s -> %s", previous, role))
+	return user, nil
+}
+
+
+func (uc *AdminUseCase) AdjustBudget(ctx context.Context, actor Actor, teamID string, req AdjustBudgetRequest) (*domain.FinanceTransaction, error) {
+	team, err := uc.getTeam(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+
+	transaction, err := uc.credit(ctx, team.ID, domain.FinanceAdjustment, req.Amount, req.Reason, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.audit(ctx, actor, team.UserID, domain.AuditBudgetAdjusted, fmt.Sprintf("%s %+.2f: %s", team.Name, req.Amount, req.Reason))
+	return transaction, nil
+}
+
+
+func (uc *AdminUseCase) CancelListing(ctx context.Context, actor Actor, listingID string, req ReasonRequest) (*domain.TransferListing, error) {
+	if _, err := uuid.Parse(listingID); err != nil {
+		return nil, domain.ErrTransferListingNotFound
+	}
+
+	listing, err := uc.transferRepo.GetListingByID(ctx, listingID)
+	if err != nil {
+		return nil, err
+	}
+	if !listing.IsActive() {
+		return nil, domain.ErrTransferListingNotFound
+	}
+
+
+	listing.Cancel()
+	if err := uc.transferRepo.UpdateListing(ctx, listing); err != nil {
+		return nil, err
+	}
+	uc.cacheHelper.InvalidateTransferListCache(ctx)
+
+
+	player, err := uc.playerRepo.GetByID(ctx, listing.PlayerID.String())
+	if err != nil {
+		return nil, err
+	}
+	if player.TeamID != nil {
+		if team, err := uc.teamRepo.GetByID(ctx, player.TeamID.String()); err == nil {
+			uc.audit(ctx, actor, team.UserID, domain.AuditListingCancelled, fmt.Sprintf("%s %s: %s", player.FirstName, player.LastName, req.Reason))
+		}
+	}
+
+	return listing, nil
+}
+
+
+func (uc *AdminUseCase) ReverseTransfer(ctx context.Context, actor Actor, transferID string, req ReasonRequest) (*domain.Transfer, error) {
+	if _, err := uuid.Parse(transferID); err != nil {
+		return nil, domain.ErrTransferNotFound
+	}
+
+	transfer, err := uc.transferRepo.GetTransferByID(ctx, transferID)
+	if err != nil {
+		return nil, err
+	}
+	if transfer.IsReversed() {
+		return nil, domain.ErrTransferAlreadyReversed
+	}
+	if transfer.SellerTeamID == nil || transfer.BuyerTeamID == nil {
+		return nil, domain.ErrTransferNotReversible
+	}
+
+
+	player, err := uc.playerRepo.GetByID(ctx, transfer.PlayerID.String())
+	if err != nil {
+		return nil, err
+	}
+	if player.TeamID == nil || !player.IsOwnedBy(*transfer.BuyerTeamID) {
+		return nil, domain.ErrTransferNotReversible
+	}
+
+
+	sellerTeam, err := uc.teamRepo.GetByID(ctx, transfer.SellerTeamID.String())
+	if err != nil {
+		return nil, err
+	}
+	buyerTeam, err := uc.teamRepo.GetByID(ctx, transfer.BuyerTeamID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	playerCount, err := uc.teamRepo.GetPlayerCount(ctx, sellerTeam.ID.String())
+	if err != nil {
+		return nil, err
+	}
+	if playerCount >= domain.MaxPlayers {
+		return nil, domain.ErrTeamFull
+	}
+
+
+	description := fmt.Sprintf("Reversed transfer of %s %s: %s", player.FirstName, player.LastName, req.Reason)
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		transfer.Reverse()
+		if err := uc.transferRepo.UpdateTransfer(ctx, transfer); err != nil {
+			return err
+		}
+
+
+		if listing, err := uc.transferRepo.GetListingByPlayerID(ctx, player.ID.String()); err == nil {
+			listing.Cancel()
+			if err := uc.transferRepo.UpdateListing(ctx, listing); err != nil {
+				return err
+			}
+		}
+
+		player.ReturnTo(sellerTeam.ID)
+		if err := uc.playerRepo.ChangeTeam(ctx, player, transfer.BuyerTeamID); err != nil {
+			return err
+		}
+		if err := uc.lineupRepo.RemovePlayer(ctx, player.ID.String()); err != nil {
+			return err
+		}
+
+
+		if contract, err := uc.contractRepo.GetActiveByPlayerID(ctx, player.ID.String()); err == nil {
+			contract.Terminate()
+			if err := uc.contractRepo.Update(ctx, contract); err != nil {
+				return err
+			}
+		}
+		if err := uc.contractRepo.Create(ctx, domain.NewStandardContract(player, sellerTeam.ID)); err != nil {
+			return err
+		}
+
+
+		if _, err := uc.credit(ctx, buyerTeam.ID, domain.FinanceTransferReversal, transfer.TransferPrice, description, &transfer.ID); err != nil {
+			return err
+		}
+		_, err := uc.credit(ctx, sellerTeam.ID, domain.FinanceTransferReversal, -transfer.TransferPrice, description, &transfer.ID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	uc.cacheHelper.InvalidateTransferListCache(ctx)
+
+
+	uc.audit(ctx, actor, sellerTeam.UserID, domain.AuditTransferReversed, description)
+	if buyerTeam.UserID != sellerTeam.UserID {
+		uc.audit(ctx, actor, buyerTeam.UserID, domain.AuditTransferReversed, description)
+	}
+	return transfer, nil
+}
+
+func (uc *AdminUseCase) credit(ctx context.Context, teamID uuid.UUID, category domain.FinanceCategory, amount float64, description string, referenceID *uuid.UUID) (*domain.FinanceTransaction, error) {
+	transaction := domain.NewFinanceTransaction(teamID, category, amount, description, referenceID)
+	if err := uc.financeRepo.ApplyTransaction(ctx, transaction); err != nil {
+		return nil, err
+	}
+
+	uc.cacheHelper.InvalidateTeamCache(ctx, teamID.String())
+
+	return transaction, nil
+}
+
+func (uc *AdminUseCase) audit(ctx context.Context, actor Actor, userID uuid.UUID, action domain.AuditAction, details string) {
+	entry := domain.NewAuditEntry(userID, action, actor.Client, details)
+	entry.ActorID = actor.id()
+	if err := uc.auditRepo.Create(ctx, entry); err != nil {
+		logger.Logger.Error("Failed to record audit entry", zap.String("user_id", userID.String()), zap.String("action", string(action)), zap.Error(err))
+	}
+
+	logger.Logger.Info("Staff action", zap.String("actor_id", actor.UserID), zap.String("role", string(actor.Role)),
+		zap.String("user_id", userID.String()), zap.String("action", string(action)))
+}
+
+func (uc *AdminUseCase) getUser(ctx context.Context, userID string) (*domain.User, error) {
+	if _, err := uuid.Parse(userID); err != nil {
+		return nil, domain.ErrUserNotFound
+	}
+	return uc.userRepo.GetByID(ctx, userID)
+}
+
+func (uc *AdminUseCase) getTeam(ctx context.Context, teamID string) (*domain.Team, error) {
+	if _, err := uuid.Parse(teamID); err != nil {
+		return nil, domain.ErrTeamNotFound
+	}
+	return uc.teamRepo.GetByID(ctx, teamID)
+}
+
+
+func (a Actor) canManage(target *domain.User) error {
+	if a.UserID == "" {
+		return nil
+	}
+	if a.UserID == target.ID.String() || !a.Role.Outranks(target.Role) {
+		return domain.ErrInsufficientRole
+	}
+	return nil
+}
+
+func (a Actor) id() *uuid.UUID {
+	id, err := uuid.Parse(a.UserID)
+	if err != nil {
+		return nil
+	}
+	return &id
+}
@@ -2,24 +2,52 @@ package auth
 
 import (
 	"context"
+	"fmt"
 	"math/rand"
+	"strings"
+	"sync"
 	"time"
 
 	"soccer-manager-api/internal/domain"
+	infraCache "soccer-manager-api/internal/infrastructure/cache"
 	"soccer-manager-api/internal/ports/repository"
+	"soccer-manager-api/pkg/countries"
 	"soccer-manager-api/pkg/jwt"
+	"soccer-manager-api/pkg/logger"
+	"soccer-manager-api/pkg/namegen"
 	"soccer-manager-api/pkg/password"
+	"soccer-manager-api/pkg/totp"
 
 	"github.com/google/uuid"
+	"go.uber.org/zap"
 )
 
 
+const maxTeamNameAttempts = 10
+
+
+var dummyPasswordHash = sync.OnceValue(func() string {
+	hash, _ := password.HashPassword(uuid.NewString())
+	return hash
+})
+
+
 type AuthUseCase struct {
-	userRepo   repository.UserRepository
-	teamRepo   repository.TeamRepository
-	playerRepo repository.PlayerRepository
-	jwtSecret  string
-	jwtExpHours int
+	userRepo         repository.UserRepository
+	teamRepo         repository.TeamRepository
+	playerRepo       repository.PlayerRepository
+	contractRepo     repository.ContractRepository
+	draftRepo        repository.DraftRepository
+	sessionRepo      repository.SessionRepository
+	recoveryCodeRepo repository.RecoveryCodeRepository
+	transactor       repository.Transactor
+	revocations      *infraCache.RevocationStore
+	challenges       *infraCache.ChallengeStore
+	loginThrottle    *infraCache.LoginThrottle
+	jwtKeys          *jwt.KeySet
+	accessTTL        time.Duration
+	refreshTTL       time.Duration
+	sessionLifetime  time.Duration
 }
 
 
@@ -27,22 +55,55 @@ func NewAuthUseCase(
 	userRepo repository.UserRepository,
 	teamRepo repository.TeamRepository,
 	playerRepo repository.PlayerRepository,
-	jwtSecret string,
-	jwtExpHours int,
+	contractRepo repository.ContractRepository,
+	draftRepo repository.DraftRepository,
+	sessionRepo repository.SessionRepository,
+	recoveryCodeRepo repository.RecoveryCodeRepository,
+	transactor repository.Transactor,
+	revocations *infraCache.RevocationStore,
+	challenges *infraCache.ChallengeStore,
+	loginThrottle *infraCache.LoginThrottle,
+	jwtKeys *jwt.KeySet,
+	accessTokenMinutes int,
+	refreshTokenDays int,
+	sessionMaxDays int,
 ) *AuthUseCase {
 	return &AuthUseCase{
-		userRepo:    userRepo,
-		teamRepo:    teamRepo,
-		playerRepo:  playerRepo,
-		jwtSecret:   jwtSecret,
-		jwtExpHours: jwtExpHours,
+		userRepo:         userRepo,
+		teamRepo:         teamRepo,
+		playerRepo:       playerRepo,
+		contractRepo:     contractRepo,
+		draftRepo:        draftRepo,
+		sessionRepo:      sessionRepo,
+		recoveryCodeRepo: recoveryCodeRepo,
+		transactor:       transactor,
+		revocations:      revocations,
+		challenges:       challenges,
+		loginThrottle:    loginThrottle,
+		jwtKeys:          jwtKeys,
+		accessTTL:        time.Duration(accessTokenMinutes) * time.Minute,
+		refreshTTL:       time.Duration(refreshTokenDays) * 24 * time.Hour,
+		sessionLifetime:  time.Duration(sessionMaxDays) * 24 * time.Hour,
 	}
 }
 
 
 type RegisterRequest struct {
-	Email    string `json:"email" binding:"required,email"`
-	Password string `json:"password" binding:"required,min=6"`
+	Email          string `json:"email" binding:"required,email"`
+	Password       string `json:"password" binding:"required,min=6"`
+	TeamName       string `json:"team_name" binding:"omitempty,max=100"`
+	Country        string `json:"country"`
+	CrestPrimary   string `json:"crest_primary"`
+	CrestSecondary string `json:"crest_secondary"`
+	Draft          bool   `json:"draft"`
+}
+
+
+type TeamOptions struct {
+	Name           string
+	Country        string
+	CrestPrimary   string
+	CrestSecondary string
 }
 
 
@@ -52,13 +113,26 @@ type LoginRequest struct {
 }
 
 
+type RefreshRequest struct {
+	RefreshToken string `json:"refresh_token" binding:"required"`
+}
+
+
+type VerifyTwoFactorRequest struct {
+	ChallengeToken string `json:"challenge_token" binding:"required"`
+	Code           string `json:"code" binding:"required"`
+}
+
+
 type AuthResponse struct {
-	Token string      `json:"token"`
-	User  *domain.User `json:"user"`
+	Token        string       `json:"token"`
+	RefreshToken string       `json:"refresh_token"`
+	ExpiresAt    time.Time    `json:"expires_at"`
+	User         *domain.User `json:"user"`
 }
 
 
-func (uc *AuthUseCase) Register(ctx context.Context, req RegisterRequest) (*AuthResponse, error) {
+func (uc *AuthUseCase) Register(ctx context.Context, req RegisterRequest, client domain.SessionClient) (*AuthResponse, error) {
 
 	existingUser, _ := uc.userRepo.GetByEmail(ctx, req.Email)
 	if existingUser != nil {
@@ -66,6 +140,17 @@ func (uc *AuthUseCase) Register(ctx context.Context, req RegisterRequest) (*Auth
 	}
 
 
+	team, err := uc.BuildTeam(ctx, uuid.Nil, TeamOptions{
+		Name:           req.TeamName,
+		Country:        req.Country,
+		CrestPrimary:   req.CrestPrimary,
+		CrestSecondary: req.CrestSecondary,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+
 	passwordHash, err := password.HashPassword(req.Password)
 	if err != nil {
 		return nil, err
@@ -73,61 +158,450 @@ func (uc *AuthUseCase) Register(ctx context.Context, req RegisterRequest) (*Auth
 
 
 	user := domain.NewUser(req.Email, passwordHash)
-	if err := uc.userRepo.Create(ctx, user); err != nil {
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.userRepo.Create(ctx, user); err != nil {
+			return err
+		}
+		team.UserID = user.ID
+		return uc.CreateTeam(ctx, team, req.Draft)
+	})
+	if err != nil {
 		return nil, err
 	}
 
 
-	teamName := generateDefaultTeamName()
-	teamCountry := "Unknown"
-	team := domain.NewTeam(user.ID, teamName, teamCountry)
-	if err := uc.teamRepo.Create(ctx, team); err != nil {
+	return uc.StartSession(ctx, user, client)
+}
+
+
+func (uc *AuthUseCase) Login(ctx context.Context, req LoginRequest, client domain.SessionClient) (*AuthResponse, *domain.TwoFactorChallenge, error) {
+
+	if err := uc.loginThrottle.Check(ctx, req.Email, client.IPAddress); err != nil {
+		if _, ok := err.(*domain.LoginThrottleError); ok {
+			return nil, nil, err
+		}
+		logger.Logger.Error("Login throttle check failed", zap.Error(err))
+	}
+
+
+	user, err := uc.userRepo.GetByEmail(ctx, req.Email)
+	if err != nil {
+		password.CheckPasswordHash(req.Password, dummyPasswordHash())
+		return nil, nil, uc.loginFailed(ctx, req.Email, client)
+	}
+
+
+	if !password.CheckPasswordHash(req.Password, user.PasswordHash) {
+		return nil, nil, uc.loginFailed(ctx, req.Email, client)
+	}
+	if user.IsBanned() {
+		return nil, nil, domain.ErrUserBanned
+	}
+
+
+	if user.HasTwoFactor() {
+		challenge, err := uc.challenges.Issue(ctx, user.ID)
+		if err != nil {
+			return nil, nil, err
+		}
+		return nil, challenge, nil
+	}
+
+	uc.resetLoginThrottle(ctx, user)
+	response, err := uc.completeLogin(ctx, user, client)
+	return response, nil, err
+}
+
+
+func (uc *AuthUseCase) VerifyTwoFactor(ctx context.Context, req VerifyTwoFactorRequest, client domain.SessionClient) (*AuthResponse, error) {
+	userID, err := uc.challenges.Lookup(ctx, req.ChallengeToken)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, userID.String())
+	if err == domain.ErrUserNotFound {
+		return nil, domain.ErrInvalidChallenge
+	}
+	if err != nil {
 		return nil, err
 	}
+	if user.IsBanned() {
+		return nil, domain.ErrUserBanned
+	}
+	if err := uc.loginThrottle.Check(ctx, user.Email, client.IPAddress); err != nil {
+		if _, ok := err.(*domain.LoginThrottleError); ok {
+			return nil, err
+		}
+		logger.Logger.Error("Login throttle check failed", zap.Error(err))
+	}
 
 
-	players := uc.generateInitialPlayers(team.ID)
-	if err := uc.playerRepo.CreateBatch(ctx, players); err != nil {
+	if err := uc.CheckTwoFactorCode(ctx, user, req.Code); err != nil {
+		if err == domain.ErrInvalidTwoFactorCode {
+			if failErr := uc.challenges.Fail(ctx, req.ChallengeToken); failErr != nil && failErr != domain.ErrInvalidChallenge {
+				return nil, failErr
+			}
+			if failErr := uc.loginThrottle.RecordFailure(ctx, user.Email, client.IPAddress); failErr != nil {
+				logger.Logger.Error("Failed to record two-factor attempt", zap.String("user_id", user.ID.String()), zap.Error(failErr))
+			}
+		}
 		return nil, err
 	}
+	if err := uc.challenges.Consume(ctx, req.ChallengeToken); err != nil {
+		return nil, err
+	}
+
+
+	uc.resetLoginThrottle(ctx, user)
+	return uc.completeLogin(ctx, user, client)
+}
 
 
-	token, err := jwt.GenerateToken(user.ID, user.Email, uc.jwtSecret, uc.jwtExpHours)
+func (uc *AuthUseCase) CheckTwoFactorCode(ctx context.Context, user *domain.User, code string) error {
+	if user.TOTPSecret == nil {
+		return domain.ErrTwoFactorNotPending
+	}
+
+	if step, ok := totp.Validate(*user.TOTPSecret, code, time.Now()); ok {
+		if step <= user.TOTPLastStep {
+			return domain.ErrInvalidTwoFactorCode
+		}
+		if err := uc.userRepo.AdvanceTOTPStep(ctx, user.ID.String(), step); err != nil {
+			return err
+		}
+		user.TOTPLastStep = step
+		return nil
+	}
+	if !user.HasTwoFactor() {
+		return domain.ErrInvalidTwoFactorCode
+	}
+
+
+	codes, err := uc.recoveryCodeRepo.ListUnused(ctx, user.ID.String())
+	if err != nil {
+		return err
+	}
+	normalized := domain.NormalizeRecoveryCode(code)
+	for _, recovery := range codes {
+		if password.CheckPasswordHash(normalized, recovery.CodeHash) {
+			logger.Logger.Info("Recovery code used", zap.String("user_id", user.ID.String()), zap.Int("remaining", len(codes)-1))
+			return uc.recoveryCodeRepo.MarkUsed(ctx, recovery.ID.String())
+		}
+	}
+	return domain.ErrInvalidTwoFactorCode
+}
+
+
+func (uc *AuthUseCase) Refresh(ctx context.Context, req RefreshRequest) (*AuthResponse, error) {
+	used, err := uc.sessionRepo.GetRefreshToken(ctx, domain.HashToken(req.RefreshToken))
 	if err != nil {
 		return nil, err
 	}
 
-	return &AuthResponse{
-		Token: token,
-		User:  user,
-	}, nil
+	session, err := uc.sessionRepo.GetByID(ctx, used.SessionID.String())
+	if err == domain.ErrSessionNotFound {
+		return nil, domain.ErrInvalidRefreshToken
+	}
+	if err != nil {
+		return nil, err
+	}
+
+
+	if used.IsRotated() {
+		return nil, uc.revokeReusedSession(ctx, session)
+	}
+	now := time.Now()
+	if !session.IsActive(now) || used.IsExpired(now) {
+		return nil, domain.ErrInvalidRefreshToken
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, session.UserID.String())
+	if err != nil {
+		return nil, err
+	}
+	if user.IsBanned() {
+		return nil, domain.ErrUserBanned
+	}
+
+
+	session.Extend(uc.refreshTTL)
+	next, refreshToken, err := domain.NewRefreshToken(session.ID, session.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.sessionRepo.Rotate(ctx, session, used, next); err != nil {
+		if err == domain.ErrRefreshTokenReused {
+			return nil, uc.revokeReusedSession(ctx, session)
+		}
+		return nil, err
+	}
+
+	return uc.authResponse(user, session, refreshToken)
 }
 
 
-func (uc *AuthUseCase) Login(ctx context.Context, req LoginRequest) (*AuthResponse, error) {
+func (uc *AuthUseCase) Logout(ctx context.Context, userID, sessionID, tokenID string) error {
+	if err := uc.RevokeSession(ctx, userID, sessionID); err != nil {
+		return err
+	}
+	return uc.revocations.RevokeToken(ctx, tokenID)
+}
+
 
-	user, err := uc.userRepo.GetByEmail(ctx, req.Email)
+func (uc *AuthUseCase) ListSessions(ctx context.Context, userID, currentSessionID string) ([]*domain.Session, error) {
+	sessions, err := uc.sessionRepo.GetActiveByUserID(ctx, userID)
 	if err != nil {
-		return nil, domain.ErrInvalidCredentials
+		return nil, err
 	}
 
+	for _, session := range sessions {
+		session.Current = session.ID.String() == currentSessionID
+	}
+	return sessions, nil
+}
 
-	if !password.CheckPasswordHash(req.Password, user.PasswordHash) {
-		return nil, domain.ErrInvalidCredentials
+
+func (uc *AuthUseCase) RevokeSession(ctx context.Context, userID, sessionID string) error {
+	if _, err := uuid.Parse(sessionID); err != nil {
+		return domain.ErrSessionNotFound
+	}
+
+	session, err := uc.sessionRepo.GetByID(ctx, sessionID)
+	if err != nil {
+		return err
+	}
+	if session.UserID.String() != userID {
+		return domain.ErrSessionNotFound
+	}
+
+	if err := uc.sessionRepo.Revoke(ctx, session.ID.String()); err != nil {
+		return err
+	}
+	return uc.revocations.RevokeSession(ctx, session.ID.String())
+}
+
+
+func (uc *AuthUseCase) RevokeUserTokens(ctx context.Context, userID string) error {
+	if err := uc.sessionRepo.RevokeByUserID(ctx, userID); err != nil {
+		return err
+	}
+	return uc.revocations.RevokeUserTokens(ctx, userID, time.Now())
+}
+
+
+func (uc *AuthUseCase) BanUser(ctx context.Context, userID string) (*domain.User, error) {
+	user, err := uc.getUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !user.IsBanned() {
+		user.Ban()
+		if err := uc.userRepo.Update(ctx, user); err != nil {
+			return nil, err
+		}
+	}
+
+
+	if err := uc.RevokeUserTokens(ctx, user.ID.String()); err != nil {
+		return nil, err
 	}
 
+	logger.Logger.Info("User banned", zap.String("user_id", user.ID.String()))
+	return user, nil
+}
+
+
+func (uc *AuthUseCase) UnbanUser(ctx context.Context, userID string) (*domain.User, error) {
+	user, err := uc.getUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.IsBanned() {
+		user.Unban()
+		if err := uc.userRepo.Update(ctx, user); err != nil {
+			return nil, err
+		}
+	}
+	return user, nil
+}
+
+func (uc *AuthUseCase) loginFailed(ctx context.Context, email string, client domain.SessionClient) error {
+	if err := uc.loginThrottle.RecordFailure(ctx, email, client.IPAddress); err != nil {
+		logger.Logger.Error("Failed to record login attempt", zap.String("email", email), zap.Error(err))
+	}
+	return domain.ErrInvalidCredentials
+}
+
+func (uc *AuthUseCase) resetLoginThrottle(ctx context.Context, user *domain.User) {
+	if err := uc.loginThrottle.Reset(ctx, user.Email); err != nil {
+		logger.Logger.Error("Failed to reset login attempts", zap.String("user_id", user.ID.String()), zap.Error(err))
+	}
+}
+
+func (uc *AuthUseCase) completeLogin(ctx context.Context, user *domain.User, client domain.SessionClient) (*AuthResponse, error) {
+	if user.IsDeletionScheduled() {
+		user.CancelDeletion()
+		if err := uc.userRepo.Update(ctx, user); err != nil {
+			return nil, err
+		}
+		logger.Logger.Info("Account deletion cancelled by login", zap.String("user_id", user.ID.String()))
+	}
+
+	return uc.StartSession(ctx, user, client)
+}
+
+func (uc *AuthUseCase) getUser(ctx context.Context, userID string) (*domain.User, error) {
+	if _, err := uuid.Parse(userID); err != nil {
+		return nil, domain.ErrUserNotFound
+	}
+	return uc.userRepo.GetByID(ctx, userID)
+}
+
+
+func (uc *AuthUseCase) StartSession(ctx context.Context, user *domain.User, client domain.SessionClient) (*AuthResponse, error) {
+	session := domain.NewSession(user.ID, client, uc.refreshTTL, uc.sessionLifetime)
+	token, refreshToken, err := domain.NewRefreshToken(session.ID, session.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.sessionRepo.Create(ctx, session, token); err != nil {
+		return nil, err
+	}
+
+	return uc.authResponse(user, session, refreshToken)
+}
 
-	token, err := jwt.GenerateToken(user.ID, user.Email, uc.jwtSecret, uc.jwtExpHours)
+func (uc *AuthUseCase) authResponse(user *domain.User, session *domain.Session, refreshToken string) (*AuthResponse, error) {
+	expiresAt := time.Now().Add(uc.accessTTL)
+	token, err := jwt.GenerateToken(user.ID, user.Email, string(user.Role), session.ID, uc.jwtKeys, uc.accessTTL)
 	if err != nil {
 		return nil, err
 	}
 
 	return &AuthResponse{
-		Token: token,
-		User:  user,
+		Token:        token,
+		RefreshToken: refreshToken,
+		ExpiresAt:    expiresAt,
+		User:         user,
 	}, nil
 }
 
+func (uc *AuthUseCase) revokeReusedSession(ctx context.Context, session *domain.Session) error {
+	logger.Logger.Warn("Refresh token reuse detected", zap.String("user_id", session.UserID.String()), zap.String("session_id", session.ID.String()))
+	if err := uc.RevokeSession(ctx, session.UserID.String(), session.ID.String()); err != nil {
+		return err
+	}
+	return domain.ErrRefreshTokenReused
+}
+
+
+func (uc *AuthUseCase) BuildTeam(ctx context.Context, userID uuid.UUID, opts TeamOptions) (*domain.Team, error) {
+	country := domain.DefaultCountry
+	if opts.Country != "" {
+		name, ok := countries.Normalize(opts.Country)
+		if !ok {
+			return nil, domain.ErrInvalidCountry
+		}
+		country = name
+	}
+
+
+	name := strings.TrimSpace(opts.Name)
+	if name == "" {
+		generated, err := uc.uniqueTeamName(ctx)
+		if err != nil {
+			return nil, err
+		}
+		name = generated
+	} else if err := uc.CheckTeamName(ctx, name, uuid.Nil); err != nil {
+		return nil, err
+	}
+
+
+	team := domain.NewTeam(userID, name, country)
+	if err := team.SetCrest(opts.CrestPrimary, opts.CrestSecondary); err != nil {
+		return nil, err
+	}
+
+	return team, nil
+}
+
+
+func (uc *AuthUseCase) CheckTeamName(ctx context.Context, name string, teamID uuid.UUID) error {
+	existing, err := uc.teamRepo.GetByName(ctx, name)
+	if err == domain.ErrTeamNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if existing.ID != teamID {
+		return domain.ErrTeamNameTaken
+	}
+	return nil
+}
+
+
+func (uc *AuthUseCase) CreateTeam(ctx context.Context, team *domain.Team, draft bool) error {
+	return uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		teams, err := uc.teamRepo.ListByUserID(ctx, team.UserID.String())
+		if err != nil {
+			return err
+		}
+		if len(teams) >= domain.MaxTeamsPerUser {
+			return domain.ErrTooManyTeams
+		}
+		team.IsDefault = len(teams) == 0
+
+
+		if err := uc.teamRepo.Create(ctx, team); err != nil {
+			return err
+		}
+
+
+		if draft {
+			pool := domain.NewDraftPool(func(position domain.Position) *domain.Player {
+				return domain.NewPlayer(nil, namegen.FirstName(), namegen.LastName(), namegen.Country(), position)
+			})
+			return uc.draftRepo.CreatePool(ctx, team.ID.String(), pool)
+		}
+
+
+		players := uc.generateInitialPlayers(team.ID)
+		if err := uc.playerRepo.CreateBatch(ctx, players); err != nil {
+			return err
+		}
+
+
+		contracts := make([]*domain.Contract, 0, len(players))
+		for _, player := range players {
+			contracts = append(contracts, domain.NewInitialContract(player, team.ID))
+		}
+		return uc.contractRepo.CreateBatch(ctx, contracts)
+	})
+}
+
+
+func (uc *AuthUseCase) uniqueTeamName(ctx context.Context) (string, error) {
+	for attempt := 0; attempt < maxTeamNameAttempts; attempt++ {
+		name := namegen.TeamName()
+		if attempt >= maxTeamNameAttempts/2 {
+			name = fmt.Sprintf("%s %d", name, rand.Intn(900)+100)
+		}
+
+		err := uc.CheckTeamName(ctx, name, uuid.Nil)
+		if err == nil {
+			return name, nil
+		}
+		if err != domain.ErrTeamNameTaken {
+			return "", err
+		}
+	}
+	return "", domain.ErrTeamNameTaken
+}
 
 func (uc *AuthUseCase) generateInitialPlayers(teamID uuid.UUID) []*domain.Player {
 	players := make([]*domain.Player, 0, 20)
@@ -136,9 +610,9 @@ func (uc *AuthUseCase) generateInitialPlayers(teamID uuid.UUID) []*domain.Player
 	for i := 0; i < 3; i++ {
 		players = append(players, domain.NewPlayer(
 			&teamID,
-			generateRandomFirstName(),
-			generateRandomLastName(),
-			generateRandomCountry(),
+			namegen.FirstName(),
+			namegen.LastName(),
+			namegen.Country(),
 			domain.PositionGoalkeeper,
 		))
 	}
@@ -147,9 +621,9 @@ func (uc *AuthUseCase) generateInitialPlayers(teamID uuid.UUID) []*domain.Player
 	for i := 0; i < 6; i++ {
 		players = append(players, domain.NewPlayer(
 			&teamID,
-			generateRandomFirstName(),
-			generateRandomLastName(),
-			generateRandomCountry(),
+			namegen.FirstName(),
+			namegen.LastName(),
+			namegen.Country(),
 			domain.PositionDefender,
 		))
 	}
@@ -158,9 +632,9 @@ func (uc *AuthUseCase) generateInitialPlayers(teamID uuid.UUID) []*domain.Player
 	for i := 0; i < 6; i++ {
 		players = append(players, domain.NewPlayer(
 			&teamID,
-			generateRandomFirstName(),
-			generateRandomLastName(),
-			generateRandomCountry(),
+			namegen.FirstName(),
+			namegen.LastName(),
+			namegen.Country(),
 			domain.PositionMidfielder,
 		))
 	}
@@ -169,52 +643,12 @@ func (uc *AuthUseCase) generateInitialPlayers(teamID uuid.UUID) []*domain.Player
 	for i := 0; i < 5; i++ {
 		players = append(players, domain.NewPlayer(
 			&teamID,
-			generateRandomFirstName(),
-			generateRandomLastName(),
-			generateRandomCountry(),
+			namegen.FirstName(),
+			namegen.LastName(),
+			namegen.Country(),
 			domain.PositionAttacker,
 		))
 	}
 
 	return players
 }
-
-func generateDefaultTeamName() string {
-	names := []string{
-		"FC United", "City FC", "Athletic Club", "Sporting FC",
-		"United FC", "City United", "Athletic United", "Sporting Club",
-	}
-	rand.Seed(time.Now().UnixNano())
-	return names[rand.Intn(len(names))]
-}
-
-func generateRandomFirstName() string {
-	names := []string{
-		"John", "James", "Michael", "David", "Robert", "William", "Richard", "Joseph",
-		"Thomas", "Charles", "Christopher", "Daniel", "Matthew", "Anthony", "Mark",
-		"Donald", "Steven", "Paul", "Andrew", "Joshua", "Kenneth", "Kevin", "Brian",
-	}
-	rand.Seed(time.Now().UnixNano())
-	return names[rand.Intn(len(names))]
-}
-
-func generateRandomLastName() string {
-	names := []string{
-		"Smith", "Johnson", "Williams", "Brown", "Jones", "Garcia", "Miller", "Davis",
-		"Rodriguez", "Martinez", "Hernandez", "Lopez", "Wilson", "Anderson", "Thomas",
-		"Taylor", "Moore", "Jackson", "Martin", "Lee", "Thompson", "White", "Harris",
-	}
-	rand.Seed(time.Now().UnixNano())
-	return names[rand.Intn(len(names))]
-}
-
-func generateRandomCountry() string {
-	countries := []string{
-		"Brazil", "Argentina", "Spain", "Germany", "France", "Italy", "England",
-		"Portugal", "Netherlands", "Belgium", "Croatia", "Uruguay", "Colombia",
-		"Mexico", "Chile", "Poland", "Denmark", "Sweden", "Norway", "Greece",
-	}
-	rand.Seed(time.Now().UnixNano())
-	return countries[rand.Intn(len(countries))]
-}
-
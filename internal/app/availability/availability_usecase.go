@@ -0,0 +1,205 @@
+package availability
+
+import (
+	"context"
+	"time"
+
+	"soccer-manager-api/internal/domain"
+	infraCache "soccer-manager-api/internal/infrastructure/cache"
+	"soccer-manager-api/internal/ports/cache"
+	"soccer-manager-api/internal/ports/repository"
+	"soccer-manager-api/pkg/logger"
+
+	"go.uber.org/zap"
+)
+
+
+type AvailabilityUseCase struct {
+	absenceRepo  repository.AbsenceRepository
+	playerRepo   repository.PlayerRepository
+	lineupRepo   repository.LineupRepository
+	facilityRepo repository.FacilityRepository
+	cache        cache.Cache
+	cacheHelper  *infraCache.CacheHelper
+}
+
+
+func NewAvailabilityUseCase(
+	absenceRepo repository.AbsenceRepository,
+	playerRepo repository.PlayerRepository,
+	lineupRepo repository.LineupRepository,
+	facilityRepo repository.FacilityRepository,
+	cache cache.Cache,
+) *AvailabilityUseCase {
+	return &AvailabilityUseCase{
+		absenceRepo:  absenceRepo,
+		playerRepo:   playerRepo,
+		lineupRepo:   lineupRepo,
+		facilityRepo: facilityRepo,
+		cache:        cache,
+		cacheHelper:  infraCache.NewCacheHelper(cache),
+	}
+}
+
+
+type RecordInjuryRequest struct {
+	Days        int    `json:"days" binding:"required"`
+	Description string `json:"description"`
+}
+
+
+type RecordSuspensionRequest struct {
+	Matches int    `json:"matches" binding:"required"`
+	Reason  string `json:"reason"`
+}
+
+
+func (uc *AvailabilityUseCase) GetInjuryHistory(ctx context.Context, playerID string) ([]*domain.PlayerAbsence, error) {
+	if _, err := uc.playerRepo.GetByID(ctx, playerID); err != nil {
+		return nil, err
+	}
+	return uc.absenceRepo.GetByPlayerID(ctx, playerID)
+}
+
+
+func (uc *AvailabilityUseCase) RecordInjury(ctx context.Context, playerID string, req RecordInjuryRequest) (*domain.Player, error) {
+	if req.Days <= 0 {
+		return nil, domain.ErrInvalidAbsence
+	}
+
+	player, err := uc.playerRepo.GetByID(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	days := req.Days
+	if player.TeamID != nil {
+		facilities, err := uc.facilityRepo.GetByTeamID(ctx, player.TeamID.String())
+		if err == domain.ErrFacilitiesNotFound {
+			facilities, err = domain.NewFacilities(*player.TeamID), nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		days = domain.InjuryDays(req.Days, facilities.MedicalCentreLevel)
+	}
+
+	player.Injure(days)
+	absence := domain.NewInjury(player, days, req.Description)
+
+	if err := uc.markUnavailable(ctx, player, absence); err != nil {
+		return nil, err
+	}
+
+	return player, nil
+}
+
+
+func (uc *AvailabilityUseCase) RecordSuspension(ctx context.Context, playerID string, req RecordSuspensionRequest) (*domain.Player, error) {
+	if req.Matches <= 0 {
+		return nil, domain.ErrInvalidAbsence
+	}
+
+	player, err := uc.playerRepo.GetByID(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	player.Suspend(req.Matches)
+	absence := domain.NewSuspension(player, req.Matches, req.Reason)
+
+	if err := uc.markUnavailable(ctx, player, absence); err != nil {
+		return nil, err
+	}
+
+	return player, nil
+}
+
+func (uc *AvailabilityUseCase) markUnavailable(ctx context.Context, player *domain.Player, absence *domain.PlayerAbsence) error {
+	if err := uc.playerRepo.UpdateAvailability(ctx, player); err != nil {
+		return err
+	}
+
+	if err := uc.absenceRepo.Create(ctx, absence); err != nil {
+		return err
+	}
+
+	if err := uc.lineupRepo.RemovePlayer(ctx, player.ID.String()); err != nil {
+		return err
+	}
+
+	uc.invalidate(ctx, player)
+
+	return nil
+}
+
+
+func (uc *AvailabilityUseCase) ServeMatch(ctx context.Context, teamID string) (int, error) {
+	players, err := uc.playerRepo.GetByTeamID(ctx, teamID)
+	if err != nil {
+		return 0, err
+	}
+
+	served := 0
+	now := time.Now()
+	for _, player := range players {
+		if !player.ServeMatch() {
+			continue
+		}
+
+		if err := uc.playerRepo.UpdateAvailability(ctx, player); err != nil {
+			return served, err
+		}
+		if player.Availability != domain.AvailabilitySuspended {
+			if err := uc.absenceRepo.CloseOpen(ctx, player.ID.String(), domain.AbsenceSuspension, now); err != nil {
+				return served, err
+			}
+		}
+		served++
+	}
+
+	if served > 0 {
+		uc.cacheHelper.InvalidateTeamCache(ctx, teamID)
+		uc.cacheHelper.InvalidateTransferListCache(ctx)
+	}
+
+	return served, nil
+}
+
+
+func (uc *AvailabilityUseCase) RunRecovery(ctx context.Context) (int, error) {
+	players, err := uc.playerRepo.GetUnavailable(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	recovered := 0
+	now := time.Now()
+	for _, player := range players {
+		if !player.IsRecoveredAt(now) {
+			continue
+		}
+
+		player.Recover()
+		if err := uc.playerRepo.UpdateAvailability(ctx, player); err != nil {
+			return recovered, err
+		}
+		if err := uc.absenceRepo.CloseOpen(ctx, player.ID.String(), domain.AbsenceInjury, now); err != nil {
+			return recovered, err
+		}
+
+		uc.invalidate(ctx, player)
+		recovered++
+	}
+
+	logger.Logger.Info("Recovery completed", zap.Int("unavailable", len(players)), zap.Int("recovered", recovered))
+
+	return recovered, nil
+}
+
+func (uc *AvailabilityUseCase) invalidate(ctx context.Context, player *domain.Player) {
+	if player.TeamID != nil {
+		uc.cacheHelper.InvalidateTeamCache(ctx, player.TeamID.String())
+	}
+	uc.cacheHelper.InvalidateTransferListCache(ctx)
+}
@@ -0,0 +1,311 @@
+package bot
+
+import (
+	"context"
+
+	"soccer-manager-api/internal/app/auth"
+	"soccer-manager-api/internal/app/lineup"
+	"soccer-manager-api/internal/app/transfer"
+	"soccer-manager-api/internal/domain"
+	infraCache "soccer-manager-api/internal/infrastructure/cache"
+	"soccer-manager-api/internal/ports/cache"
+	"soccer-manager-api/internal/ports/repository"
+	"soccer-manager-api/pkg/logger"
+	"soccer-manager-api/pkg/namegen"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+
+type BotUseCase struct {
+	userRepo        repository.UserRepository
+	teamRepo        repository.TeamRepository
+	playerRepo      repository.PlayerRepository
+	transferRepo    repository.TransferRepository
+	lineupRepo      repository.LineupRepository
+	contractRepo    repository.ContractRepository
+	matchRepo       repository.MatchRepository
+	transactor      repository.Transactor
+	authUseCase     *auth.AuthUseCase
+	lineupUseCase   *lineup.LineupUseCase
+	transferUseCase *transfer.TransferUseCase
+	cache           cache.Cache
+	cacheHelper     *infraCache.CacheHelper
+}
+
+
+func NewBotUseCase(
+	userRepo repository.UserRepository,
+	teamRepo repository.TeamRepository,
+	playerRepo repository.PlayerRepository,
+	transferRepo repository.TransferRepository,
+	lineupRepo repository.LineupRepository,
+	contractRepo repository.ContractRepository,
+	matchRepo repository.MatchRepository,
+	transactor repository.Transactor,
+	authUseCase *auth.AuthUseCase,
+	lineupUseCase *lineup.LineupUseCase,
+	transferUseCase *transfer.TransferUseCase,
+	cache cache.Cache,
+) *BotUseCase {
+	return &BotUseCase{
+		userRepo:        userRepo,
+		teamRepo:        teamRepo,
+		playerRepo:      playerRepo,
+		transferRepo:    transferRepo,
+		lineupRepo:      lineupRepo,
+		contractRepo:    contractRepo,
+		matchRepo:       matchRepo,
+		transactor:      transactor,
+		authUseCase:     authUseCase,
+		lineupUseCase:   lineupUseCase,
+		transferUseCase: transferUseCase,
+		cache:           cache,
+		cacheHelper:     infraCache.NewCacheHelper(cache),
+	}
+}
+
+
+type CreateBotRequest struct {
+	Name    string `json:"name"`
+	Country string `json:"country"`
+}
+
+
+func (uc *BotUseCase) ListBots(ctx context.Context) ([]*domain.Team, error) {
+	return uc.teamRepo.ListBots(ctx)
+}
+
+
+func (uc *BotUseCase) CreateBot(ctx context.Context, req CreateBotRequest) (*domain.Team, error) {
+	team, err := uc.authUseCase.BuildTeam(ctx, uuid.Nil, auth.TeamOptions{Name: req.Name, Country: req.Country})
+	if err != nil {
+		return nil, err
+	}
+	if req.Country == "" {
+		team.Country = namegen.Country()
+	}
+	team.IsBot = true
+
+
+	user := domain.NewBotUser()
+	if err := uc.userRepo.Create(ctx, user); err != nil {
+		return nil, err
+	}
+
+	team.UserID = user.ID
+	if err := uc.authUseCase.CreateTeam(ctx, team, false); err != nil {
+		return nil, err
+	}
+
+
+	if _, err := uc.pickLineup(ctx, team); err != nil {
+		return nil, err
+	}
+
+	return team, nil
+}
+
+
+func (uc *BotUseCase) DeleteBot(ctx context.Context, teamID string) error {
+	team, err := uc.teamRepo.GetByID(ctx, teamID)
+	if err != nil {
+		return err
+	}
+	if !team.IsBot {
+		return domain.ErrNotBotTeam
+	}
+
+
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		players, err := uc.playerRepo.GetByTeamID(ctx, teamID)
+		if err != nil {
+			return err
+		}
+		for _, player := range players {
+			if err := uc.releasePlayer(ctx, player); err != nil {
+				return err
+			}
+		}
+
+		if err := uc.matchRepo.DeleteScheduledByTeamID(ctx, teamID); err != nil {
+			return err
+		}
+		return uc.userRepo.Delete(ctx, team.UserID.String())
+	})
+	if err != nil {
+		return err
+	}
+
+
+	uc.cacheHelper.InvalidateTeamCache(ctx, teamID)
+	uc.cacheHelper.InvalidateTransferListCache(ctx)
+
+	return nil
+}
+
+
+func (uc *BotUseCase) RunBots(ctx context.Context) (*domain.BotActivity, error) {
+	teams, err := uc.teamRepo.ListBots(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	activity := &domain.BotActivity{}
+	for _, team := range teams {
+		if err := uc.runBot(ctx, team, activity); err != nil {
+			return activity, err
+		}
+		activity.TeamsProcessed++
+	}
+
+	logger.Logger.Info("Bot teams processed",
+		zap.Int("teams", activity.TeamsProcessed),
+		zap.Int("lineups_picked", activity.LineupsPicked),
+		zap.Int("players_listed", activity.PlayersListed),
+		zap.Int("players_bought", activity.PlayersBought),
+	)
+
+	return activity, nil
+}
+
+func (uc *BotUseCase) runBot(ctx context.Context, team *domain.Team, activity *domain.BotActivity) error {
+	players, err := uc.playerRepo.GetByTeamID(ctx, team.ID.String())
+	if err != nil {
+		return err
+	}
+
+
+	for _, player := range domain.SurplusPlayers(players) {
+		req := transfer.ListPlayerRequest{AskingPrice: domain.BotAskingPrice(player)}
+		_, err := uc.transferUseCase.ListPlayer(ctx, team.ID.String(), player.ID.String(), req)
+		if err == domain.ErrPlayerAlreadyListed {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		activity.PlayersListed++
+	}
+
+
+	bought, err := uc.fillGaps(ctx, team, players)
+	if err != nil {
+		return err
+	}
+	activity.PlayersBought += bought
+
+
+	picked, err := uc.pickLineup(ctx, team)
+	if err != nil {
+		return err
+	}
+	if picked {
+		activity.LineupsPicked++
+	}
+
+	return nil
+}
+
+func (uc *BotUseCase) fillGaps(ctx context.Context, team *domain.Team, players []*domain.Player) (int, error) {
+	gaps := domain.SquadGaps(players)
+	if len(gaps) == 0 || len(players) >= domain.MaxPlayers {
+		return 0, nil
+	}
+
+	listings, err := uc.transferRepo.GetActiveListings(ctx, team.ID.String(), nil)
+	if err != nil {
+		return 0, err
+	}
+
+
+	bought := 0
+	for _, listing := range listings {
+		if bought >= domain.BotPurchasesPerRun {
+			break
+		}
+		player := &listing.Player
+		if gaps[player.Position] == 0 || !player.IsAvailable() {
+			continue
+		}
+		if !domain.BotCanBuy(team, player, listing.AskingPrice) {
+			continue
+		}
+
+		_, err := uc.transferUseCase.BuyPlayer(ctx, team.ID.String(), listing.ID.String())
+		if err == domain.ErrInsufficientBudget || err == domain.ErrTeamFull || err == domain.ErrTransferListingNotFound {
+			continue
+		}
+		if err != nil {
+			return bought, err
+		}
+
+		bought++
+		gaps[player.Position]--
+		team.DeductBudget(listing.AskingPrice)
+	}
+
+	return bought, nil
+}
+
+func (uc *BotUseCase) pickLineup(ctx context.Context, team *domain.Team) (bool, error) {
+	players, err := uc.playerRepo.GetByTeamID(ctx, team.ID.String())
+	if err != nil {
+		return false, err
+	}
+
+	picked := domain.AutoPickLineup(team.ID, players)
+	if picked == nil {
+		return false, nil
+	}
+
+
+	req := lineupRequest(picked)
+	if _, err := uc.lineupUseCase.UpdateLineup(ctx, team.ID.String(), req); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (uc *BotUseCase) releasePlayer(ctx context.Context, player *domain.Player) error {
+	if listing, err := uc.transferRepo.GetListingByPlayerID(ctx, player.ID.String()); err == nil && listing.IsActive() {
+		listing.Cancel()
+		if err := uc.transferRepo.UpdateListing(ctx, listing); err != nil {
+			return err
+		}
+	}
+
+	if err := uc.lineupRepo.RemovePlayer(ctx, player.ID.String()); err != nil {
+		return err
+	}
+
+	if contract, err := uc.contractRepo.GetActiveByPlayerID(ctx, player.ID.String()); err == nil {
+		contract.Terminate()
+		if err := uc.contractRepo.Update(ctx, contract); err != nil {
+			return err
+		}
+	}
+
+	teamID := player.TeamID
+	player.Release()
+	if err := uc.playerRepo.ChangeTeam(ctx, player, teamID); err != nil {
+		return err
+	}
+	return uc.transferRepo.CreateListing(ctx, domain.NewFreeAgentListing(player))
+}
+
+func lineupRequest(picked *domain.Lineup) lineup.UpdateLineupRequest {
+	req := lineup.UpdateLineupRequest{
+		Formation:   string(picked.Formation),
+		Starters:    make([]string, 0, len(picked.Starters)),
+		Substitutes: make([]string, 0, len(picked.Substitutes)),
+	}
+	for _, id := range picked.Starters {
+		req.Starters = append(req.Starters, id.String())
+	}
+	for _, id := range picked.Substitutes {
+		req.Substitutes = append(req.Substitutes, id.String())
+	}
+	return req
+}
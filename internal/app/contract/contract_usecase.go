@@ -0,0 +1,275 @@
+package contract
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"soccer-manager-api/internal/app/morale"
+	"soccer-manager-api/internal/app/scouting"
+	"soccer-manager-api/internal/domain"
+	infraCache "soccer-manager-api/internal/infrastructure/cache"
+	"soccer-manager-api/internal/ports/cache"
+	"soccer-manager-api/internal/ports/repository"
+	"soccer-manager-api/pkg/logger"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+
+type ContractUseCase struct {
+	contractRepo    repository.ContractRepository
+	financeRepo     repository.FinanceRepository
+	teamRepo        repository.TeamRepository
+	playerRepo      repository.PlayerRepository
+	transferRepo    repository.TransferRepository
+	lineupRepo      repository.LineupRepository
+	transactor      repository.Transactor
+	moraleUseCase   *morale.MoraleUseCase
+	scoutingUseCase *scouting.ScoutingUseCase
+	cache           cache.Cache
+	cacheHelper     *infraCache.CacheHelper
+}
+
+
+func NewContractUseCase(
+	contractRepo repository.ContractRepository,
+	financeRepo repository.FinanceRepository,
+	teamRepo repository.TeamRepository,
+	playerRepo repository.PlayerRepository,
+	transferRepo repository.TransferRepository,
+	lineupRepo repository.LineupRepository,
+	transactor repository.Transactor,
+	moraleUseCase *morale.MoraleUseCase,
+	scoutingUseCase *scouting.ScoutingUseCase,
+	cache cache.Cache,
+) *ContractUseCase {
+	return &ContractUseCase{
+		contractRepo:    contractRepo,
+		financeRepo:     financeRepo,
+		teamRepo:        teamRepo,
+		playerRepo:      playerRepo,
+		transferRepo:    transferRepo,
+		lineupRepo:      lineupRepo,
+		transactor:      transactor,
+		moraleUseCase:   moraleUseCase,
+		scoutingUseCase: scoutingUseCase,
+		cache:           cache,
+		cacheHelper:     infraCache.NewCacheHelper(cache),
+	}
+}
+
+
+type RenegotiateContractRequest struct {
+	WeeklyWage float64 `json:"weekly_wage" binding:"required,gt=0"`
+	Years      int     `json:"years" binding:"required"`
+}
+
+
+type TeamPayroll struct {
+	WeeklyWages float64                  `json:"weekly_wages"`
+	Contracts   []*domain.Contract       `json:"contracts"`
+	Payments    []*domain.PayrollPayment `json:"payments"`
+}
+
+
+func (uc *ContractUseCase) GetContract(ctx context.Context, teamID, playerID string) (*domain.Contract, error) {
+	player, err := uc.playerRepo.GetByID(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.scoutingUseCase.RequireExactView(ctx, teamID, player); err != nil {
+		return nil, err
+	}
+	return uc.contractRepo.GetActiveByPlayerID(ctx, playerID)
+}
+
+
+func (uc *ContractUseCase) GetTeamPayroll(ctx context.Context, teamID string) (*TeamPayroll, error) {
+	team, err := uc.teamRepo.GetByID(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	contracts, err := uc.contractRepo.GetActiveByTeamID(ctx, team.ID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	payments, err := uc.contractRepo.GetPaymentsByTeamID(ctx, team.ID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	payroll := &TeamPayroll{Contracts: contracts, Payments: payments}
+	for _, contract := range contracts {
+		payroll.WeeklyWages += contract.WeeklyWage
+	}
+
+	return payroll, nil
+}
+
+
+func (uc *ContractUseCase) RenegotiateContract(ctx context.Context, teamID, playerID string, req RenegotiateContractRequest) (*domain.Contract, error) {
+	team, err := uc.teamRepo.GetByID(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+
+	player, err := uc.playerRepo.GetByID(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !player.IsOwnedBy(team.ID) {
+		return nil, domain.ErrPlayerNotOwned
+	}
+
+
+	contract, err := uc.contractRepo.GetActiveByPlayerID(ctx, playerID)
+	if err == domain.ErrContractNotFound {
+		contract = domain.NewStandardContract(player, team.ID)
+		if err := contract.Renegotiate(player, req.WeeklyWage, req.Years); err != nil {
+			return nil, err
+		}
+		err = uc.contractRepo.Create(ctx, contract)
+	} else if err == nil {
+		if err := contract.Renegotiate(player, req.WeeklyWage, req.Years); err != nil {
+			return nil, err
+		}
+		err = uc.contractRepo.Update(ctx, contract)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+
+	if err := uc.moraleUseCase.Adjust(ctx, player, domain.MoraleDriverWages, domain.MoraleRenegotiated, "Contract renegotiated"); err != nil {
+		return nil, err
+	}
+
+	return contract, nil
+}
+
+
+func (uc *ContractUseCase) RunPayroll(ctx context.Context) (float64, error) {
+	teams, err := uc.teamRepo.List(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	total := 0.0
+	for _, team := range teams {
+		contracts, err := uc.contractRepo.GetActiveByTeamID(ctx, team.ID.String())
+		if err != nil {
+			return total, err
+		}
+		if len(contracts) == 0 {
+			continue
+		}
+
+		payment := domain.NewPayrollPayment(team.ID, contracts)
+		description := fmt.Sprintf("Weekly wages: %d players", payment.Players)
+		transaction := domain.NewFinanceTransaction(team.ID, domain.FinanceWages, -payment.Amount, description, &payment.ID)
+		err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+			if err := uc.contractRepo.CreatePayment(ctx, payment); err != nil {
+				return err
+			}
+			if err := uc.financeRepo.ApplyTransaction(ctx, transaction); err != nil {
+				return err
+			}
+			return uc.moraleUseCase.ReviewWages(ctx, team.ID.String(), contracts)
+		})
+		if err == domain.ErrPayrollAlreadyPaid {
+			continue
+		}
+		if err != nil {
+			return total, err
+		}
+
+		uc.cacheHelper.InvalidateTeamCache(ctx, team.ID.String())
+		total += payment.Amount
+	}
+
+	logger.Logger.Info("Payroll completed", zap.Int("teams", len(teams)), zap.Float64("total_wages", total))
+
+	return total, nil
+}
+
+
+func (uc *ContractUseCase) RunExpiry(ctx context.Context) (int, error) {
+	contracts, err := uc.contractRepo.GetExpiring(ctx, time.Now())
+	if err != nil {
+		return 0, err
+	}
+
+	expired := 0
+	affectedTeams := make(map[uuid.UUID]bool)
+	for _, contract := range contracts {
+		released, err := uc.expireContract(ctx, contract)
+		if err != nil {
+			logger.Logger.Error("Failed to expire contract", zap.String("contract_id", contract.ID.String()), zap.Error(err))
+			continue
+		}
+		expired++
+		if released {
+			affectedTeams[contract.TeamID] = true
+		}
+	}
+
+
+	for teamID := range affectedTeams {
+		uc.cacheHelper.InvalidateTeamCache(ctx, teamID.String())
+	}
+	if expired > 0 {
+		uc.cacheHelper.InvalidateTransferListCache(ctx)
+	}
+
+	logger.Logger.Info("Contract expiry completed", zap.Int("contracts_expired", expired), zap.Int("contracts_failed", len(contracts)-expired))
+
+	return expired, nil
+}
+
+func (uc *ContractUseCase) expireContract(ctx context.Context, contract *domain.Contract) (bool, error) {
+	released := false
+	err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		contract.Expire()
+		if err := uc.contractRepo.Update(ctx, contract); err != nil {
+			return err
+		}
+
+		player, err := uc.playerRepo.GetByID(ctx, contract.PlayerID.String())
+		if err != nil {
+			return err
+		}
+		if !player.IsOwnedBy(contract.TeamID) {
+			return nil
+		}
+
+		released = true
+		return uc.releasePlayer(ctx, player)
+	})
+	return released, err
+}
+
+func (uc *ContractUseCase) releasePlayer(ctx context.Context, player *domain.Player) error {
+	if listing, err := uc.transferRepo.GetListingByPlayerID(ctx, player.ID.String()); err == nil {
+		listing.Cancel()
+		if err := uc.transferRepo.UpdateListing(ctx, listing); err != nil {
+			return err
+		}
+	}
+
+	if err := uc.lineupRepo.RemovePlayer(ctx, player.ID.String()); err != nil {
+		return err
+	}
+
+	teamID := player.TeamID
+	player.Release()
+	if err := uc.playerRepo.ChangeTeam(ctx, player, teamID); err != nil {
+		return err
+	}
+	return uc.transferRepo.CreateListing(ctx, domain.NewFreeAgentListing(player))
+}
@@ -0,0 +1,163 @@
+package draft
+
+import (
+	"context"
+
+	"soccer-manager-api/internal/domain"
+	infraCache "soccer-manager-api/internal/infrastructure/cache"
+	"soccer-manager-api/internal/ports/cache"
+	"soccer-manager-api/internal/ports/repository"
+)
+
+
+type DraftUseCase struct {
+	draftRepo    repository.DraftRepository
+	teamRepo     repository.TeamRepository
+	playerRepo   repository.PlayerRepository
+	contractRepo repository.ContractRepository
+	transactor   repository.Transactor
+	cache        cache.Cache
+	cacheHelper  *infraCache.CacheHelper
+}
+
+
+func NewDraftUseCase(
+	draftRepo repository.DraftRepository,
+	teamRepo repository.TeamRepository,
+	playerRepo repository.PlayerRepository,
+	contractRepo repository.ContractRepository,
+	transactor repository.Transactor,
+	cache cache.Cache,
+) *DraftUseCase {
+	return &DraftUseCase{
+		draftRepo:    draftRepo,
+		teamRepo:     teamRepo,
+		playerRepo:   playerRepo,
+		contractRepo: contractRepo,
+		transactor:   transactor,
+		cache:        cache,
+		cacheHelper:  infraCache.NewCacheHelper(cache),
+	}
+}
+
+
+type PickRequest struct {
+	PlayerIDs []string `json:"player_ids" binding:"required,min=1"`
+}
+
+
+func (uc *DraftUseCase) GetDraft(ctx context.Context, teamID string) (*domain.DraftBoard, error) {
+	team, err := uc.teamRepo.GetByID(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	pool, err := uc.draftRepo.GetPool(ctx, team.ID.String())
+	if err != nil {
+		return nil, err
+	}
+	if len(pool) == 0 {
+		return nil, domain.ErrDraftNotFound
+	}
+
+	squad, err := uc.playerRepo.GetByTeamID(ctx, team.ID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return newDraftBoard(team, squad, pool), nil
+}
+
+func (uc *DraftUseCase) Pick(ctx context.Context, teamID string, req PickRequest) (*domain.DraftBoard, error) {
+	team, err := uc.teamRepo.GetByID(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+
+	var squad, pool []*domain.Player
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if _, err := uc.teamRepo.LockSquadSize(ctx, team.ID.String()); err != nil {
+			return err
+		}
+
+		pool, err = uc.draftRepo.GetPool(ctx, team.ID.String())
+		if err != nil {
+			return err
+		}
+		if len(pool) == 0 {
+			return domain.ErrDraftNotFound
+		}
+
+		squad, err = uc.playerRepo.GetByTeamID(ctx, team.ID.String())
+		if err != nil {
+			return err
+		}
+
+
+		available := make(map[string]*domain.Player, len(pool))
+		for _, player := range pool {
+			available[player.ID.String()] = player
+		}
+		remaining := domain.RemainingPicks(squad)
+		picked := make([]*domain.Player, 0, len(req.PlayerIDs))
+		for _, id := range req.PlayerIDs {
+			player, ok := available[id]
+			if !ok || remaining[player.Position] == 0 {
+				return domain.ErrInvalidDraftPick
+			}
+			delete(available, id)
+			remaining[player.Position]--
+
+			player.Draft(team.ID)
+			picked = append(picked, player)
+		}
+
+
+		if err := uc.draftRepo.ClaimPlayers(ctx, team.ID.String(), picked); err != nil {
+			return err
+		}
+
+		contracts := make([]*domain.Contract, 0, len(picked))
+		for _, player := range picked {
+			contracts = append(contracts, domain.NewInitialContract(player, team.ID))
+		}
+		if err := uc.contractRepo.CreateBatch(ctx, contracts); err != nil {
+			return err
+		}
+		squad = append(squad, picked...)
+
+
+		pool = make([]*domain.Player, 0, len(available))
+		for _, player := range available {
+			pool = append(pool, player)
+		}
+		if domain.DraftComplete(remaining) {
+			pool = nil
+			return uc.draftRepo.DeletePool(ctx, team.ID.String())
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+
+	uc.cacheHelper.InvalidateTeamCache(ctx, team.ID.String())
+
+	return newDraftBoard(team, squad, pool), nil
+}
+
+func newDraftBoard(team *domain.Team, squad, pool []*domain.Player) *domain.DraftBoard {
+	remaining := domain.RemainingPicks(squad)
+	if pool == nil {
+		pool = make([]*domain.Player, 0)
+	}
+	return &domain.DraftBoard{
+		TeamID:    team.ID,
+		Remaining: remaining,
+		Picked:    squad,
+		Pool:      pool,
+		Complete:  domain.DraftComplete(remaining),
+	}
+}
@@ -0,0 +1,259 @@
+package facility
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"soccer-manager-api/internal/domain"
+	infraCache "soccer-manager-api/internal/infrastructure/cache"
+	"soccer-manager-api/internal/ports/cache"
+	"soccer-manager-api/internal/ports/repository"
+	"soccer-manager-api/pkg/logger"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+
+type FacilityUseCase struct {
+	facilityRepo repository.FacilityRepository
+	academyRepo  repository.AcademyRepository
+	financeRepo  repository.FinanceRepository
+	teamRepo     repository.TeamRepository
+	transactor   repository.Transactor
+	cache        cache.Cache
+	cacheHelper  *infraCache.CacheHelper
+}
+
+
+func NewFacilityUseCase(
+	facilityRepo repository.FacilityRepository,
+	academyRepo repository.AcademyRepository,
+	financeRepo repository.FinanceRepository,
+	teamRepo repository.TeamRepository,
+	transactor repository.Transactor,
+	cache cache.Cache,
+) *FacilityUseCase {
+	return &FacilityUseCase{
+		facilityRepo: facilityRepo,
+		academyRepo:  academyRepo,
+		financeRepo:  financeRepo,
+		teamRepo:     teamRepo,
+		transactor:   transactor,
+		cache:        cache,
+		cacheHelper:  infraCache.NewCacheHelper(cache),
+	}
+}
+
+
+type UpdateTicketPriceRequest struct {
+	TicketPrice float64 `json:"ticket_price" binding:"required,gt=0"`
+}
+
+
+func (uc *FacilityUseCase) GetFacilities(ctx context.Context, teamID string) (*domain.FacilitiesOverview, error) {
+	team, err := uc.teamRepo.GetByID(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	facilities, err := uc.getOrCreateFacilities(ctx, team.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	academy, err := uc.getOrCreateAcademy(ctx, team.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	projects, err := uc.facilityRepo.GetProjectsByTeamID(ctx, team.ID.String())
+	if err != nil {
+		return nil, err
+	}
+
+
+	overview := &domain.FacilitiesOverview{
+		Facilities:      *facilities,
+		StadiumCapacity: facilities.StadiumCapacity(),
+		AcademyLevel:    academy.Level,
+		UpgradeCosts:    make(map[domain.FacilityType]float64),
+		Projects:        projects,
+	}
+	for _, facility := range []domain.FacilityType{domain.FacilityStadium, domain.FacilityTrainingGround, domain.FacilityMedicalCentre} {
+		if level := facilities.Level(facility); level < domain.MaxFacilityLevel {
+			overview.UpgradeCosts[facility] = domain.FacilityUpgradeCost(facility, level+1)
+		}
+	}
+	if academy.CanUpgrade() {
+		overview.UpgradeCosts[domain.FacilityAcademy] = academy.UpgradeCost()
+	}
+
+	return overview, nil
+}
+
+
+func (uc *FacilityUseCase) StartUpgrade(ctx context.Context, teamID, facilityType string) (*domain.ConstructionProject, error) {
+	team, err := uc.teamRepo.GetByID(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	facility := domain.FacilityType(facilityType)
+	if !facility.IsValid() {
+		return nil, domain.ErrInvalidFacility
+	}
+
+
+	projects, err := uc.facilityRepo.GetProjectsByTeamID(ctx, team.ID.String())
+	if err != nil {
+		return nil, err
+	}
+	for _, project := range projects {
+		if project.Facility == facility && project.Status == domain.ConstructionInProgress {
+			return nil, domain.ErrConstructionInProgress
+		}
+	}
+
+
+	var currentLevel int
+	if facility == domain.FacilityAcademy {
+		academy, err := uc.getOrCreateAcademy(ctx, team.ID)
+		if err != nil {
+			return nil, err
+		}
+		currentLevel = academy.Level
+	} else {
+		facilities, err := uc.getOrCreateFacilities(ctx, team.ID)
+		if err != nil {
+			return nil, err
+		}
+		currentLevel = facilities.Level(facility)
+	}
+
+	project, err := domain.NewConstructionProject(team.ID, facility, currentLevel)
+	if err != nil {
+		return nil, err
+	}
+	if !team.CanAfford(project.Cost) {
+		return nil, domain.ErrInsufficientBudget
+	}
+
+
+	description := fmt.Sprintf("%s upgrade to level %d", facility, project.TargetLevel)
+	transaction := domain.NewFinanceTransaction(team.ID, domain.FinanceConstruction, -project.Cost, description, &project.ID)
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.financeRepo.ApplyTransaction(ctx, transaction); err != nil {
+			return err
+		}
+		return uc.facilityRepo.CreateProject(ctx, project)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+
+	uc.cacheHelper.InvalidateTeamCache(ctx, team.ID.String())
+
+	return project, nil
+}
+
+
+func (uc *FacilityUseCase) UpdateTicketPrice(ctx context.Context, teamID string, req UpdateTicketPriceRequest) (*domain.Facilities, error) {
+	team, err := uc.teamRepo.GetByID(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	facilities, err := uc.getOrCreateFacilities(ctx, team.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := facilities.SetTicketPrice(req.TicketPrice); err != nil {
+		return nil, err
+	}
+	if err := uc.facilityRepo.UpdateTicketPrice(ctx, facilities); err != nil {
+		return nil, err
+	}
+
+	return facilities, nil
+}
+
+
+func (uc *FacilityUseCase) RunConstruction(ctx context.Context) (int, error) {
+	now := time.Now()
+	projects, err := uc.facilityRepo.GetDueProjects(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+
+	completed := 0
+	for _, project := range projects {
+		err := uc.completeProject(ctx, project)
+		if err == domain.ErrConstructionNotInProgress {
+			continue
+		}
+		if err != nil {
+			return completed, err
+		}
+		uc.cacheHelper.InvalidateTeamCache(ctx, project.TeamID.String())
+		completed++
+	}
+
+	logger.Logger.Info("Construction completed", zap.Int("projects", completed))
+
+	return completed, nil
+}
+
+func (uc *FacilityUseCase) completeProject(ctx context.Context, project *domain.ConstructionProject) error {
+	return uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		project.Complete()
+		if err := uc.facilityRepo.UpdateProject(ctx, project); err != nil {
+			return err
+		}
+
+		if project.Facility == domain.FacilityAcademy {
+			academy, err := uc.getOrCreateAcademy(ctx, project.TeamID)
+			if err != nil {
+				return err
+			}
+			for academy.Level < project.TargetLevel && academy.CanUpgrade() {
+				academy.Upgrade()
+			}
+			return uc.academyRepo.UpdateLevel(ctx, academy)
+		}
+
+		facilities, err := uc.getOrCreateFacilities(ctx, project.TeamID)
+		if err != nil {
+			return err
+		}
+		facilities.SetLevel(project.Facility, project.TargetLevel)
+		return uc.facilityRepo.UpdateLevel(ctx, facilities, project.Facility)
+	})
+}
+
+func (uc *FacilityUseCase) getOrCreateFacilities(ctx context.Context, teamID uuid.UUID) (*domain.Facilities, error) {
+	facilities, err := uc.facilityRepo.GetByTeamID(ctx, teamID.String())
+	if err == domain.ErrFacilitiesNotFound {
+		facilities = domain.NewFacilities(teamID)
+		err = uc.facilityRepo.Create(ctx, facilities)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return facilities, nil
+}
+
+func (uc *FacilityUseCase) getOrCreateAcademy(ctx context.Context, teamID uuid.UUID) (*domain.Academy, error) {
+	academy, err := uc.academyRepo.GetByTeamID(ctx, teamID.String())
+	if err == domain.ErrAcademyNotFound {
+		academy = domain.NewAcademy(teamID)
+		err = uc.academyRepo.Save(ctx, academy)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return academy, nil
+}
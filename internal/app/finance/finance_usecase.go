@@ -0,0 +1,313 @@
+package finance
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"soccer-manager-api/internal/domain"
+	infraCache "soccer-manager-api/internal/infrastructure/cache"
+	"soccer-manager-api/internal/ports/cache"
+	"soccer-manager-api/internal/ports/repository"
+	"soccer-manager-api/pkg/logger"
+	"soccer-manager-api/pkg/namegen"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+
+type FinanceUseCase struct {
+	financeRepo     repository.FinanceRepository
+	sponsorshipRepo repository.SponsorshipRepository
+	matchRepo       repository.MatchRepository
+	contractRepo    repository.ContractRepository
+	facilityRepo    repository.FacilityRepository
+	teamRepo        repository.TeamRepository
+	transactor      repository.Transactor
+	cache           cache.Cache
+	cacheHelper     *infraCache.CacheHelper
+}
+
+
+func NewFinanceUseCase(
+	financeRepo repository.FinanceRepository,
+	sponsorshipRepo repository.SponsorshipRepository,
+	matchRepo repository.MatchRepository,
+	contractRepo repository.ContractRepository,
+	facilityRepo repository.FacilityRepository,
+	teamRepo repository.TeamRepository,
+	transactor repository.Transactor,
+	cache cache.Cache,
+) *FinanceUseCase {
+	return &FinanceUseCase{
+		financeRepo:     financeRepo,
+		sponsorshipRepo: sponsorshipRepo,
+		matchRepo:       matchRepo,
+		contractRepo:    contractRepo,
+		facilityRepo:    facilityRepo,
+		teamRepo:        teamRepo,
+		transactor:      transactor,
+		cache:           cache,
+		cacheHelper:     infraCache.NewCacheHelper(cache),
+	}
+}
+
+
+type AwardPrizeRequest struct {
+	TeamID      string `json:"team_id" binding:"required"`
+	Competition string `json:"competition" binding:"required"`
+	Position    int    `json:"position" binding:"required,min=1"`
+}
+
+
+func (uc *FinanceUseCase) GetTransactions(ctx context.Context, teamID string) ([]*domain.FinanceTransaction, error) {
+	team, err := uc.teamRepo.GetByID(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	return uc.financeRepo.GetTransactionsByTeamID(ctx, team.ID.String())
+}
+
+
+func (uc *FinanceUseCase) GetForecast(ctx context.Context, teamID string, weeks int) (*domain.FinanceForecast, error) {
+	team, err := uc.teamRepo.GetByID(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	if weeks <= 0 {
+		weeks = domain.DefaultForecastWeeks
+	}
+	if weeks > domain.MaxForecastWeeks {
+		weeks = domain.MaxForecastWeeks
+	}
+	forecast := &domain.FinanceForecast{Weeks: weeks, Budget: team.Budget}
+
+
+	fixtures, err := uc.matchRepo.GetScheduledByTeamID(ctx, team.ID.String(), time.Now().AddDate(0, 0, 7*weeks))
+	if err != nil {
+		return nil, err
+	}
+	for _, match := range fixtures {
+		if match.HomeTeamID == team.ID {
+			forecast.HomeFixtures++
+		}
+	}
+	facilities, err := uc.facilityRepo.GetByTeamID(ctx, team.ID.String())
+	if err == domain.ErrFacilitiesNotFound {
+		facilities, err = domain.NewFacilities(team.ID), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	forecast.GateReceipts = float64(forecast.HomeFixtures) * domain.ExpectedGateReceipts(facilities.StadiumCapacity(), facilities.TicketPrice)
+
+
+	deal, err := uc.sponsorshipRepo.GetActiveDealByTeamID(ctx, team.ID.String())
+	if err != nil && err != domain.ErrSponsorshipNotFound {
+		return nil, err
+	}
+	if deal != nil {
+		payments := 0
+		switch deal.Schedule {
+		case domain.SponsorshipWeekly:
+			payments = weeks
+		case domain.SponsorshipPerMatch:
+			payments = len(fixtures)
+		}
+		if payments > deal.RemainingPayments() {
+			payments = deal.RemainingPayments()
+		}
+		forecast.Sponsorship = float64(payments) * deal.Amount
+	}
+
+
+	contracts, err := uc.contractRepo.GetActiveByTeamID(ctx, team.ID.String())
+	if err != nil {
+		return nil, err
+	}
+	for _, contract := range contracts {
+		forecast.Wages -= contract.WeeklyWage * float64(weeks)
+	}
+
+
+	forecast.Net = forecast.Sponsorship + forecast.GateReceipts + forecast.Wages
+	forecast.Projected = forecast.Budget + forecast.Net
+
+	return forecast, nil
+}
+
+
+func (uc *FinanceUseCase) GetSponsorships(ctx context.Context, teamID string) (*domain.Sponsorships, error) {
+	team, err := uc.teamRepo.GetByID(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	deal, err := uc.sponsorshipRepo.GetActiveDealByTeamID(ctx, team.ID.String())
+	if err != nil && err != domain.ErrSponsorshipNotFound {
+		return nil, err
+	}
+	if deal != nil {
+		return &domain.Sponsorships{Deal: deal, Offers: []*domain.SponsorshipOffer{}}, nil
+	}
+
+
+	offers, err := uc.sponsorshipRepo.GetOffersByTeamID(ctx, team.ID.String(), time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if len(offers) == 0 {
+		if err := uc.sponsorshipRepo.DeleteOffersByTeamID(ctx, team.ID.String()); err != nil {
+			return nil, err
+		}
+		offers = domain.NewSponsorshipOffers(team.ID, namegen.SponsorNames(3))
+		if err := uc.sponsorshipRepo.CreateOffers(ctx, offers); err != nil {
+			return nil, err
+		}
+	}
+
+	return &domain.Sponsorships{Offers: offers}, nil
+}
+
+
+func (uc *FinanceUseCase) AcceptSponsorship(ctx context.Context, teamID, offerID string) (*domain.SponsorshipDeal, error) {
+	team, err := uc.teamRepo.GetByID(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+
+	offer, err := uc.sponsorshipRepo.GetOfferByID(ctx, offerID)
+	if err != nil {
+		return nil, err
+	}
+	if offer.TeamID != team.ID || offer.IsExpiredAt(time.Now()) {
+		return nil, domain.ErrSponsorshipNotFound
+	}
+
+
+	if _, err := uc.sponsorshipRepo.GetActiveDealByTeamID(ctx, team.ID.String()); err == nil {
+		return nil, domain.ErrSponsorshipActive
+	} else if err != domain.ErrSponsorshipNotFound {
+		return nil, err
+	}
+
+
+	deal := offer.Accept()
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.sponsorshipRepo.CreateDeal(ctx, deal); err != nil {
+			return err
+		}
+		if err := uc.sponsorshipRepo.DeleteOffersByTeamID(ctx, team.ID.String()); err != nil {
+			return err
+		}
+
+		if deal.Schedule == domain.SponsorshipUpfront {
+			return uc.paySponsor(ctx, deal, &deal.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return deal, nil
+}
+
+
+func (uc *FinanceUseCase) RunSponsorshipPayments(ctx context.Context) (int, error) {
+	now := time.Now()
+	deals, err := uc.sponsorshipRepo.GetDueDeals(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+
+	paid := 0
+	for _, deal := range deals {
+		for deal.IsDueAt(now) {
+			err := uc.paySponsor(ctx, deal, &deal.ID)
+			if err == domain.ErrSponsorshipAlreadyPaid {
+				break
+			}
+			if err != nil {
+				return paid, err
+			}
+			paid++
+		}
+	}
+
+	logger.Logger.Info("Sponsorship payments completed", zap.Int("deals", len(deals)), zap.Int("payments", paid))
+
+	return paid, nil
+}
+
+
+func (uc *FinanceUseCase) AwardPrize(ctx context.Context, req AwardPrizeRequest) (*domain.FinanceTransaction, error) {
+	team, err := uc.teamRepo.GetByID(ctx, req.TeamID)
+	if err != nil {
+		return nil, err
+	}
+
+	competition := domain.Competition(req.Competition)
+	amount, err := domain.PrizeMoney(competition, req.Position)
+	if err != nil {
+		return nil, err
+	}
+
+	description := fmt.Sprintf("%s prize money: position %d", competition, req.Position)
+	return uc.credit(ctx, team.ID, domain.FinancePrizeMoney, amount, description, nil)
+}
+
+
+func (uc *FinanceUseCase) CreditMatchIncome(ctx context.Context, match *domain.Match) error {
+	if match.GateReceipts > 0 {
+		description := fmt.Sprintf("Gate receipts: %d spectators", match.Attendance)
+		if _, err := uc.credit(ctx, match.HomeTeamID, domain.FinanceGateReceipts, match.GateReceipts, description, &match.ID); err != nil {
+			return err
+		}
+	}
+
+	for _, teamID := range []uuid.UUID{match.HomeTeamID, match.AwayTeamID} {
+		deal, err := uc.sponsorshipRepo.GetActiveDealByTeamID(ctx, teamID.String())
+		if err == domain.ErrSponsorshipNotFound {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if deal.Schedule != domain.SponsorshipPerMatch {
+			continue
+		}
+		if err := uc.paySponsor(ctx, deal, &match.ID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (uc *FinanceUseCase) paySponsor(ctx context.Context, deal *domain.SponsorshipDeal, referenceID *uuid.UUID) error {
+	return uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		deal.RecordPayment()
+		if err := uc.sponsorshipRepo.UpdateDeal(ctx, deal); err != nil {
+			return err
+		}
+
+		description := fmt.Sprintf("%s sponsorship (%d/%d)", deal.SponsorName, deal.PaymentsMade, deal.PaymentsTotal)
+		_, err := uc.credit(ctx, deal.TeamID, domain.FinanceSponsorship, deal.Amount, description, referenceID)
+		return err
+	})
+}
+
+func (uc *FinanceUseCase) credit(ctx context.Context, teamID uuid.UUID, category domain.FinanceCategory, amount float64, description string, referenceID *uuid.UUID) (*domain.FinanceTransaction, error) {
+	transaction := domain.NewFinanceTransaction(teamID, category, amount, description, referenceID)
+	if err := uc.financeRepo.ApplyTransaction(ctx, transaction); err != nil {
+		return nil, err
+	}
+
+	uc.cacheHelper.InvalidateTeamCache(ctx, teamID.String())
+
+	return transaction, nil
+}
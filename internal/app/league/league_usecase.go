@@ -0,0 +1,278 @@
+package league
+
+import (
+	"context"
+
+	"soccer-manager-api/internal/domain"
+	infraCache "soccer-manager-api/internal/infrastructure/cache"
+	"soccer-manager-api/internal/ports/cache"
+	"soccer-manager-api/internal/ports/repository"
+)
+
+
+type LeagueUseCase struct {
+	leagueRepo  repository.LeagueRepository
+	teamRepo    repository.TeamRepository
+	cache       cache.Cache
+	cacheHelper *infraCache.CacheHelper
+}
+
+
+func NewLeagueUseCase(
+	leagueRepo repository.LeagueRepository,
+	teamRepo repository.TeamRepository,
+	cache cache.Cache,
+) *LeagueUseCase {
+	return &LeagueUseCase{
+		leagueRepo:  leagueRepo,
+		teamRepo:    teamRepo,
+		cache:       cache,
+		cacheHelper: infraCache.NewCacheHelper(cache),
+	}
+}
+
+
+type LeagueSettingsRequest struct {
+	TransferBudgetCap   *float64 `json:"transfer_budget_cap"`
+	MaxSquadValue       *float64 `json:"max_squad_value"`
+	LeagueOnlyTransfers bool     `json:"league_only_transfers"`
+}
+
+
+type CreateLeagueRequest struct {
+	Name string `json:"name" binding:"required"`
+	LeagueSettingsRequest
+}
+
+
+type JoinLeagueRequest struct {
+	InviteCode string `json:"invite_code" binding:"required"`
+}
+
+
+func (uc *LeagueUseCase) CreateLeague(ctx context.Context, teamID string, req CreateLeagueRequest) (*domain.LeagueWithMembers, error) {
+	team, err := uc.teamRepo.GetByID(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.ensureNotInLeague(ctx, team); err != nil {
+		return nil, err
+	}
+
+
+	league, err := domain.NewLeague(team.UserID, req.Name, settings(req.LeagueSettingsRequest))
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.leagueRepo.Create(ctx, league); err != nil {
+		return nil, err
+	}
+	if err := uc.leagueRepo.AddMember(ctx, league.ID.String(), team.ID.String()); err != nil {
+		return nil, err
+	}
+
+
+	uc.cacheHelper.InvalidateTransferListCache(ctx)
+
+	return &domain.LeagueWithMembers{League: *league, Members: []*domain.Team{team}}, nil
+}
+
+
+func (uc *LeagueUseCase) JoinLeague(ctx context.Context, teamID string, req JoinLeagueRequest) (*domain.LeagueWithMembers, error) {
+	team, err := uc.teamRepo.GetByID(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.ensureNotInLeague(ctx, team); err != nil {
+		return nil, err
+	}
+
+
+	league, err := uc.leagueRepo.GetByInviteCode(ctx, req.InviteCode)
+	if err != nil {
+		return nil, err
+	}
+	members, err := uc.leagueRepo.GetMembers(ctx, league.ID.String())
+	if err != nil {
+		return nil, err
+	}
+	if len(members) >= domain.MaxLeagueMembers {
+		return nil, domain.ErrLeagueFull
+	}
+
+
+	if err := uc.leagueRepo.AddMember(ctx, league.ID.String(), team.ID.String()); err != nil {
+		return nil, err
+	}
+
+
+	uc.cacheHelper.InvalidateTransferListCache(ctx)
+
+	return &domain.LeagueWithMembers{League: *league, Members: append(members, team)}, nil
+}
+
+
+func (uc *LeagueUseCase) GetLeague(ctx context.Context, teamID string) (*domain.LeagueWithMembers, error) {
+	_, league, err := uc.teamLeague(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := uc.leagueRepo.GetMembers(ctx, league.ID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.LeagueWithMembers{League: *league, Members: members}, nil
+}
+
+
+func (uc *LeagueUseCase) UpdateSettings(ctx context.Context, teamID string, req LeagueSettingsRequest) (*domain.League, error) {
+	league, err := uc.commissionedLeague(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := league.UpdateSettings(settings(req)); err != nil {
+		return nil, err
+	}
+	if err := uc.leagueRepo.Update(ctx, league); err != nil {
+		return nil, err
+	}
+
+
+	uc.cacheHelper.InvalidateTransferListCache(ctx)
+
+	return league, nil
+}
+
+
+func (uc *LeagueUseCase) RegenerateInviteCode(ctx context.Context, teamID string) (*domain.League, error) {
+	league, err := uc.commissionedLeague(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	league.InviteCode, err = domain.NewInviteCode()
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.leagueRepo.Update(ctx, league); err != nil {
+		return nil, err
+	}
+
+	return league, nil
+}
+
+
+func (uc *LeagueUseCase) LeaveLeague(ctx context.Context, teamID string) error {
+	team, league, err := uc.teamLeague(ctx, teamID)
+	if err != nil {
+		return err
+	}
+	if league.IsCommissioner(team.UserID) {
+		return domain.ErrCommissionerCannotLeave
+	}
+
+	if err := uc.leagueRepo.RemoveMember(ctx, league.ID.String(), team.ID.String()); err != nil {
+		return err
+	}
+
+
+	uc.cacheHelper.InvalidateTransferListCache(ctx)
+
+	return nil
+}
+
+
+func (uc *LeagueUseCase) RemoveMember(ctx context.Context, teamID, memberID string) error {
+	league, err := uc.commissionedLeague(ctx, teamID)
+	if err != nil {
+		return err
+	}
+
+	member, err := uc.teamRepo.GetByID(ctx, memberID)
+	if err != nil {
+		return err
+	}
+	if league.IsCommissioner(member.UserID) {
+		return domain.ErrCommissionerCannotLeave
+	}
+	memberLeague, err := uc.leagueRepo.GetByTeamID(ctx, memberID)
+	if err == domain.ErrLeagueNotFound || (err == nil && memberLeague.ID != league.ID) {
+		return domain.ErrTeamNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+
+	if err := uc.leagueRepo.RemoveMember(ctx, league.ID.String(), memberID); err != nil {
+		return err
+	}
+
+
+	uc.cacheHelper.InvalidateTransferListCache(ctx)
+
+	return nil
+}
+
+
+func (uc *LeagueUseCase) DisbandLeague(ctx context.Context, teamID string) error {
+	league, err := uc.commissionedLeague(ctx, teamID)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.leagueRepo.Delete(ctx, league.ID.String()); err != nil {
+		return err
+	}
+
+
+	uc.cacheHelper.InvalidateTransferListCache(ctx)
+
+	return nil
+}
+
+func (uc *LeagueUseCase) teamLeague(ctx context.Context, teamID string) (*domain.Team, *domain.League, error) {
+	team, err := uc.teamRepo.GetByID(ctx, teamID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	league, err := uc.leagueRepo.GetByTeamID(ctx, team.ID.String())
+	if err != nil {
+		return nil, nil, err
+	}
+	return team, league, nil
+}
+
+func (uc *LeagueUseCase) commissionedLeague(ctx context.Context, teamID string) (*domain.League, error) {
+	team, league, err := uc.teamLeague(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	if !league.IsCommissioner(team.UserID) {
+		return nil, domain.ErrNotCommissioner
+	}
+	return league, nil
+}
+
+func (uc *LeagueUseCase) ensureNotInLeague(ctx context.Context, team *domain.Team) error {
+	_, err := uc.leagueRepo.GetByTeamID(ctx, team.ID.String())
+	if err == nil {
+		return domain.ErrAlreadyInLeague
+	}
+	if err != domain.ErrLeagueNotFound {
+		return err
+	}
+	return nil
+}
+
+func settings(req LeagueSettingsRequest) domain.LeagueSettings {
+	return domain.LeagueSettings{
+		TransferBudgetCap:   req.TransferBudgetCap,
+		MaxSquadValue:       req.MaxSquadValue,
+		LeagueOnlyTransfers: req.LeagueOnlyTransfers,
+	}
+}
@@ -0,0 +1,126 @@
+package lineup
+
+import (
+	"context"
+
+	"soccer-manager-api/internal/domain"
+	infraCache "soccer-manager-api/internal/infrastructure/cache"
+	"soccer-manager-api/internal/ports/cache"
+	"soccer-manager-api/internal/ports/repository"
+
+	"github.com/google/uuid"
+)
+
+
+type LineupUseCase struct {
+	lineupRepo  repository.LineupRepository
+	teamRepo    repository.TeamRepository
+	playerRepo  repository.PlayerRepository
+	cache       cache.Cache
+	cacheHelper *infraCache.CacheHelper
+}
+
+
+func NewLineupUseCase(
+	lineupRepo repository.LineupRepository,
+	teamRepo repository.TeamRepository,
+	playerRepo repository.PlayerRepository,
+	cache cache.Cache,
+) *LineupUseCase {
+	return &LineupUseCase{
+		lineupRepo:  lineupRepo,
+		teamRepo:    teamRepo,
+		playerRepo:  playerRepo,
+		cache:       cache,
+		cacheHelper: infraCache.NewCacheHelper(cache),
+	}
+}
+
+
+type UpdateLineupRequest struct {
+	Formation   string   `json:"formation" binding:"required"`
+	Starters    []string `json:"starters" binding:"required"`
+	Substitutes []string `json:"substitutes"`
+}
+
+
+func (uc *LineupUseCase) GetLineup(ctx context.Context, teamID string) (*domain.Lineup, error) {
+	team, err := uc.teamRepo.GetByID(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.GetLineupByTeamID(ctx, team.ID.String())
+}
+
+
+func (uc *LineupUseCase) GetLineupByTeamID(ctx context.Context, teamID string) (*domain.Lineup, error) {
+	cacheKey := infraCache.CacheKey("team:lineup", teamID)
+	var lineup domain.Lineup
+	if err := uc.cacheHelper.Get(ctx, cacheKey, &lineup); err == nil {
+		return &lineup, nil
+	}
+
+	result, err := uc.lineupRepo.GetByTeamID(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.cacheHelper.Set(ctx, cacheKey, result, 300)
+
+	return result, nil
+}
+
+
+func (uc *LineupUseCase) UpdateLineup(ctx context.Context, teamID string, req UpdateLineupRequest) (*domain.Lineup, error) {
+	team, err := uc.teamRepo.GetByID(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	starters, err := parsePlayerIDs(req.Starters)
+	if err != nil {
+		return nil, err
+	}
+	substitutes, err := parsePlayerIDs(req.Substitutes)
+	if err != nil {
+		return nil, err
+	}
+
+
+	players, err := uc.playerRepo.GetByTeamID(ctx, team.ID.String())
+	if err != nil {
+		return nil, err
+	}
+	squad := make(map[uuid.UUID]*domain.Player, len(players))
+	for _, player := range players {
+		squad[player.ID] = player
+	}
+
+
+	lineup := domain.NewLineup(team.ID, domain.Formation(req.Formation), starters, substitutes)
+	if err := lineup.Validate(squad); err != nil {
+		return nil, err
+	}
+
+	if err := uc.lineupRepo.Save(ctx, lineup); err != nil {
+		return nil, err
+	}
+
+
+	uc.cacheHelper.InvalidateTeamCache(ctx, team.ID.String())
+
+	return lineup, nil
+}
+
+func parsePlayerIDs(ids []string) ([]uuid.UUID, error) {
+	parsed := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		playerID, err := uuid.Parse(id)
+		if err != nil {
+			return nil, domain.ErrInvalidLineup
+		}
+		parsed = append(parsed, playerID)
+	}
+	return parsed, nil
+}
@@ -0,0 +1,232 @@
+package match
+
+import (
+	"context"
+	"time"
+
+	"soccer-manager-api/internal/app/availability"
+	"soccer-manager-api/internal/app/finance"
+	"soccer-manager-api/internal/app/morale"
+	"soccer-manager-api/internal/app/ranking"
+	"soccer-manager-api/internal/app/stats"
+	"soccer-manager-api/internal/domain"
+	"soccer-manager-api/internal/ports/repository"
+	"soccer-manager-api/pkg/logger"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+
+type MatchUseCase struct {
+	matchRepo           repository.MatchRepository
+	teamRepo            repository.TeamRepository
+	facilityRepo        repository.FacilityRepository
+	transactor          repository.Transactor
+	financeUseCase      *finance.FinanceUseCase
+	availabilityUseCase *availability.AvailabilityUseCase
+	statsUseCase        *stats.StatsUseCase
+	moraleUseCase       *morale.MoraleUseCase
+	rankingUseCase      *ranking.RankingUseCase
+}
+
+
+func NewMatchUseCase(
+	matchRepo repository.MatchRepository,
+	teamRepo repository.TeamRepository,
+	facilityRepo repository.FacilityRepository,
+	transactor repository.Transactor,
+	financeUseCase *finance.FinanceUseCase,
+	availabilityUseCase *availability.AvailabilityUseCase,
+	statsUseCase *stats.StatsUseCase,
+	moraleUseCase *morale.MoraleUseCase,
+	rankingUseCase *ranking.RankingUseCase,
+) *MatchUseCase {
+	return &MatchUseCase{
+		matchRepo:           matchRepo,
+		teamRepo:            teamRepo,
+		facilityRepo:        facilityRepo,
+		transactor:          transactor,
+		financeUseCase:      financeUseCase,
+		availabilityUseCase: availabilityUseCase,
+		statsUseCase:        statsUseCase,
+		moraleUseCase:       moraleUseCase,
+		rankingUseCase:      rankingUseCase,
+	}
+}
+
+
+type ScheduleMatchRequest struct {
+	HomeTeamID  string    `json:"home_team_id" binding:"required"`
+	AwayTeamID  string    `json:"away_team_id" binding:"required"`
+	Competition string    `json:"competition" binding:"required"`
+	ScheduledAt time.Time `json:"scheduled_at" binding:"required"`
+}
+
+
+type RecordResultRequest struct {
+	HomeGoals int `json:"home_goals" binding:"min=0"`
+	AwayGoals int `json:"away_goals" binding:"min=0"`
+}
+
+
+type MatchReport struct {
+	*domain.Match
+	PlayerStats []*domain.PlayerMatchStats `json:"player_stats"`
+}
+
+
+func (uc *MatchUseCase) GetTeamMatches(ctx context.Context, teamID string) ([]*domain.Match, error) {
+	team, err := uc.teamRepo.GetByID(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	return uc.matchRepo.GetByTeamID(ctx, team.ID.String())
+}
+
+
+func (uc *MatchUseCase) ScheduleMatch(ctx context.Context, req ScheduleMatchRequest) (*domain.Match, error) {
+	homeTeam, err := uc.teamRepo.GetByID(ctx, req.HomeTeamID)
+	if err != nil {
+		return nil, err
+	}
+	awayTeam, err := uc.teamRepo.GetByID(ctx, req.AwayTeamID)
+	if err != nil {
+		return nil, err
+	}
+
+
+	match, err := domain.NewMatch(homeTeam.ID, awayTeam.ID, domain.Competition(req.Competition), req.ScheduledAt)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.matchRepo.Create(ctx, match); err != nil {
+		return nil, err
+	}
+
+	return match, nil
+}
+
+
+func (uc *MatchUseCase) RecordResult(ctx context.Context, matchID string, req RecordResultRequest) (*MatchReport, error) {
+	match, err := uc.matchRepo.GetByID(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+	return uc.record(ctx, match, req.HomeGoals, req.AwayGoals)
+}
+
+
+func (uc *MatchUseCase) SimulateResult(ctx context.Context, matchID string) (*MatchReport, error) {
+	match, err := uc.matchRepo.GetByID(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+	return uc.simulate(ctx, match)
+}
+
+
+func (uc *MatchUseCase) RunMatchday(ctx context.Context) (int, error) {
+	matches, err := uc.matchRepo.GetDue(ctx, time.Now())
+	if err != nil {
+		return 0, err
+	}
+
+	played := 0
+	for _, match := range matches {
+		_, err := uc.simulate(ctx, match)
+		if err == domain.ErrMatchAlreadyPlayed {
+			continue
+		}
+		if err != nil {
+			return played, err
+		}
+		played++
+	}
+
+	logger.Logger.Info("Matchday processed", zap.Int("matches", played))
+
+	return played, nil
+}
+
+func (uc *MatchUseCase) simulate(ctx context.Context, match *domain.Match) (*MatchReport, error) {
+	strengths := make([]float64, 0, 2)
+	for _, teamID := range []uuid.UUID{match.HomeTeamID, match.AwayTeamID} {
+		starters, _, chemistry, err := uc.statsUseCase.MatchdaySquad(ctx, teamID)
+		if err != nil {
+			return nil, err
+		}
+		strengths = append(strengths, domain.TeamStrength(starters, chemistry.Score))
+	}
+
+	homeGoals, awayGoals := domain.SimulateScore(strengths[0], strengths[1])
+	return uc.record(ctx, match, homeGoals, awayGoals)
+}
+
+func (uc *MatchUseCase) record(ctx context.Context, match *domain.Match, homeGoals, awayGoals int) (*MatchReport, error) {
+	facilities, err := uc.facilityRepo.GetByTeamID(ctx, match.HomeTeamID.String())
+	if err == domain.ErrFacilitiesNotFound {
+		facilities, err = domain.NewFacilities(match.HomeTeamID), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+
+	if err := match.RecordResult(homeGoals, awayGoals); err != nil {
+		return nil, err
+	}
+	match.SellTickets(facilities.StadiumCapacity(), facilities.TicketPrice)
+
+
+	var playerStats []*domain.PlayerMatchStats
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.matchRepo.RecordResult(ctx, match); err != nil {
+			return err
+		}
+		if err := uc.financeUseCase.CreditMatchIncome(ctx, match); err != nil {
+			return err
+		}
+
+
+		stats, err := uc.statsUseCase.RecordMatch(ctx, match)
+		if err != nil {
+			return err
+		}
+		playerStats = stats
+		if err := uc.moraleUseCase.ApplyMatch(ctx, match, playerStats); err != nil {
+			return err
+		}
+		if !match.IsCompetitive() {
+			return nil
+		}
+
+
+		if err := uc.rankingUseCase.ApplyMatch(ctx, match); err != nil {
+			return err
+		}
+
+		if _, err := uc.availabilityUseCase.ServeMatch(ctx, match.HomeTeamID.String()); err != nil {
+			return err
+		}
+		if _, err := uc.availabilityUseCase.ServeMatch(ctx, match.AwayTeamID.String()); err != nil {
+			return err
+		}
+
+		for _, line := range playerStats {
+			if line.RedCards == 0 {
+				continue
+			}
+			suspension := availability.RecordSuspensionRequest{Matches: domain.RedCardSuspensionMatches, Reason: "Sent off"}
+			if _, err := uc.availabilityUseCase.RecordSuspension(ctx, line.PlayerID.String(), suspension); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &MatchReport{Match: match, PlayerStats: playerStats}, nil
+}
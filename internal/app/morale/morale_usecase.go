@@ -0,0 +1,266 @@
+package morale
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"soccer-manager-api/internal/domain"
+	infraCache "soccer-manager-api/internal/infrastructure/cache"
+	"soccer-manager-api/internal/ports/cache"
+	"soccer-manager-api/internal/ports/repository"
+	"soccer-manager-api/pkg/logger"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+
+type MoraleUseCase struct {
+	moraleRepo   repository.MoraleRepository
+	playerRepo   repository.PlayerRepository
+	teamRepo     repository.TeamRepository
+	transferRepo repository.TransferRepository
+	cache        cache.Cache
+	cacheHelper  *infraCache.CacheHelper
+}
+
+
+func NewMoraleUseCase(
+	moraleRepo repository.MoraleRepository,
+	playerRepo repository.PlayerRepository,
+	teamRepo repository.TeamRepository,
+	transferRepo repository.TransferRepository,
+	cache cache.Cache,
+) *MoraleUseCase {
+	return &MoraleUseCase{
+		moraleRepo:   moraleRepo,
+		playerRepo:   playerRepo,
+		teamRepo:     teamRepo,
+		transferRepo: transferRepo,
+		cache:        cache,
+		cacheHelper:  infraCache.NewCacheHelper(cache),
+	}
+}
+
+
+func (uc *MoraleUseCase) GetTeamMorale(ctx context.Context, teamID string) (*domain.TeamMorale, error) {
+	team, err := uc.teamRepo.GetByID(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	players, err := uc.playerRepo.GetByTeamID(ctx, team.ID.String())
+	if err != nil {
+		return nil, err
+	}
+
+
+	now := time.Now()
+	totals, err := uc.moraleRepo.GetDriverTotals(ctx, team.ID.String(), now.AddDate(0, 0, -domain.MoraleDriverWindowDays))
+	if err != nil {
+		return nil, err
+	}
+	drivers := make(map[uuid.UUID]map[domain.MoraleDriver]int)
+	for _, total := range totals {
+		if drivers[total.PlayerID] == nil {
+			drivers[total.PlayerID] = make(map[domain.MoraleDriver]int)
+		}
+		drivers[total.PlayerID][total.Driver] = total.Total
+	}
+
+
+	result := &domain.TeamMorale{
+		TeamID:    team.ID,
+		Chemistry: domain.NewTeamChemistry(players, now),
+		Players:   make([]*domain.PlayerMorale, 0, len(players)),
+	}
+	sum := 0
+	for _, player := range players {
+		playerDrivers := drivers[player.ID]
+		if playerDrivers == nil {
+			playerDrivers = make(map[domain.MoraleDriver]int)
+		}
+		result.Players = append(result.Players, &domain.PlayerMorale{
+			PlayerID:          player.ID,
+			FirstName:         player.FirstName,
+			LastName:          player.LastName,
+			Morale:            player.Morale,
+			Status:            domain.MoraleStatus(player.Morale),
+			DaysAtClub:        int(player.DaysAtClub(now)),
+			TransferRequested: player.WantsTransfer(),
+			Drivers:           playerDrivers,
+		})
+		sum += player.Morale
+	}
+	if len(players) > 0 {
+		result.AverageMorale = int(math.Round(float64(sum) / float64(len(players))))
+	}
+
+	return result, nil
+}
+
+
+func (uc *MoraleUseCase) Adjust(ctx context.Context, player *domain.Player, driver domain.MoraleDriver, delta int, description string) error {
+	if err := uc.playerRepo.AdjustMorale(ctx, player, delta); err != nil {
+		return err
+	}
+
+	event := domain.NewMoraleEvent(player, driver, delta, description)
+	if err := uc.moraleRepo.CreateBatch(ctx, []*domain.MoraleEvent{event}); err != nil {
+		return err
+	}
+
+	return uc.checkTransferRequest(ctx, player)
+}
+
+
+func (uc *MoraleUseCase) ApplyMatch(ctx context.Context, match *domain.Match, stats []*domain.PlayerMatchStats) error {
+	appeared := make(map[uuid.UUID]*domain.PlayerMatchStats, len(stats))
+	picked := make(map[uuid.UUID]bool)
+	for _, line := range stats {
+		appeared[line.PlayerID] = line
+		picked[line.TeamID] = true
+	}
+
+
+	events := make([]*domain.MoraleEvent, 0)
+	for _, teamID := range []uuid.UUID{match.HomeTeamID, match.AwayTeamID} {
+		players, err := uc.playerRepo.GetByTeamID(ctx, teamID.String())
+		if err != nil {
+			return err
+		}
+
+		goalsFor, goalsAgainst := match.GoalsFor(teamID)
+		for _, player := range players {
+			changes := make([]*domain.MoraleEvent, 0, 2)
+			total := 0
+			record := func(driver domain.MoraleDriver, delta int, description string) {
+				player.AdjustMorale(delta)
+				total += delta
+				changes = append(changes, domain.NewMoraleEvent(player, driver, delta, description))
+			}
+
+			if picked[teamID] && player.IsAvailable() {
+				line, ok := appeared[player.ID]
+				switch {
+				case ok && line.Started:
+					record(domain.MoraleDriverPlayingTime, domain.MoraleStarted, "Started the match")
+				case ok:
+					record(domain.MoraleDriverPlayingTime, domain.MoraleSubstitute, "Came on as a substitute")
+				default:
+					record(domain.MoraleDriverPlayingTime, domain.MoraleLeftOut, "Left out of the matchday squad")
+				}
+			}
+			switch {
+			case goalsFor > goalsAgainst:
+				record(domain.MoraleDriverResults, domain.MoraleWin, "Won the match")
+			case goalsFor < goalsAgainst:
+				record(domain.MoraleDriverResults, domain.MoraleLoss, "Lost the match")
+			}
+			if len(changes) == 0 {
+				continue
+			}
+
+			if err := uc.playerRepo.AdjustMorale(ctx, player, total); err != nil {
+				return err
+			}
+			if err := uc.checkTransferRequest(ctx, player); err != nil {
+				return err
+			}
+			events = append(events, changes...)
+		}
+	}
+
+	return uc.moraleRepo.CreateBatch(ctx, events)
+}
+
+
+func (uc *MoraleUseCase) ReviewWages(ctx context.Context, teamID string, contracts []*domain.Contract) error {
+	players, err := uc.playerRepo.GetByTeamID(ctx, teamID)
+	if err != nil {
+		return err
+	}
+	squad := make(map[uuid.UUID]*domain.Player, len(players))
+	for _, player := range players {
+		squad[player.ID] = player
+	}
+
+
+	for _, contract := range contracts {
+		player, ok := squad[contract.PlayerID]
+		if !ok {
+			continue
+		}
+
+		standard := domain.StandardWage(player)
+		switch {
+		case contract.WeeklyWage < standard:
+			err = uc.Adjust(ctx, player, domain.MoraleDriverWages, domain.MoraleUnderpaid, "Paid below market wage")
+		case contract.WeeklyWage >= standard*domain.WellPaidWageRatio:
+			err = uc.Adjust(ctx, player, domain.MoraleDriverWages, domain.MoraleWellPaid, "Well paid")
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (uc *MoraleUseCase) RunRecovery(ctx context.Context) (int, error) {
+	players, err := uc.playerRepo.GetActive(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	recovered := 0
+	for _, player := range players {
+		delta := domain.MoraleRecovery(player.Morale)
+		if delta == 0 {
+			continue
+		}
+		if err := uc.Adjust(ctx, player, domain.MoraleDriverRecovery, delta, "Settled back towards normal"); err != nil {
+			return recovered, err
+		}
+		if player.TeamID != nil {
+			uc.cacheHelper.InvalidateTeamCache(ctx, player.TeamID.String())
+		}
+		recovered++
+	}
+
+	logger.Logger.Info("Morale recovery processed", zap.Int("players", recovered))
+
+	return recovered, nil
+}
+
+func (uc *MoraleUseCase) checkTransferRequest(ctx context.Context, player *domain.Player) error {
+	if !player.WantsTransfer() {
+		return nil
+	}
+
+	existingListing, _ := uc.transferRepo.GetListingByPlayerID(ctx, player.ID.String())
+	err := domain.ValidateListing(player, *player.TeamID, existingListing, player.MarketValue)
+	if err == domain.ErrPlayerAlreadyListed {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+
+	listing := domain.NewTransferListing(player.ID, player.MarketValue)
+	if err := uc.transferRepo.CreateListing(ctx, listing); err != nil {
+		return err
+	}
+
+	logger.Logger.Info("Unhappy player submitted a transfer request",
+		zap.String("player_id", player.ID.String()),
+		zap.Int("morale", player.Morale),
+	)
+
+	uc.cacheHelper.InvalidateTeamCache(ctx, player.TeamID.String())
+	uc.cacheHelper.InvalidateTransferListCache(ctx)
+
+	return nil
+}
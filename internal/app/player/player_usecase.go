@@ -3,6 +3,7 @@ package player
 import (
 	"context"
 
+	"soccer-manager-api/internal/app/scouting"
 	"soccer-manager-api/internal/domain"
 	infraCache "soccer-manager-api/internal/infrastructure/cache"
 	"soccer-manager-api/internal/ports/cache"
@@ -11,23 +12,29 @@ import (
 
 
 type PlayerUseCase struct {
-	playerRepo repository.PlayerRepository
-	teamRepo   repository.TeamRepository
-	cache      cache.Cache
-	cacheHelper *infraCache.CacheHelper
+	playerRepo      repository.PlayerRepository
+	teamRepo        repository.TeamRepository
+	statsRepo       repository.StatsRepository
+	scoutingUseCase *scouting.ScoutingUseCase
+	cache           cache.Cache
+	cacheHelper     *infraCache.CacheHelper
 }
 
 
 func NewPlayerUseCase(
 	playerRepo repository.PlayerRepository,
 	teamRepo repository.TeamRepository,
+	statsRepo repository.StatsRepository,
+	scoutingUseCase *scouting.ScoutingUseCase,
 	cache cache.Cache,
 ) *PlayerUseCase {
 	return &PlayerUseCase{
-		playerRepo:  playerRepo,
-		teamRepo:    teamRepo,
-		cache:       cache,
-		cacheHelper: infraCache.NewCacheHelper(cache),
+		playerRepo:      playerRepo,
+		teamRepo:        teamRepo,
+		statsRepo:       statsRepo,
+		scoutingUseCase: scoutingUseCase,
+		cache:           cache,
+		cacheHelper:     infraCache.NewCacheHelper(cache),
 	}
 }
 
@@ -39,18 +46,35 @@ type UpdatePlayerRequest struct {
 }
 
 
-func (uc *PlayerUseCase) GetPlayer(ctx context.Context, playerID string) (*domain.Player, error) {
+func (uc *PlayerUseCase) GetPlayer(ctx context.Context, teamID, playerID string) (*domain.ScoutedPlayer, error) {
+	team, err := uc.teamRepo.GetByID(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
 	player, err := uc.playerRepo.GetByID(ctx, playerID)
 	if err != nil {
 		return nil, err
 	}
-	return player, nil
+
+	stats, err := uc.statsRepo.GetPlayerTotals(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+
+
+	scouted, err := uc.scoutingUseCase.ViewPlayer(ctx, team.ID, player)
+	if err != nil {
+		return nil, err
+	}
+	scouted.Stats = stats
+	return scouted, nil
 }
 
 
-func (uc *PlayerUseCase) UpdatePlayer(ctx context.Context, userID, playerID string, req UpdatePlayerRequest) (*domain.Player, error) {
+func (uc *PlayerUseCase) UpdatePlayer(ctx context.Context, teamID, playerID string, req UpdatePlayerRequest) (*domain.Player, error) {
 
-	team, err := uc.teamRepo.GetByUserID(ctx, userID)
+	team, err := uc.teamRepo.GetByID(ctx, teamID)
 	if err != nil {
 		return nil, err
 	}
@@ -71,7 +95,7 @@ func (uc *PlayerUseCase) UpdatePlayer(ctx context.Context, userID, playerID stri
 	player.LastName = req.LastName
 	player.Country = req.Country
 
-	if err := uc.playerRepo.Update(ctx, player); err != nil {
+	if err := uc.playerRepo.UpdateProfile(ctx, player); err != nil {
 		return nil, err
 	}
 
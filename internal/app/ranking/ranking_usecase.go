@@ -0,0 +1,147 @@
+package ranking
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	"soccer-manager-api/internal/domain"
+	infraCache "soccer-manager-api/internal/infrastructure/cache"
+	"soccer-manager-api/internal/ports/cache"
+	"soccer-manager-api/internal/ports/repository"
+)
+
+
+type RankingUseCase struct {
+	teamRepo    repository.TeamRepository
+	ratingRepo  repository.RatingRepository
+	matchRepo   repository.MatchRepository
+	transactor  repository.Transactor
+	cache       cache.Cache
+	cacheHelper *infraCache.CacheHelper
+}
+
+
+func NewRankingUseCase(
+	teamRepo repository.TeamRepository,
+	ratingRepo repository.RatingRepository,
+	matchRepo repository.MatchRepository,
+	transactor repository.Transactor,
+	cache cache.Cache,
+) *RankingUseCase {
+	return &RankingUseCase{
+		teamRepo:    teamRepo,
+		ratingRepo:  ratingRepo,
+		matchRepo:   matchRepo,
+		transactor:  transactor,
+		cache:       cache,
+		cacheHelper: infraCache.NewCacheHelper(cache),
+	}
+}
+
+
+type ArrangeFriendlyRequest struct {
+	ScheduledAt time.Time `json:"scheduled_at" binding:"required"`
+}
+
+
+func (uc *RankingUseCase) GetRankings(ctx context.Context, country string, limit int) ([]*domain.TeamRanking, error) {
+	if limit <= 0 {
+		limit = domain.DefaultRankingsLimit
+	}
+	if limit > domain.MaxRankingsLimit {
+		limit = domain.MaxRankingsLimit
+	}
+
+	teams, err := uc.teamRepo.GetRankings(ctx, country, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.NewTeamRankings(teams), nil
+}
+
+
+func (uc *RankingUseCase) GetRatingHistory(ctx context.Context, teamID string) ([]*domain.RatingChange, error) {
+	team, err := uc.teamRepo.GetByID(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	return uc.ratingRepo.GetByTeamID(ctx, team.ID.String(), domain.DefaultRatingHistory)
+}
+
+
+func (uc *RankingUseCase) ApplyMatch(ctx context.Context, match *domain.Match) error {
+	if !match.IsCompetitive() {
+		return nil
+	}
+
+	var changes []*domain.RatingChange
+	err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		home, err := uc.teamRepo.GetByID(ctx, match.HomeTeamID.String())
+		if err != nil {
+			return err
+		}
+		away, err := uc.teamRepo.GetByID(ctx, match.AwayTeamID.String())
+		if err != nil {
+			return err
+		}
+
+		homeChange, awayChange := domain.NewRatingChanges(match, home, away)
+		changes = []*domain.RatingChange{homeChange, awayChange}
+		for _, change := range changes {
+			rating, err := uc.teamRepo.AdjustRating(ctx, change.TeamID.String(), change.Delta())
+			if err != nil {
+				return err
+			}
+			change.Settle(rating)
+			if err := uc.ratingRepo.Create(ctx, change); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+
+	for _, change := range changes {
+		uc.cacheHelper.InvalidateTeamCache(ctx, change.TeamID.String())
+	}
+
+	return nil
+}
+
+
+func (uc *RankingUseCase) ArrangeFriendly(ctx context.Context, teamID string, req ArrangeFriendlyRequest) (*domain.Match, error) {
+	if req.ScheduledAt.Before(time.Now()) {
+		return nil, domain.ErrInvalidMatch
+	}
+
+	team, err := uc.teamRepo.GetByID(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+
+	candidates, err := uc.teamRepo.GetNearestByRating(ctx, team.ID.String(), team.Rating, domain.FriendlyOpponentPool)
+	if err != nil {
+		return nil, err
+	}
+	if len(candidates) == 0 {
+		return nil, domain.ErrNoFriendlyOpponent
+	}
+	opponent := candidates[rand.Intn(len(candidates))]
+
+
+	match, err := domain.NewMatch(team.ID, opponent.ID, domain.CompetitionFriendly, req.ScheduledAt)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.matchRepo.Create(ctx, match); err != nil {
+		return nil, err
+	}
+
+	return match, nil
+}
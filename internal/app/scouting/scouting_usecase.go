@@ -0,0 +1,350 @@
+package scouting
+
+import (
+	"context"
+	"fmt"
+
+	"soccer-manager-api/internal/domain"
+	infraCache "soccer-manager-api/internal/infrastructure/cache"
+	"soccer-manager-api/internal/ports/cache"
+	"soccer-manager-api/internal/ports/repository"
+	"soccer-manager-api/pkg/logger"
+	"soccer-manager-api/pkg/namegen"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+
+type ScoutingUseCase struct {
+	scoutingRepo repository.ScoutingRepository
+	teamRepo     repository.TeamRepository
+	playerRepo   repository.PlayerRepository
+	financeRepo  repository.FinanceRepository
+	transactor   repository.Transactor
+	cache        cache.Cache
+	cacheHelper  *infraCache.CacheHelper
+}
+
+
+func NewScoutingUseCase(
+	scoutingRepo repository.ScoutingRepository,
+	teamRepo repository.TeamRepository,
+	playerRepo repository.PlayerRepository,
+	financeRepo repository.FinanceRepository,
+	transactor repository.Transactor,
+	cache cache.Cache,
+) *ScoutingUseCase {
+	return &ScoutingUseCase{
+		scoutingRepo: scoutingRepo,
+		teamRepo:     teamRepo,
+		playerRepo:   playerRepo,
+		financeRepo:  financeRepo,
+		transactor:   transactor,
+		cache:        cache,
+		cacheHelper:  infraCache.NewCacheHelper(cache),
+	}
+}
+
+
+type HireScoutRequest struct {
+	Skill int `json:"skill" binding:"required,min=1,max=5"`
+}
+
+
+type AssignScoutRequest struct {
+	PlayerID string `json:"player_id"`
+	Region   string `json:"region"`
+}
+
+
+func (uc *ScoutingUseCase) GetScouts(ctx context.Context, teamID string) ([]*domain.Scout, error) {
+	team, err := uc.teamRepo.GetByID(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.scoutingRepo.GetScoutsByTeamID(ctx, team.ID.String())
+}
+
+
+func (uc *ScoutingUseCase) HireScout(ctx context.Context, teamID string, req HireScoutRequest) (*domain.Scout, error) {
+	team, err := uc.teamRepo.GetByID(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	scouts, err := uc.scoutingRepo.GetScoutsByTeamID(ctx, team.ID.String())
+	if err != nil {
+		return nil, err
+	}
+	if len(scouts) >= domain.MaxScoutsPerTeam {
+		return nil, domain.ErrTooManyScouts
+	}
+
+
+	scout, err := domain.NewScout(team.ID, namegen.FirstName()+" "+namegen.LastName(), req.Skill)
+	if err != nil {
+		return nil, err
+	}
+	fee := domain.ScoutHiringFee(scout.Skill)
+	if !team.CanAfford(fee) {
+		return nil, domain.ErrInsufficientBudget
+	}
+
+
+	description := fmt.Sprintf("Hired scout %s (skill %d)", scout.Name, scout.Skill)
+	transaction := domain.NewFinanceTransaction(team.ID, domain.FinanceScouting, -fee, description, &scout.ID)
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		scoutCount, err := uc.scoutingRepo.LockScoutCount(ctx, team.ID.String())
+		if err != nil {
+			return err
+		}
+		if scoutCount >= domain.MaxScoutsPerTeam {
+			return domain.ErrTooManyScouts
+		}
+
+		if err := uc.financeRepo.ApplyTransaction(ctx, transaction); err != nil {
+			return err
+		}
+		return uc.scoutingRepo.CreateScout(ctx, scout)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+
+	uc.cacheHelper.InvalidateTeamCache(ctx, team.ID.String())
+
+	return scout, nil
+}
+
+
+func (uc *ScoutingUseCase) AssignScout(ctx context.Context, teamID, scoutID string, req AssignScoutRequest) (*domain.Scout, error) {
+	scout, err := uc.ownScout(ctx, teamID, scoutID)
+	if err != nil {
+		return nil, err
+	}
+	if (req.PlayerID == "") == (req.Region == "") {
+		return nil, domain.ErrInvalidScoutAssignment
+	}
+
+
+	if req.PlayerID != "" {
+		if _, err := uuid.Parse(req.PlayerID); err != nil {
+			return nil, domain.ErrPlayerNotFound
+		}
+		player, err := uc.playerRepo.GetByID(ctx, req.PlayerID)
+		if err != nil {
+			return nil, err
+		}
+		if player.IsOwnedBy(scout.TeamID) {
+			return nil, domain.ErrInvalidScoutAssignment
+		}
+		scout.AssignPlayer(player.ID)
+	} else if err := scout.AssignRegion(req.Region); err != nil {
+		return nil, err
+	}
+
+	if err := uc.scoutingRepo.UpdateScout(ctx, scout); err != nil {
+		return nil, err
+	}
+
+	return scout, nil
+}
+
+
+func (uc *ScoutingUseCase) UnassignScout(ctx context.Context, teamID, scoutID string) (*domain.Scout, error) {
+	scout, err := uc.ownScout(ctx, teamID, scoutID)
+	if err != nil {
+		return nil, err
+	}
+
+	scout.Unassign()
+	if err := uc.scoutingRepo.UpdateScout(ctx, scout); err != nil {
+		return nil, err
+	}
+
+	return scout, nil
+}
+
+
+func (uc *ScoutingUseCase) FireScout(ctx context.Context, teamID, scoutID string) error {
+	scout, err := uc.ownScout(ctx, teamID, scoutID)
+	if err != nil {
+		return err
+	}
+
+	return uc.scoutingRepo.DeleteScout(ctx, scout.ID.String())
+}
+
+
+func (uc *ScoutingUseCase) GetReports(ctx context.Context, teamID string) ([]*domain.ScoutedPlayer, error) {
+	team, err := uc.teamRepo.GetByID(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	reports, err := uc.scoutingRepo.GetReportsByTeamID(ctx, team.ID.String())
+	if err != nil {
+		return nil, err
+	}
+
+
+	scouted := make([]*domain.ScoutedPlayer, 0, len(reports))
+	for _, report := range reports {
+		player, err := uc.playerRepo.GetByID(ctx, report.PlayerID.String())
+		if err == domain.ErrPlayerNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if player.IsOwnedBy(team.ID) {
+			continue
+		}
+		scouted = append(scouted, domain.NewScoutedPlayer(player, team.ID, report.Accuracy))
+	}
+
+	return scouted, nil
+}
+
+
+func (uc *ScoutingUseCase) ViewPlayer(ctx context.Context, teamID uuid.UUID, player *domain.Player) (*domain.ScoutedPlayer, error) {
+	if player.IsOwnedBy(teamID) {
+		return domain.NewScoutedPlayer(player, teamID, domain.FullScoutingAccuracy), nil
+	}
+
+	accuracy := 0
+	report, err := uc.scoutingRepo.GetReport(ctx, teamID.String(), player.ID.String())
+	if err == nil {
+		accuracy = report.Accuracy
+	} else if err != domain.ErrScoutReportNotFound {
+		return nil, err
+	}
+
+	return domain.NewScoutedPlayer(player, teamID, accuracy), nil
+}
+
+
+func (uc *ScoutingUseCase) RequireExactView(ctx context.Context, teamID string, player *domain.Player) error {
+	team, err := uc.teamRepo.GetByID(ctx, teamID)
+	if err != nil {
+		return err
+	}
+	if player.IsOwnedBy(team.ID) {
+		return nil
+	}
+
+	report, err := uc.scoutingRepo.GetReport(ctx, team.ID.String(), player.ID.String())
+	if err == domain.ErrScoutReportNotFound {
+		return domain.ErrPlayerNotScouted
+	}
+	if err != nil {
+		return err
+	}
+	if !report.IsComplete() {
+		return domain.ErrPlayerNotScouted
+	}
+	return nil
+}
+
+
+func (uc *ScoutingUseCase) ViewListings(ctx context.Context, teamID uuid.UUID, listings []*domain.TransferListingWithPlayer) ([]*domain.ScoutedListing, error) {
+	reports, err := uc.scoutingRepo.GetReportsByTeamID(ctx, teamID.String())
+	if err != nil {
+		return nil, err
+	}
+	accuracy := make(map[uuid.UUID]int, len(reports))
+	for _, report := range reports {
+		accuracy[report.PlayerID] = report.Accuracy
+	}
+
+
+	scouted := make([]*domain.ScoutedListing, 0, len(listings))
+	for _, listing := range listings {
+		scouted = append(scouted, &domain.ScoutedListing{
+			TransferListing: listing.TransferListing,
+			Player:          domain.NewScoutedPlayer(&listing.Player, teamID, accuracy[listing.PlayerID]),
+		})
+	}
+
+	return scouted, nil
+}
+
+
+func (uc *ScoutingUseCase) RunScouting(ctx context.Context) (int, error) {
+	scouts, err := uc.scoutingRepo.GetAssignedScouts(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	updated := 0
+	for _, scout := range scouts {
+		count, err := uc.scout(ctx, scout)
+		if err != nil {
+			return updated, err
+		}
+		updated += count
+	}
+
+	logger.Logger.Info("Scouting completed", zap.Int("scouts", len(scouts)), zap.Int("reports_updated", updated))
+
+	return updated, nil
+}
+
+func (uc *ScoutingUseCase) scout(ctx context.Context, scout *domain.Scout) (int, error) {
+	if scout.Region != nil {
+		reports, err := uc.scoutingRepo.GetRegionReports(ctx, scout.TeamID.String(), *scout.Region, scout.RegionCoverage())
+		if err != nil {
+			return 0, err
+		}
+		for _, report := range reports {
+			report.Improve(scout.RegionProgress())
+			if err := uc.scoutingRepo.SaveReport(ctx, report); err != nil {
+				return 0, err
+			}
+		}
+		return len(reports), nil
+	}
+
+	report, err := uc.scoutingRepo.GetReport(ctx, scout.TeamID.String(), scout.PlayerID.String())
+	if err == domain.ErrScoutReportNotFound {
+		report, err = domain.NewScoutReport(scout.TeamID, *scout.PlayerID), nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	report.Improve(scout.PlayerProgress())
+	if err := uc.scoutingRepo.SaveReport(ctx, report); err != nil {
+		return 0, err
+	}
+
+	if report.IsComplete() {
+		scout.Unassign()
+		if err := uc.scoutingRepo.UpdateScout(ctx, scout); err != nil {
+			return 0, err
+		}
+	}
+	return 1, nil
+}
+
+func (uc *ScoutingUseCase) ownScout(ctx context.Context, teamID, scoutID string) (*domain.Scout, error) {
+	team, err := uc.teamRepo.GetByID(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := uuid.Parse(scoutID); err != nil {
+		return nil, domain.ErrScoutNotFound
+	}
+
+	scout, err := uc.scoutingRepo.GetScoutByID(ctx, scoutID)
+	if err != nil {
+		return nil, err
+	}
+	if scout.TeamID != team.ID {
+		return nil, domain.ErrScoutNotFound
+	}
+	return scout, nil
+}
@@ -0,0 +1,182 @@
+package season
+
+import (
+	"context"
+
+	"soccer-manager-api/internal/app/scouting"
+	"soccer-manager-api/internal/domain"
+	infraCache "soccer-manager-api/internal/infrastructure/cache"
+	"soccer-manager-api/internal/ports/cache"
+	"soccer-manager-api/internal/ports/repository"
+	"soccer-manager-api/pkg/logger"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+
+type SeasonUseCase struct {
+	seasonRepo      repository.SeasonRepository
+	playerRepo      repository.PlayerRepository
+	transferRepo    repository.TransferRepository
+	lineupRepo      repository.LineupRepository
+	contractRepo    repository.ContractRepository
+	transactor      repository.Transactor
+	scoutingUseCase *scouting.ScoutingUseCase
+	cache           cache.Cache
+	cacheHelper     *infraCache.CacheHelper
+}
+
+
+func NewSeasonUseCase(
+	seasonRepo repository.SeasonRepository,
+	playerRepo repository.PlayerRepository,
+	transferRepo repository.TransferRepository,
+	lineupRepo repository.LineupRepository,
+	contractRepo repository.ContractRepository,
+	transactor repository.Transactor,
+	scoutingUseCase *scouting.ScoutingUseCase,
+	cache cache.Cache,
+) *SeasonUseCase {
+	return &SeasonUseCase{
+		seasonRepo:      seasonRepo,
+		playerRepo:      playerRepo,
+		transferRepo:    transferRepo,
+		lineupRepo:      lineupRepo,
+		contractRepo:    contractRepo,
+		transactor:      transactor,
+		scoutingUseCase: scoutingUseCase,
+		cache:           cache,
+		cacheHelper:     infraCache.NewCacheHelper(cache),
+	}
+}
+
+
+func (uc *SeasonUseCase) GetCurrentSeason(ctx context.Context) (*domain.Season, error) {
+	return uc.seasonRepo.GetCurrent(ctx)
+}
+
+
+func (uc *SeasonUseCase) ListSeasons(ctx context.Context) ([]*domain.Season, error) {
+	return uc.seasonRepo.List(ctx)
+}
+
+
+func (uc *SeasonUseCase) GetPlayerHistory(ctx context.Context, teamID, playerID string) ([]*domain.SeasonPlayerSnapshot, error) {
+	player, err := uc.playerRepo.GetByID(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.scoutingUseCase.RequireExactView(ctx, teamID, player); err != nil {
+		return nil, err
+	}
+	return uc.seasonRepo.GetPlayerHistory(ctx, playerID)
+}
+
+
+func (uc *SeasonUseCase) Rollover(ctx context.Context) (*domain.SeasonRollover, error) {
+	result := &domain.SeasonRollover{}
+	affectedTeams := make(map[uuid.UUID]bool)
+	err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		current, err := uc.seasonRepo.GetCurrent(ctx)
+		if err == domain.ErrSeasonNotFound {
+			current = domain.NewSeason(1)
+			err = uc.seasonRepo.Create(ctx, current)
+		}
+		if err != nil {
+			return err
+		}
+
+		current.Complete()
+		if err := uc.seasonRepo.Complete(ctx, current); err != nil {
+			return err
+		}
+		result.CompletedSeason = current
+
+
+		players, err := uc.playerRepo.GetActive(ctx)
+		if err != nil {
+			return err
+		}
+
+		snapshots := make([]*domain.SeasonPlayerSnapshot, 0, len(players))
+		for _, player := range players {
+			snapshots = append(snapshots, domain.NewSeasonPlayerSnapshot(current.ID, player))
+		}
+		if err := uc.seasonRepo.ArchivePlayers(ctx, snapshots); err != nil {
+			return err
+		}
+		result.StatsArchived, err = uc.seasonRepo.ArchivePlayerStats(ctx, current.ID)
+		if err != nil {
+			return err
+		}
+
+
+		for _, player := range players {
+			if player.TeamID != nil {
+				affectedTeams[*player.TeamID] = true
+			}
+
+			if player.ShouldRetire() {
+				if err := uc.releaseRetiredPlayer(ctx, player); err != nil {
+					return err
+				}
+				result.PlayersRetired++
+				continue
+			}
+
+			player.AgeOneYear()
+			if err := uc.playerRepo.UpdateDevelopment(ctx, player); err != nil {
+				return err
+			}
+			result.PlayersAged++
+		}
+
+
+		result.NewSeason = domain.NewSeason(current.Number + 1)
+		return uc.seasonRepo.Create(ctx, result.NewSeason)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+
+	for teamID := range affectedTeams {
+		uc.cacheHelper.InvalidateTeamCache(ctx, teamID.String())
+	}
+	uc.cacheHelper.InvalidateTransferListCache(ctx)
+
+	logger.Logger.Info("Season rolled over",
+		zap.Int("completed_season", result.CompletedSeason.Number),
+		zap.Int("new_season", result.NewSeason.Number),
+		zap.Int("players_aged", result.PlayersAged),
+		zap.Int("players_retired", result.PlayersRetired),
+		zap.Int("stats_archived", result.StatsArchived),
+	)
+
+	return result, nil
+}
+
+func (uc *SeasonUseCase) releaseRetiredPlayer(ctx context.Context, player *domain.Player) error {
+	if listing, err := uc.transferRepo.GetListingByPlayerID(ctx, player.ID.String()); err == nil {
+		listing.Cancel()
+		if err := uc.transferRepo.UpdateListing(ctx, listing); err != nil {
+			return err
+		}
+	}
+
+	if err := uc.lineupRepo.RemovePlayer(ctx, player.ID.String()); err != nil {
+		return err
+	}
+
+	if contract, err := uc.contractRepo.GetActiveByPlayerID(ctx, player.ID.String()); err == nil {
+		contract.Terminate()
+		if err := uc.contractRepo.Update(ctx, contract); err != nil {
+			return err
+		}
+	}
+
+	teamID := player.TeamID
+	player.Retire()
+	return uc.playerRepo.ChangeTeam(ctx, player, teamID)
+}
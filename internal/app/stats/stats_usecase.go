@@ -0,0 +1,205 @@
+package stats
+
+import (
+	"context"
+	"time"
+
+	"soccer-manager-api/internal/domain"
+	infraCache "soccer-manager-api/internal/infrastructure/cache"
+	"soccer-manager-api/internal/ports/cache"
+	"soccer-manager-api/internal/ports/repository"
+
+	"github.com/google/uuid"
+)
+
+
+type StatsUseCase struct {
+	statsRepo   repository.StatsRepository
+	matchRepo   repository.MatchRepository
+	seasonRepo  repository.SeasonRepository
+	lineupRepo  repository.LineupRepository
+	teamRepo    repository.TeamRepository
+	playerRepo  repository.PlayerRepository
+	cache       cache.Cache
+	cacheHelper *infraCache.CacheHelper
+}
+
+
+func NewStatsUseCase(
+	statsRepo repository.StatsRepository,
+	matchRepo repository.MatchRepository,
+	seasonRepo repository.SeasonRepository,
+	lineupRepo repository.LineupRepository,
+	teamRepo repository.TeamRepository,
+	playerRepo repository.PlayerRepository,
+	cache cache.Cache,
+) *StatsUseCase {
+	return &StatsUseCase{
+		statsRepo:   statsRepo,
+		matchRepo:   matchRepo,
+		seasonRepo:  seasonRepo,
+		lineupRepo:  lineupRepo,
+		teamRepo:    teamRepo,
+		playerRepo:  playerRepo,
+		cache:       cache,
+		cacheHelper: infraCache.NewCacheHelper(cache),
+	}
+}
+
+
+func (uc *StatsUseCase) GetTeamStats(ctx context.Context, teamID string) (*domain.TeamStats, error) {
+	team, err := uc.teamRepo.GetByID(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+
+	season, err := uc.currentSeason(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &domain.TeamStats{TeamID: team.ID}
+	var seasonID *uuid.UUID
+	var since time.Time
+	if season != nil {
+		stats.Season = &season.Number
+		seasonID = &season.ID
+		since = season.StartedAt
+	}
+
+
+	matches, err := uc.matchRepo.GetByTeamID(ctx, team.ID.String())
+	if err != nil {
+		return nil, err
+	}
+	stats.Records = domain.NewTeamRecords(team.ID, matches, since)
+
+
+	stats.Players, err = uc.statsRepo.GetTeamTotals(ctx, team.ID.String(), seasonID)
+	if err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+
+func (uc *StatsUseCase) GetLeaderboard(ctx context.Context, category, competition string, limit int) ([]*domain.PlayerStats, error) {
+	if !domain.LeaderboardCategory(category).IsValid() {
+		return nil, domain.ErrInvalidLeaderboard
+	}
+	if competition != "" && !domain.Competition(competition).IsValid() {
+		return nil, domain.ErrInvalidLeaderboard
+	}
+	if limit <= 0 {
+		limit = domain.DefaultLeaderboardLimit
+	}
+	if limit > domain.MaxLeaderboardLimit {
+		limit = domain.MaxLeaderboardLimit
+	}
+
+
+	season, err := uc.currentSeason(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var seasonID *uuid.UUID
+	if season != nil {
+		seasonID = &season.ID
+	}
+
+	return uc.statsRepo.GetLeaderboard(ctx, domain.LeaderboardCategory(category), seasonID, competition, limit)
+}
+
+
+func (uc *StatsUseCase) RecordMatch(ctx context.Context, match *domain.Match) ([]*domain.PlayerMatchStats, error) {
+	season, err := uc.currentSeason(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var seasonID *uuid.UUID
+	if season != nil {
+		seasonID = &season.ID
+	}
+
+
+	stats := make([]*domain.PlayerMatchStats, 0)
+	squads := make(map[uuid.UUID]*domain.Player)
+	for _, teamID := range []uuid.UUID{match.HomeTeamID, match.AwayTeamID} {
+		starters, substitutes, chemistry, err := uc.MatchdaySquad(ctx, teamID)
+		if err != nil {
+			return nil, err
+		}
+		for _, player := range append(append([]*domain.Player{}, starters...), substitutes...) {
+			squads[player.ID] = player
+		}
+		stats = append(stats, domain.SimulatePlayerStats(match, teamID, seasonID, chemistry.Score, starters, substitutes)...)
+	}
+	if err := uc.statsRepo.CreateBatch(ctx, stats); err != nil {
+		return nil, err
+	}
+
+
+	for _, line := range stats {
+		form, err := uc.statsRepo.GetRecentForm(ctx, line.PlayerID.String(), domain.RecentFormMatches)
+		if err != nil {
+			return nil, err
+		}
+
+		player := squads[line.PlayerID]
+		player.AdjustMarketValueForForm(form)
+		if err := uc.playerRepo.UpdateForm(ctx, player); err != nil {
+			return nil, err
+		}
+	}
+
+
+	uc.cacheHelper.InvalidateTeamCache(ctx, match.HomeTeamID.String())
+	uc.cacheHelper.InvalidateTeamCache(ctx, match.AwayTeamID.String())
+	uc.cacheHelper.InvalidateTransferListCache(ctx)
+
+	return stats, nil
+}
+
+func (uc *StatsUseCase) MatchdaySquad(ctx context.Context, teamID uuid.UUID) ([]*domain.Player, []*domain.Player, domain.TeamChemistry, error) {
+	lineup, err := uc.lineupRepo.GetByTeamID(ctx, teamID.String())
+	if err == domain.ErrLineupNotFound {
+		return nil, nil, domain.TeamChemistry{}, nil
+	}
+	if err != nil {
+		return nil, nil, domain.TeamChemistry{}, err
+	}
+
+
+	players, err := uc.playerRepo.GetByTeamID(ctx, teamID.String())
+	if err != nil {
+		return nil, nil, domain.TeamChemistry{}, err
+	}
+	squad := make(map[uuid.UUID]*domain.Player, len(players))
+	for _, player := range players {
+		if player.IsAvailable() {
+			squad[player.ID] = player
+		}
+	}
+
+	pick := func(ids []uuid.UUID) []*domain.Player {
+		picked := make([]*domain.Player, 0, len(ids))
+		for _, id := range ids {
+			if player, ok := squad[id]; ok {
+				picked = append(picked, player)
+			}
+		}
+		return picked
+	}
+
+	return pick(lineup.Starters), pick(lineup.Substitutes), domain.NewTeamChemistry(players, time.Now()), nil
+}
+
+func (uc *StatsUseCase) currentSeason(ctx context.Context) (*domain.Season, error) {
+	season, err := uc.seasonRepo.GetCurrent(ctx)
+	if err == domain.ErrSeasonNotFound {
+		return nil, nil
+	}
+	return season, err
+}
@@ -2,18 +2,24 @@ package team
 
 import (
 	"context"
+	"strings"
 
+	"soccer-manager-api/internal/app/auth"
 	"soccer-manager-api/internal/domain"
 	infraCache "soccer-manager-api/internal/infrastructure/cache"
 	"soccer-manager-api/internal/ports/cache"
 	"soccer-manager-api/internal/ports/repository"
+	"soccer-manager-api/pkg/countries"
+
+	"github.com/google/uuid"
 )
 
 
 type TeamUseCase struct {
-	teamRepo   repository.TeamRepository
-	playerRepo repository.PlayerRepository
-	cache      cache.Cache
+	teamRepo    repository.TeamRepository
+	playerRepo  repository.PlayerRepository
+	authUseCase *auth.AuthUseCase
+	cache       cache.Cache
 	cacheHelper *infraCache.CacheHelper
 }
 
@@ -21,11 +27,13 @@ type TeamUseCase struct {
 func NewTeamUseCase(
 	teamRepo repository.TeamRepository,
 	playerRepo repository.PlayerRepository,
+	authUseCase *auth.AuthUseCase,
 	cache cache.Cache,
 ) *TeamUseCase {
 	return &TeamUseCase{
 		teamRepo:    teamRepo,
 		playerRepo:  playerRepo,
+		authUseCase: authUseCase,
 		cache:       cache,
 		cacheHelper: infraCache.NewCacheHelper(cache),
 	}
@@ -33,21 +41,98 @@ func NewTeamUseCase(
 
 
 type UpdateTeamRequest struct {
-	Name    string `json:"name" binding:"required"`
-	Country string `json:"country" binding:"required"`
+	Name           string `json:"name" binding:"required,max=100"`
+	Country        string `json:"country" binding:"required"`
+	CrestPrimary   string `json:"crest_primary"`
+	CrestSecondary string `json:"crest_secondary"`
+}
+
+
+type CreateTeamRequest struct {
+	Name           string `json:"name" binding:"omitempty,max=100"`
+	Country        string `json:"country"`
+	CrestPrimary   string `json:"crest_primary"`
+	CrestSecondary string `json:"crest_secondary"`
+	Draft          bool   `json:"draft"`
 }
 
 
-func (uc *TeamUseCase) GetTeam(ctx context.Context, userID string) (*domain.TeamWithValue, error) {
+func (uc *TeamUseCase) ResolveTeam(ctx context.Context, userID, teamRef string) (*domain.Team, error) {
+	if teamRef == "" || teamRef == "me" {
+		return uc.teamRepo.GetDefaultByUserID(ctx, userID)
+	}
+
+	if _, err := uuid.Parse(teamRef); err != nil {
+		return nil, domain.ErrTeamNotFound
+	}
+	team, err := uc.teamRepo.GetByID(ctx, teamRef)
+	if err != nil {
+		return nil, err
+	}
+	if team.UserID.String() != userID {
+		return nil, domain.ErrTeamNotOwned
+	}
+	return team, nil
+}
+
 
-	cacheKey := infraCache.CacheKey("team", userID)
+func (uc *TeamUseCase) ListTeams(ctx context.Context, userID string) ([]*domain.Team, error) {
+	return uc.teamRepo.ListByUserID(ctx, userID)
+}
+
+
+func (uc *TeamUseCase) CreateTeam(ctx context.Context, userID string, req CreateTeamRequest) (*domain.Team, error) {
+	ownerID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, err
+	}
+
+
+	team, err := uc.authUseCase.BuildTeam(ctx, ownerID, auth.TeamOptions{
+		Name:           req.Name,
+		Country:        req.Country,
+		CrestPrimary:   req.CrestPrimary,
+		CrestSecondary: req.CrestSecondary,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authUseCase.CreateTeam(ctx, team, req.Draft); err != nil {
+		return nil, err
+	}
+
+	return team, nil
+}
+
+
+func (uc *TeamUseCase) SetDefaultTeam(ctx context.Context, userID, teamID string) (*domain.Team, error) {
+	team, err := uc.ResolveTeam(ctx, userID, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.teamRepo.SetDefault(ctx, userID, team.ID.String()); err != nil {
+		return nil, err
+	}
+	team.IsDefault = true
+
+
+	uc.cacheHelper.InvalidateTeamCache(ctx, team.ID.String())
+
+	return team, nil
+}
+
+
+func (uc *TeamUseCase) GetTeam(ctx context.Context, teamID string) (*domain.TeamWithValue, error) {
+
+	cacheKey := infraCache.CacheKey("team", teamID)
 	var teamWithValue domain.TeamWithValue
 	if err := uc.cacheHelper.Get(ctx, cacheKey, &teamWithValue); err == nil {
 		return &teamWithValue, nil
 	}
 
 
-	team, err := uc.teamRepo.GetByUserID(ctx, userID)
+	team, err := uc.teamRepo.GetByID(ctx, teamID)
 	if err != nil {
 		return nil, err
 	}
@@ -59,7 +144,7 @@ func (uc *TeamUseCase) GetTeam(ctx context.Context, userID string) (*domain.Team
 	}
 
 	teamWithValue = domain.TeamWithValue{
-		Team:      *team,
+		Team:       *team,
 		TotalValue: totalValue,
 	}
 
@@ -70,14 +155,26 @@ func (uc *TeamUseCase) GetTeam(ctx context.Context, userID string) (*domain.Team
 }
 
 
-func (uc *TeamUseCase) UpdateTeam(ctx context.Context, userID string, req UpdateTeamRequest) (*domain.Team, error) {
-	team, err := uc.teamRepo.GetByUserID(ctx, userID)
+func (uc *TeamUseCase) UpdateTeam(ctx context.Context, teamID string, req UpdateTeamRequest) (*domain.Team, error) {
+	team, err := uc.teamRepo.GetByID(ctx, teamID)
 	if err != nil {
 		return nil, err
 	}
 
-	team.Name = req.Name
-	team.Country = req.Country
+	country, ok := countries.Normalize(req.Country)
+	if !ok {
+		return nil, domain.ErrInvalidCountry
+	}
+	name := strings.TrimSpace(req.Name)
+	if err := uc.authUseCase.CheckTeamName(ctx, name, team.ID); err != nil {
+		return nil, err
+	}
+	if err := team.SetCrest(req.CrestPrimary, req.CrestSecondary); err != nil {
+		return nil, err
+	}
+
+	team.Name = name
+	team.Country = country
 
 	if err := uc.teamRepo.Update(ctx, team); err != nil {
 		return nil, err
@@ -90,9 +187,9 @@ func (uc *TeamUseCase) UpdateTeam(ctx context.Context, userID string, req Update
 }
 
 
-func (uc *TeamUseCase) GetTeamPlayers(ctx context.Context, userID string) ([]*domain.Player, error) {
+func (uc *TeamUseCase) GetTeamPlayers(ctx context.Context, teamID string) ([]*domain.Player, error) {
 
-	team, err := uc.teamRepo.GetByUserID(ctx, userID)
+	team, err := uc.teamRepo.GetByID(ctx, teamID)
 	if err != nil {
 		return nil, err
 	}
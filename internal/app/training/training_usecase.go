@@ -0,0 +1,207 @@
+package training
+
+import (
+	"context"
+
+	"soccer-manager-api/internal/app/scouting"
+	"soccer-manager-api/internal/domain"
+	infraCache "soccer-manager-api/internal/infrastructure/cache"
+	"soccer-manager-api/internal/ports/cache"
+	"soccer-manager-api/internal/ports/repository"
+	"soccer-manager-api/pkg/logger"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+
+type TrainingUseCase struct {
+	trainingRepo    repository.TrainingRepository
+	teamRepo        repository.TeamRepository
+	playerRepo      repository.PlayerRepository
+	facilityRepo    repository.FacilityRepository
+	scoutingUseCase *scouting.ScoutingUseCase
+	cache           cache.Cache
+	cacheHelper     *infraCache.CacheHelper
+}
+
+
+func NewTrainingUseCase(
+	trainingRepo repository.TrainingRepository,
+	teamRepo repository.TeamRepository,
+	playerRepo repository.PlayerRepository,
+	facilityRepo repository.FacilityRepository,
+	scoutingUseCase *scouting.ScoutingUseCase,
+	cache cache.Cache,
+) *TrainingUseCase {
+	return &TrainingUseCase{
+		trainingRepo:    trainingRepo,
+		teamRepo:        teamRepo,
+		playerRepo:      playerRepo,
+		facilityRepo:    facilityRepo,
+		scoutingUseCase: scoutingUseCase,
+		cache:           cache,
+		cacheHelper:     infraCache.NewCacheHelper(cache),
+	}
+}
+
+
+type AssignTrainingRequest struct {
+	Focus    string `json:"focus" binding:"required"`
+	PlayerID string `json:"player_id"`
+	Position string `json:"position"`
+}
+
+
+func (uc *TrainingUseCase) GetAssignments(ctx context.Context, teamID string) ([]*domain.TrainingAssignment, error) {
+	team, err := uc.teamRepo.GetByID(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	return uc.trainingRepo.GetAssignmentsByTeamID(ctx, team.ID.String())
+}
+
+
+func (uc *TrainingUseCase) AssignTraining(ctx context.Context, teamID string, req AssignTrainingRequest) (*domain.TrainingAssignment, error) {
+	team, err := uc.teamRepo.GetByID(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	focus := domain.Attribute(req.Focus)
+	if !focus.IsValid() {
+		return nil, domain.ErrInvalidTrainingFocus
+	}
+
+	if (req.PlayerID == "") == (req.Position == "") {
+		return nil, domain.ErrInvalidTrainingTarget
+	}
+
+	var playerID *uuid.UUID
+	var position *domain.Position
+	if req.PlayerID != "" {
+		player, err := uc.playerRepo.GetByID(ctx, req.PlayerID)
+		if err != nil {
+			return nil, err
+		}
+		if !player.IsOwnedBy(team.ID) {
+			return nil, domain.ErrPlayerNotOwned
+		}
+		playerID = &player.ID
+	} else {
+		pos := domain.Position(req.Position)
+		if !pos.IsValid() {
+			return nil, domain.ErrInvalidTrainingTarget
+		}
+		position = &pos
+	}
+
+	assignment := domain.NewTrainingAssignment(team.ID, playerID, position, focus)
+	if err := uc.trainingRepo.SaveAssignment(ctx, assignment); err != nil {
+		return nil, err
+	}
+
+	return assignment, nil
+}
+
+
+func (uc *TrainingUseCase) RemoveAssignment(ctx context.Context, teamID, assignmentID string) error {
+	team, err := uc.teamRepo.GetByID(ctx, teamID)
+	if err != nil {
+		return err
+	}
+
+	assignment, err := uc.trainingRepo.GetAssignmentByID(ctx, assignmentID)
+	if err != nil {
+		return err
+	}
+	if assignment.TeamID != team.ID {
+		return domain.ErrTrainingAssignmentNotFound
+	}
+
+	return uc.trainingRepo.DeleteAssignment(ctx, assignmentID)
+}
+
+
+func (uc *TrainingUseCase) GetTrainingHistory(ctx context.Context, teamID, playerID string) ([]*domain.TrainingSession, error) {
+	player, err := uc.playerRepo.GetByID(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.scoutingUseCase.RequireExactView(ctx, teamID, player); err != nil {
+		return nil, err
+	}
+	return uc.trainingRepo.GetSessionsByPlayerID(ctx, playerID)
+}
+
+
+func (uc *TrainingUseCase) RunTraining(ctx context.Context) (int, error) {
+	teams, err := uc.teamRepo.List(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	trained := 0
+	for _, team := range teams {
+		count, err := uc.trainTeam(ctx, team)
+		if err != nil {
+			return trained, err
+		}
+		trained += count
+	}
+
+	logger.Logger.Info("Training completed", zap.Int("teams", len(teams)), zap.Int("players_trained", trained))
+
+	return trained, nil
+}
+
+func (uc *TrainingUseCase) trainTeam(ctx context.Context, team *domain.Team) (int, error) {
+	assignments, err := uc.trainingRepo.GetAssignmentsByTeamID(ctx, team.ID.String())
+	if err != nil || len(assignments) == 0 {
+		return 0, err
+	}
+
+	players, err := uc.playerRepo.GetByTeamID(ctx, team.ID.String())
+	if err != nil {
+		return 0, err
+	}
+
+	facilities, err := uc.facilityRepo.GetByTeamID(ctx, team.ID.String())
+	if err == domain.ErrFacilitiesNotFound {
+		facilities, err = domain.NewFacilities(team.ID), nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	facilityLevel := facilities.TrainingGroundLevel
+
+	sessions := make([]*domain.TrainingSession, 0, len(players))
+	for _, player := range players {
+		focus, ok := domain.ResolveTrainingFocus(player, assignments)
+		if !ok {
+			continue
+		}
+
+		previousRating := player.Rating()
+		growth := domain.TrainingGrowth(player.Age, player.Potential, previousRating, facilityLevel)
+		if growth == 0 {
+			continue
+		}
+
+		previousValue, _ := player.Train(focus, growth)
+		if err := uc.playerRepo.UpdateDevelopment(ctx, player); err != nil {
+			return 0, err
+		}
+		sessions = append(sessions, domain.NewTrainingSession(player, focus, previousValue, previousRating))
+	}
+
+	if err := uc.trainingRepo.CreateSessions(ctx, sessions); err != nil {
+		return 0, err
+	}
+
+	if len(sessions) > 0 {
+		uc.cacheHelper.InvalidateTeamCache(ctx, team.ID.String())
+	}
+
+	return len(sessions), nil
+}
@@ -3,19 +3,30 @@ package transfer
 import (
 	"context"
 
+	"soccer-manager-api/internal/app/morale"
+	"soccer-manager-api/internal/app/scouting"
 	"soccer-manager-api/internal/domain"
 	infraCache "soccer-manager-api/internal/infrastructure/cache"
 	"soccer-manager-api/internal/ports/cache"
 	"soccer-manager-api/internal/ports/repository"
+
+	"github.com/google/uuid"
 )
 
 
 type TransferUseCase struct {
-	transferRepo repository.TransferRepository
-	teamRepo     repository.TeamRepository
-	playerRepo   repository.PlayerRepository
-	cache        cache.Cache
-	cacheHelper  *infraCache.CacheHelper
+	transferRepo    repository.TransferRepository
+	teamRepo        repository.TeamRepository
+	playerRepo      repository.PlayerRepository
+	lineupRepo      repository.LineupRepository
+	contractRepo    repository.ContractRepository
+	leagueRepo      repository.LeagueRepository
+	financeRepo     repository.FinanceRepository
+	transactor      repository.Transactor
+	moraleUseCase   *morale.MoraleUseCase
+	scoutingUseCase *scouting.ScoutingUseCase
+	cache           cache.Cache
+	cacheHelper     *infraCache.CacheHelper
 }
 
 
@@ -23,14 +34,28 @@ func NewTransferUseCase(
 	transferRepo repository.TransferRepository,
 	teamRepo repository.TeamRepository,
 	playerRepo repository.PlayerRepository,
+	lineupRepo repository.LineupRepository,
+	contractRepo repository.ContractRepository,
+	leagueRepo repository.LeagueRepository,
+	financeRepo repository.FinanceRepository,
+	transactor repository.Transactor,
+	moraleUseCase *morale.MoraleUseCase,
+	scoutingUseCase *scouting.ScoutingUseCase,
 	cache cache.Cache,
 ) *TransferUseCase {
 	return &TransferUseCase{
-		transferRepo: transferRepo,
-		teamRepo:     teamRepo,
-		playerRepo:   playerRepo,
-		cache:        cache,
-		cacheHelper:  infraCache.NewCacheHelper(cache),
+		transferRepo:    transferRepo,
+		teamRepo:        teamRepo,
+		playerRepo:      playerRepo,
+		lineupRepo:      lineupRepo,
+		contractRepo:    contractRepo,
+		leagueRepo:      leagueRepo,
+		financeRepo:     financeRepo,
+		transactor:      transactor,
+		moraleUseCase:   moraleUseCase,
+		scoutingUseCase: scoutingUseCase,
+		cache:           cache,
+		cacheHelper:     infraCache.NewCacheHelper(cache),
 	}
 }
 
@@ -45,9 +70,9 @@ type BuyPlayerRequest struct {
 }
 
 
-func (uc *TransferUseCase) ListPlayer(ctx context.Context, userID, playerID string, req ListPlayerRequest) (*domain.TransferListing, error) {
+func (uc *TransferUseCase) ListPlayer(ctx context.Context, teamID, playerID string, req ListPlayerRequest) (*domain.TransferListing, error) {
 
-	team, err := uc.teamRepo.GetByUserID(ctx, userID)
+	team, err := uc.teamRepo.GetByID(ctx, teamID)
 	if err != nil {
 		return nil, err
 	}
@@ -59,19 +84,9 @@ func (uc *TransferUseCase) ListPlayer(ctx context.Context, userID, playerID stri
 	}
 
 
-	if !player.IsOwnedBy(team.ID) {
-		return nil, domain.ErrPlayerNotOwned
-	}
-
-
 	existingListing, _ := uc.transferRepo.GetListingByPlayerID(ctx, playerID)
-	if existingListing != nil && existingListing.IsActive() {
-		return nil, domain.ErrPlayerAlreadyListed
-	}
-
-
-	if req.AskingPrice <= 0 {
-		return nil, domain.ErrInvalidAskingPrice
+	if err := domain.ValidateListing(player, team.ID, existingListing, req.AskingPrice); err != nil {
+		return nil, err
 	}
 
 
@@ -79,6 +94,9 @@ func (uc *TransferUseCase) ListPlayer(ctx context.Context, userID, playerID stri
 	if err := uc.transferRepo.CreateListing(ctx, listing); err != nil {
 		return nil, err
 	}
+	if err := uc.moraleUseCase.Adjust(ctx, player, domain.MoraleDriverTransfers, domain.MoraleListed, "Placed on the transfer list"); err != nil {
+		return nil, err
+	}
 
 
 	uc.cacheHelper.InvalidateTransferListCache(ctx)
@@ -87,9 +105,9 @@ func (uc *TransferUseCase) ListPlayer(ctx context.Context, userID, playerID stri
 }
 
 
-func (uc *TransferUseCase) RemoveFromTransferList(ctx context.Context, userID, playerID string) error {
+func (uc *TransferUseCase) RemoveFromTransferList(ctx context.Context, teamID, playerID string) error {
 
-	team, err := uc.teamRepo.GetByUserID(ctx, userID)
+	team, err := uc.teamRepo.GetByID(ctx, teamID)
 	if err != nil {
 		return err
 	}
@@ -124,23 +142,35 @@ func (uc *TransferUseCase) RemoveFromTransferList(ctx context.Context, userID, p
 }
 
 
-func (uc *TransferUseCase) GetTransferList(ctx context.Context, userID string) ([]*domain.TransferListingWithPlayer, error) {
+func (uc *TransferUseCase) GetTransferList(ctx context.Context, teamID string) ([]*domain.ScoutedListing, error) {
 
-	team, err := uc.teamRepo.GetByUserID(ctx, userID)
+	team, err := uc.teamRepo.GetByID(ctx, teamID)
 	if err != nil {
 		return nil, err
 	}
 
 
-	cacheKey := infraCache.CacheKey("transfer_list", "all")
+	league, err := uc.teamLeague(ctx, team.ID.String())
+	if err != nil {
+		return nil, err
+	}
+	var leagueID *uuid.UUID
+	scope := "all"
+	if league != nil && league.LeagueOnlyTransfers {
+		leagueID = &league.ID
+		scope = "league:" + league.ID.String()
+	}
+
+
+	cacheKey := infraCache.CacheKey("transfer_list", scope)
 	var listings []*domain.TransferListingWithPlayer
 	if err := uc.cacheHelper.Get(ctx, cacheKey, &listings); err == nil {
 
-		return uc.filterOwnPlayers(listings, team.ID.String()), nil
+		return uc.scoutingUseCase.ViewListings(ctx, team.ID, uc.filterOwnPlayers(listings, team.ID.String()))
 	}
 
 
-	listings, err = uc.transferRepo.GetActiveListings(ctx, team.ID.String())
+	listings, err = uc.transferRepo.GetActiveListings(ctx, team.ID.String(), leagueID)
 	if err != nil {
 		return nil, err
 	}
@@ -148,13 +178,13 @@ func (uc *TransferUseCase) GetTransferList(ctx context.Context, userID string) (
 
 	uc.cacheHelper.Set(ctx, cacheKey, listings, 60)
 
-	return listings, nil
+	return uc.scoutingUseCase.ViewListings(ctx, team.ID, listings)
 }
 
 
-func (uc *TransferUseCase) BuyPlayer(ctx context.Context, userID, listingID string) (*domain.Transfer, error) {
+func (uc *TransferUseCase) BuyPlayer(ctx context.Context, teamID, listingID string) (*domain.Transfer, error) {
 
-	buyerTeam, err := uc.teamRepo.GetByUserID(ctx, userID)
+	buyerTeam, err := uc.teamRepo.GetByID(ctx, teamID)
 	if err != nil {
 		return nil, err
 	}
@@ -201,54 +231,126 @@ func (uc *TransferUseCase) BuyPlayer(ctx context.Context, userID, listingID stri
 		if err != nil {
 			return nil, err
 		}
-	} else {
-
-		return nil, domain.ErrTeamNotFound
+		if sellerTeam.IsOwnedBy(buyerTeam.UserID) {
+			return nil, domain.ErrCannotBuyOwnPlayer
+		}
+	} else if !player.IsFreeAgent() {
+		return nil, domain.ErrTransferListingNotFound
 	}
 
 
-
-	player.Transfer(buyerTeam.ID)
-	if err := uc.playerRepo.Update(ctx, player); err != nil {
+	if err := uc.checkLeagueRules(ctx, buyerTeam, sellerTeam, player, listing.AskingPrice); err != nil {
 		return nil, err
 	}
 
 
-	buyerTeam.DeductBudget(listing.AskingPrice)
-	sellerTeam.AddBudget(listing.AskingPrice)
-	if err := uc.teamRepo.Update(ctx, buyerTeam); err != nil {
-		return nil, err
-	}
-	if err := uc.teamRepo.Update(ctx, sellerTeam); err != nil {
-		return nil, err
-	}
-
-
-	listing.MarkAsSold()
-	if err := uc.transferRepo.UpdateListing(ctx, listing); err != nil {
-		return nil, err
-	}
-
 
+	fromTeamID := player.TeamID
 	transfer := domain.NewTransfer(
 		player.ID,
-		sellerTeam.ID,
+		fromTeamID,
 		buyerTeam.ID,
 		listing.AskingPrice,
 	)
-	if err := uc.transferRepo.CreateTransfer(ctx, transfer); err != nil {
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		player.Transfer(buyerTeam.ID)
+		if err := uc.playerRepo.ChangeTeam(ctx, player, fromTeamID); err != nil {
+			return err
+		}
+
+
+		if err := uc.lineupRepo.RemovePlayer(ctx, player.ID.String()); err != nil {
+			return err
+		}
+
+
+		if contract, err := uc.contractRepo.GetActiveByPlayerID(ctx, player.ID.String()); err == nil {
+			contract.Terminate()
+			if err := uc.contractRepo.Update(ctx, contract); err != nil {
+				return err
+			}
+		}
+		if err := uc.contractRepo.Create(ctx, domain.NewStandardContract(player, buyerTeam.ID)); err != nil {
+			return err
+		}
+		if err := uc.moraleUseCase.Adjust(ctx, player, domain.MoraleDriverTransfers, domain.MoraleJoined, "Joined "+buyerTeam.Name); err != nil {
+			return err
+		}
+
+
+		name := player.FirstName + " " + player.LastName
+		source := "free agency"
+		if sellerTeam != nil {
+			source = sellerTeam.Name
+		}
+		fee := domain.NewFinanceTransaction(buyerTeam.ID, domain.FinanceTransfer, -listing.AskingPrice, "Signed "+name+" from "+source, &transfer.ID)
+		if err := uc.financeRepo.ApplyTransaction(ctx, fee); err != nil {
+			return err
+		}
+		if sellerTeam != nil {
+			income := domain.NewFinanceTransaction(sellerTeam.ID, domain.FinanceTransfer, listing.AskingPrice, "Sold "+name+" to "+buyerTeam.Name, &transfer.ID)
+			if err := uc.financeRepo.ApplyTransaction(ctx, income); err != nil {
+				return err
+			}
+		}
+
+
+		listing.MarkAsSold()
+		if err := uc.transferRepo.UpdateListing(ctx, listing); err != nil {
+			return err
+		}
+
+
+		return uc.transferRepo.CreateTransfer(ctx, transfer)
+	})
+	if err != nil {
 		return nil, err
 	}
 
 
 	uc.cacheHelper.InvalidateTeamCache(ctx, buyerTeam.ID.String())
-	uc.cacheHelper.InvalidateTeamCache(ctx, sellerTeam.ID.String())
+	if sellerTeam != nil {
+		uc.cacheHelper.InvalidateTeamCache(ctx, sellerTeam.ID.String())
+	}
 	uc.cacheHelper.InvalidateTransferListCache(ctx)
 
 	return transfer, nil
 }
 
 
+func (uc *TransferUseCase) checkLeagueRules(ctx context.Context, buyerTeam, sellerTeam *domain.Team, player *domain.Player, price float64) error {
+	buyerLeague, err := uc.teamLeague(ctx, buyerTeam.ID.String())
+	if err != nil {
+		return err
+	}
+	var sellerLeague *domain.League
+	if sellerTeam != nil {
+		sellerLeague, err = uc.teamLeague(ctx, sellerTeam.ID.String())
+		if err != nil {
+			return err
+		}
+	}
+
+	squadValue := 0.0
+	if buyerLeague != nil && buyerLeague.MaxSquadValue != nil {
+		squadValue, err = uc.teamRepo.GetTotalValue(ctx, buyerTeam.ID.String())
+		if err != nil {
+			return err
+		}
+	}
+
+	return domain.CheckLeagueTransfer(buyerLeague, sellerLeague, price, squadValue+player.MarketValue)
+}
+
+func (uc *TransferUseCase) teamLeague(ctx context.Context, teamID string) (*domain.League, error) {
+	league, err := uc.leagueRepo.GetByTeamID(ctx, teamID)
+	if err == domain.ErrLeagueNotFound {
+		return nil, nil
+	}
+	return league, err
+}
+
+
 func (uc *TransferUseCase) filterOwnPlayers(listings []*domain.TransferListingWithPlayer, teamID string) []*domain.TransferListingWithPlayer {
 	filtered := make([]*domain.TransferListingWithPlayer, 0)
 	for _, listing := range listings {
@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+
+type AbsenceType string
+
+const (
+	AbsenceInjury     AbsenceType = "injury"
+	AbsenceSuspension AbsenceType = "suspension"
+)
+
+
+type PlayerAbsence struct {
+	ID             uuid.UUID   `json:"id" db:"id"`
+	PlayerID       uuid.UUID   `json:"player_id" db:"player_id"`
+	TeamID         *uuid.UUID  `json:"team_id,omitempty" db:"team_id"`
+	Type           AbsenceType `json:"type" db:"type"`
+	Description    string      `json:"description" db:"description"`
+	Days           int         `json:"days,omitempty" db:"days"`
+	Matches        int         `json:"matches,omitempty" db:"matches"`
+	StartedAt      time.Time   `json:"started_at" db:"started_at"`
+	ExpectedReturn *time.Time  `json:"expected_return,omitempty" db:"expected_return"`
+	EndedAt        *time.Time  `json:"ended_at,omitempty" db:"ended_at"`
+}
+
+
+func NewInjury(player *Player, days int, description string) *PlayerAbsence {
+	return &PlayerAbsence{
+		ID:             uuid.New(),
+		PlayerID:       player.ID,
+		TeamID:         player.TeamID,
+		Type:           AbsenceInjury,
+		Description:    description,
+		Days:           days,
+		StartedAt:      time.Now(),
+		ExpectedReturn: player.UnavailableUntil,
+	}
+}
+
+
+func NewSuspension(player *Player, matches int, description string) *PlayerAbsence {
+	return &PlayerAbsence{
+		ID:          uuid.New(),
+		PlayerID:    player.ID,
+		TeamID:      player.TeamID,
+		Type:        AbsenceSuspension,
+		Description: description,
+		Matches:     matches,
+		StartedAt:   time.Now(),
+	}
+}
@@ -0,0 +1,132 @@
+package domain
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+
+type Academy struct {
+	TeamID       uuid.UUID  `json:"team_id" db:"team_id"`
+	Level        int        `json:"level" db:"level"`
+	LastIntakeAt *time.Time `json:"last_intake_at,omitempty" db:"last_intake_at"`
+	UpdatedAt    time.Time  `json:"updated_at" db:"updated_at"`
+}
+
+
+type AcademyWithProspects struct {
+	Academy
+	UpgradeCost float64          `json:"upgrade_cost"`
+	Prospects   []*YouthProspect `json:"prospects"`
+}
+
+const (
+	MinAcademyLevel        = 1
+	MaxAcademyLevel        = 5
+	AcademyUpgradeBaseCost = 500000.00
+	MaxProspects           = 10
+	MinProspectAge         = 16
+	MaxProspectAge         = 19
+	InitialProspectValue   = 250000.00
+)
+
+
+func NewAcademy(teamID uuid.UUID) *Academy {
+	return &Academy{
+		TeamID:    teamID,
+		Level:     MinAcademyLevel,
+		UpdatedAt: time.Now(),
+	}
+}
+
+
+func (a *Academy) UpgradeCost() float64 {
+	return FacilityUpgradeCost(FacilityAcademy, a.Level+1)
+}
+
+
+func (a *Academy) CanUpgrade() bool {
+	return a.Level < MaxAcademyLevel
+}
+
+
+func (a *Academy) Upgrade() {
+	a.Level++
+	a.UpdatedAt = time.Now()
+}
+
+
+func (a *Academy) IntakeSize() int {
+	return 1 + a.Level/2
+}
+
+
+func (a *Academy) RecordIntake() {
+	now := time.Now()
+	a.LastIntakeAt = &now
+	a.UpdatedAt = now
+}
+
+
+type YouthProspect struct {
+	ID        uuid.UUID `json:"id" db:"id"`
+	TeamID    uuid.UUID `json:"team_id" db:"team_id"`
+	FirstName string    `json:"first_name" db:"first_name"`
+	LastName  string    `json:"last_name" db:"last_name"`
+	Country   string    `json:"country" db:"country"`
+	Age       int       `json:"age" db:"age"`
+	Position  Position  `json:"position" db:"position"`
+	Potential int       `json:"potential" db:"potential"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+}
+
+var prospectPositions = []Position{
+	PositionGoalkeeper, PositionDefender, PositionDefender, PositionMidfielder,
+	PositionMidfielder, PositionAttacker, PositionAttacker,
+}
+
+
+func NewYouthProspect(teamID uuid.UUID, academyLevel int, firstName, lastName, country string) *YouthProspect {
+	potential := 45 + academyLevel*6 + rand.Intn(21)
+	if potential > MaxPotential {
+		potential = MaxPotential
+	}
+
+	return &YouthProspect{
+		ID:        uuid.New(),
+		TeamID:    teamID,
+		FirstName: firstName,
+		LastName:  lastName,
+		Country:   country,
+		Age:       MinProspectAge + rand.Intn(MaxProspectAge-MinProspectAge+1),
+		Position:  prospectPositions[rand.Intn(len(prospectPositions))],
+		Potential: potential,
+		CreatedAt: time.Now(),
+	}
+}
+
+
+func (yp *YouthProspect) Promote() *Player {
+	teamID := yp.TeamID
+	player := &Player{
+		ID:           yp.ID,
+		TeamID:       &teamID,
+		FirstName:    yp.FirstName,
+		LastName:     yp.LastName,
+		Country:      yp.Country,
+		Age:          yp.Age,
+		Position:     yp.Position,
+		Potential:    yp.Potential,
+		MarketValue:  InitialProspectValue * (1 + float64(yp.Potential)/100),
+		FormFactor:   1,
+		Morale:       DefaultMorale,
+		Availability: AvailabilityAvailable,
+		JoinedAt:     time.Now(),
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+	player.rollAttributes(25, 45)
+	return player
+}
@@ -0,0 +1,24 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+
+type AccountExport struct {
+	UserID      uuid.UUID                  `json:"user_id"`
+	GeneratedAt time.Time                  `json:"generated_at"`
+	Data        map[string]json.RawMessage `json:"data"`
+}
+
+
+func NewAccountExport(userID uuid.UUID) *AccountExport {
+	return &AccountExport{
+		UserID:      userID,
+		GeneratedAt: time.Now(),
+		Data:        make(map[string]json.RawMessage),
+	}
+}
@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+
+type AuditAction string
+
+const (
+	AuditPasswordChanged    AuditAction = "password_changed"
+	AuditPasswordReset      AuditAction = "password_reset"
+	AuditEmailChanged       AuditAction = "email_changed"
+	AuditDeletionScheduled  AuditAction = "deletion_scheduled"
+	AuditPersonalDataExport AuditAction = "personal_data_exported"
+	AuditTwoFactorEnabled   AuditAction = "two_factor_enabled"
+	AuditTwoFactorDisabled  AuditAction = "two_factor_disabled"
+	AuditRecoveryCodesReset AuditAction = "recovery_codes_regenerated"
+	AuditUserSuspended      AuditAction = "user_suspended"
+	AuditUserUnsuspended    AuditAction = "user_unsuspended"
+	AuditRoleChanged        AuditAction = "role_changed"
+	AuditBudgetAdjusted     AuditAction = "budget_adjusted"
+	AuditListingCancelled   AuditAction = "listing_cancelled"
+	AuditTransferReversed   AuditAction = "transfer_reversed"
+)
+
+
+type AuditEntry struct {
+	ID        uuid.UUID   `json:"id" db:"id"`
+	UserID    uuid.UUID   `json:"user_id" db:"user_id"`
+	ActorID   *uuid.UUID  `json:"actor_id,omitempty" db:"actor_id"`
+	Action    AuditAction `json:"action" db:"action"`
+	Details   string      `json:"details,omitempty" db:"details"`
+	IPAddress string      `json:"ip_address" db:"ip_address"`
+	UserAgent string      `json:"user_agent" db:"user_agent"`
+	CreatedAt time.Time   `json:"created_at" db:"created_at"`
+}
+
+
+func NewAuditEntry(userID uuid.UUID, action AuditAction, client SessionClient, details string) *AuditEntry {
+	return &AuditEntry{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Action:    action,
+		Details:   details,
+		IPAddress: client.IPAddress,
+		UserAgent: client.ShortUserAgent(),
+		CreatedAt: time.Now(),
+	}
+}
@@ -0,0 +1,187 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/google/uuid"
+)
+
+
+const (
+	BotEmailDomain       = "bots.soccer-manager.local"
+	BotAskingPriceMarkup = 1.10
+	BotMaxOverpay        = 1.25
+	BotSpendRatio        = 0.25
+	BotReserveBudget     = 1000000.00
+	BotPurchasesPerRun   = 1
+)
+
+
+var BotSquadShape = map[Position]int{
+	PositionGoalkeeper: 3,
+	PositionDefender:   6,
+	PositionMidfielder: 6,
+	PositionAttacker:   5,
+}
+
+var botFormations = []Formation{
+	Formation442, Formation433, Formation451, Formation352,
+	Formation532, Formation343, Formation541,
+}
+
+
+type BotActivity struct {
+	TeamsProcessed int `json:"teams_processed"`
+	LineupsPicked  int `json:"lineups_picked"`
+	PlayersListed  int `json:"players_listed"`
+	PlayersBought  int `json:"players_bought"`
+}
+
+
+func NewBotUser() *User {
+	user := NewUser("", "")
+	user.Email = fmt.Sprintf("bot-%s@%s", user.ID, BotEmailDomain)
+	return user
+}
+
+
+func AutoPickLineup(teamID uuid.UUID, players []*Player) *Lineup {
+	byPosition := make(map[Position][]*Player)
+	for _, player := range sortByRating(players) {
+		if player.IsAvailable() {
+			byPosition[player.Position] = append(byPosition[player.Position], player)
+		}
+	}
+	if len(byPosition[PositionGoalkeeper]) == 0 {
+		return nil
+	}
+
+
+	var best Formation
+	bestScore := -1
+	for _, formation := range botFormations {
+		shape, _ := formation.Shape()
+		needed := map[Position]int{
+			PositionDefender:   shape.Defenders,
+			PositionMidfielder: shape.Midfielders,
+			PositionAttacker:   shape.Attackers,
+		}
+
+		score := byPosition[PositionGoalkeeper][0].Rating()
+		for position, count := range needed {
+			if len(byPosition[position]) < count {
+				score = -1
+				break
+			}
+			for _, player := range byPosition[position][:count] {
+				score += player.Rating()
+			}
+		}
+		if score > bestScore {
+			best, bestScore = formation, score
+		}
+	}
+	if bestScore < 0 {
+		return nil
+	}
+
+
+	shape, _ := best.Shape()
+	picked := []*Player{byPosition[PositionGoalkeeper][0]}
+	picked = append(picked, byPosition[PositionDefender][:shape.Defenders]...)
+	picked = append(picked, byPosition[PositionMidfielder][:shape.Midfielders]...)
+	picked = append(picked, byPosition[PositionAttacker][:shape.Attackers]...)
+
+	starters := make([]uuid.UUID, 0, StartingPlayers)
+	chosen := make(map[uuid.UUID]bool, StartingPlayers+MaxSubstitutes)
+	for _, player := range picked {
+		starters = append(starters, player.ID)
+		chosen[player.ID] = true
+	}
+
+
+	substitutes := make([]uuid.UUID, 0, MaxSubstitutes)
+	bench := func(candidates []*Player) {
+		for _, player := range candidates {
+			if len(substitutes) >= MaxSubstitutes {
+				return
+			}
+			if chosen[player.ID] {
+				continue
+			}
+			substitutes = append(substitutes, player.ID)
+			chosen[player.ID] = true
+		}
+	}
+	if len(byPosition[PositionGoalkeeper]) > 1 {
+		bench(byPosition[PositionGoalkeeper][1:2])
+	}
+	for _, player := range sortByRating(players) {
+		if player.IsAvailable() {
+			bench([]*Player{player})
+		}
+	}
+
+	return NewLineup(teamID, best, starters, substitutes)
+}
+
+
+func SquadGaps(players []*Player) map[Position]int {
+	counts := countByPosition(players)
+	gaps := make(map[Position]int)
+	for position, target := range BotSquadShape {
+		if counts[position] < target {
+			gaps[position] = target - counts[position]
+		}
+	}
+	return gaps
+}
+
+
+func SurplusPlayers(players []*Player) []*Player {
+	counts := countByPosition(players)
+	surplus := make([]*Player, 0)
+	sorted := sortByRating(players)
+	for i := len(sorted) - 1; i >= 0; i-- {
+		player := sorted[i]
+		if counts[player.Position] > BotSquadShape[player.Position] {
+			surplus = append(surplus, player)
+			counts[player.Position]--
+		}
+	}
+	return surplus
+}
+
+
+func BotAskingPrice(player *Player) float64 {
+	return math.Round(player.MarketValue * BotAskingPriceMarkup)
+}
+
+
+func BotCanBuy(team *Team, player *Player, price float64) bool {
+	if price > player.MarketValue*BotMaxOverpay {
+		return false
+	}
+	if price > team.Budget*BotSpendRatio {
+		return false
+	}
+	return team.Budget-price >= BotReserveBudget
+}
+
+func countByPosition(players []*Player) map[Position]int {
+	counts := make(map[Position]int)
+	for _, player := range players {
+		counts[player.Position]++
+	}
+	return counts
+}
+
+func sortByRating(players []*Player) []*Player {
+	sorted := append([]*Player{}, players...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Rating() > sorted[j].Rating()
+	})
+	return sorted
+}
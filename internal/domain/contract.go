@@ -0,0 +1,151 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+
+type ContractStatus string
+
+const (
+	ContractStatusActive     ContractStatus = "active"
+	ContractStatusExpired    ContractStatus = "expired"
+	ContractStatusTerminated ContractStatus = "terminated"
+)
+
+const (
+	MinContractYears     = 1
+	MaxContractYears     = 5
+	DefaultContractYears = 3
+	WeeklyWageRate       = 0.001
+	MinWeeklyWage        = 500.00
+)
+
+
+type Contract struct {
+	ID         uuid.UUID      `json:"id" db:"id"`
+	PlayerID   uuid.UUID      `json:"player_id" db:"player_id"`
+	TeamID     uuid.UUID      `json:"team_id" db:"team_id"`
+	WeeklyWage float64        `json:"weekly_wage" db:"weekly_wage"`
+	Status     ContractStatus `json:"status" db:"status"`
+	StartedAt  time.Time      `json:"started_at" db:"started_at"`
+	ExpiresAt  time.Time      `json:"expires_at" db:"expires_at"`
+	EndedAt    *time.Time     `json:"ended_at,omitempty" db:"ended_at"`
+	UpdatedAt  time.Time      `json:"updated_at" db:"updated_at"`
+}
+
+
+type PayrollPayment struct {
+	ID      uuid.UUID `json:"id" db:"id"`
+	TeamID  uuid.UUID `json:"team_id" db:"team_id"`
+	Amount  float64   `json:"amount" db:"amount"`
+	Players int       `json:"players" db:"players"`
+	Period  string    `json:"period" db:"period"`
+	PaidAt  time.Time `json:"paid_at" db:"paid_at"`
+}
+
+
+func StandardWage(player *Player) float64 {
+	wage := math.Round(player.MarketValue * WeeklyWageRate)
+	if wage < MinWeeklyWage {
+		return MinWeeklyWage
+	}
+	return wage
+}
+
+
+func NewContract(player *Player, teamID uuid.UUID, weeklyWage float64, years int) *Contract {
+	now := time.Now()
+	return &Contract{
+		ID:         uuid.New(),
+		PlayerID:   player.ID,
+		TeamID:     teamID,
+		WeeklyWage: weeklyWage,
+		Status:     ContractStatusActive,
+		StartedAt:  now,
+		ExpiresAt:  now.AddDate(years, 0, 0),
+		UpdatedAt:  now,
+	}
+}
+
+
+func NewStandardContract(player *Player, teamID uuid.UUID) *Contract {
+	return NewContract(player, teamID, StandardWage(player), DefaultContractYears)
+}
+
+
+func NewInitialContract(player *Player, teamID uuid.UUID) *Contract {
+	years := MinContractYears + rand.Intn(MaxContractYears-MinContractYears+1)
+	return NewContract(player, teamID, StandardWage(player), years)
+}
+
+
+func (c *Contract) IsActive() bool {
+	return c.Status == ContractStatusActive
+}
+
+
+func (c *Contract) IsExpiredAt(now time.Time) bool {
+	return c.IsActive() && !c.ExpiresAt.After(now)
+}
+
+
+func (c *Contract) Renegotiate(player *Player, weeklyWage float64, years int) error {
+	if years < MinContractYears || years > MaxContractYears {
+		return ErrInvalidContractLength
+	}
+	if weeklyWage < StandardWage(player) {
+		return ErrWageTooLow
+	}
+
+	now := time.Now()
+	c.WeeklyWage = weeklyWage
+	c.ExpiresAt = now.AddDate(years, 0, 0)
+	c.UpdatedAt = now
+	return nil
+}
+
+
+func (c *Contract) Expire() {
+	c.end(ContractStatusExpired)
+}
+
+
+func (c *Contract) Terminate() {
+	c.end(ContractStatusTerminated)
+}
+
+func (c *Contract) end(status ContractStatus) {
+	now := time.Now()
+	c.Status = status
+	c.EndedAt = &now
+	c.UpdatedAt = now
+}
+
+
+func NewPayrollPayment(teamID uuid.UUID, contracts []*Contract) *PayrollPayment {
+	total := 0.0
+	for _, contract := range contracts {
+		total += contract.WeeklyWage
+	}
+	now := time.Now()
+	return &PayrollPayment{
+		ID:      uuid.New(),
+		TeamID:  teamID,
+		Amount:  total,
+		Players: len(contracts),
+		Period:  PayrollPeriod(now),
+		PaidAt:  now,
+	}
+}
+
+
+func PayrollPeriod(t time.Time) string {
+	year, week := t.ISOWeek()
+	return fmt.Sprintf("%d-W%02d", year, week)
+}
@@ -0,0 +1,119 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStandardWage(t *testing.T) {
+	tests := []struct {
+		marketValue float64
+		want        float64
+	}{
+		{1000000, 1000},
+		{1234567, 1235},
+		{500000, MinWeeklyWage},
+		{100000, MinWeeklyWage},
+		{0, MinWeeklyWage},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, StandardWage(&Player{MarketValue: tt.marketValue}), "market value %.0f", tt.marketValue)
+	}
+}
+
+func TestPayrollPeriod(t *testing.T) {
+	tests := []struct {
+		date time.Time
+		want string
+	}{
+		{time.Date(2026, time.January, 1, 12, 0, 0, 0, time.UTC), "2026-W01"},
+		{time.Date(2026, time.October, 18, 0, 0, 0, 0, time.UTC), "2026-W42"},
+		{time.Date(2024, time.December, 30, 0, 0, 0, 0, time.UTC), "2025-W01"},
+		{time.Date(2021, time.January, 3, 23, 59, 0, 0, time.UTC), "2020-W53"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, PayrollPeriod(tt.date), tt.date.Format(time.DateOnly))
+	}
+}
+
+func TestPayrollPeriodIsStableWithinAWeek(t *testing.T) {
+	monday := time.Date(2026, time.October, 12, 0, 0, 0, 0, time.UTC)
+	for day := 0; day < 7; day++ {
+		assert.Equal(t, PayrollPeriod(monday), PayrollPeriod(monday.AddDate(0, 0, day)))
+	}
+	assert.NotEqual(t, PayrollPeriod(monday), PayrollPeriod(monday.AddDate(0, 0, 7)))
+}
+
+func TestNewPayrollPayment(t *testing.T) {
+	teamID := uuid.New()
+	contracts := []*Contract{
+		{WeeklyWage: 1000},
+		{WeeklyWage: 2500},
+		{WeeklyWage: 500},
+	}
+
+	payment := NewPayrollPayment(teamID, contracts)
+
+	assert.Equal(t, teamID, payment.TeamID)
+	assert.Equal(t, 4000.0, payment.Amount)
+	assert.Equal(t, 3, payment.Players)
+	assert.Equal(t, PayrollPeriod(payment.PaidAt), payment.Period)
+}
+
+func TestContractRenegotiate(t *testing.T) {
+	player := &Player{MarketValue: 2000000}
+
+	tests := []struct {
+		name    string
+		wage    float64
+		years   int
+		wantErr error
+	}{
+		{"standard wage", 2000, DefaultContractYears, nil},
+		{"raise", 5000, MaxContractYears, nil},
+		{"below standard wage", 1999, DefaultContractYears, ErrWageTooLow},
+		{"too short", 2000, MinContractYears - 1, ErrInvalidContractLength},
+		{"too long", 2000, MaxContractYears + 1, ErrInvalidContractLength},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			contract := NewStandardContract(player, uuid.New())
+			original := *contract
+
+			err := contract.Renegotiate(player, tt.wage, tt.years)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Equal(t, original.WeeklyWage, contract.WeeklyWage)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wage, contract.WeeklyWage)
+			assert.WithinDuration(t, time.Now().AddDate(tt.years, 0, 0), contract.ExpiresAt, time.Minute)
+		})
+	}
+}
+
+func TestContractLifecycle(t *testing.T) {
+	now := time.Now()
+	contract := NewContract(&Player{MarketValue: 1000000}, uuid.New(), 1000, 1)
+
+	assert.True(t, contract.IsActive())
+	assert.False(t, contract.IsExpiredAt(now))
+	assert.True(t, contract.IsExpiredAt(contract.ExpiresAt))
+
+	contract.Expire()
+	assert.Equal(t, ContractStatusExpired, contract.Status)
+	assert.NotNil(t, contract.EndedAt)
+	assert.False(t, contract.IsExpiredAt(contract.ExpiresAt))
+
+	terminated := NewStandardContract(&Player{MarketValue: 1000000}, uuid.New())
+	terminated.Terminate()
+	assert.Equal(t, ContractStatusTerminated, terminated.Status)
+	assert.False(t, terminated.IsActive())
+}
@@ -0,0 +1,57 @@
+package domain
+
+import "github.com/google/uuid"
+
+
+const DraftPoolMultiplier = 2
+
+
+var InitialSquadShape = map[Position]int{
+	PositionGoalkeeper: 3,
+	PositionDefender:   6,
+	PositionMidfielder: 6,
+	PositionAttacker:   5,
+}
+
+
+type DraftBoard struct {
+	TeamID    uuid.UUID        `json:"team_id"`
+	Remaining map[Position]int `json:"remaining"`
+	Picked    []*Player        `json:"picked"`
+	Pool      []*Player        `json:"pool"`
+	Complete  bool             `json:"complete"`
+}
+
+
+func NewDraftPool(newPlayer func(position Position) *Player) []*Player {
+	pool := make([]*Player, 0, MaxPlayers*DraftPoolMultiplier)
+	for _, position := range []Position{PositionGoalkeeper, PositionDefender, PositionMidfielder, PositionAttacker} {
+		for i := 0; i < InitialSquadShape[position]*DraftPoolMultiplier; i++ {
+			pool = append(pool, newPlayer(position))
+		}
+	}
+	return pool
+}
+
+
+func RemainingPicks(squad []*Player) map[Position]int {
+	counts := countByPosition(squad)
+	remaining := make(map[Position]int, len(InitialSquadShape))
+	for position, target := range InitialSquadShape {
+		remaining[position] = 0
+		if counts[position] < target {
+			remaining[position] = target - counts[position]
+		}
+	}
+	return remaining
+}
+
+
+func DraftComplete(remaining map[Position]int) bool {
+	for _, count := range remaining {
+		if count > 0 {
+			return false
+		}
+	}
+	return true
+}
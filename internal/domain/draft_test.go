@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func squadOf(counts map[Position]int) []*Player {
+	squad := make([]*Player, 0)
+	for position, count := range counts {
+		for i := 0; i < count; i++ {
+			squad = append(squad, &Player{Position: position})
+		}
+	}
+	return squad
+}
+
+func TestRemainingPicks(t *testing.T) {
+	tests := []struct {
+		name         string
+		squad        map[Position]int
+		want         map[Position]int
+		wantComplete bool
+	}{
+		{
+			name:  "empty squad",
+			squad: map[Position]int{},
+			want:  InitialSquadShape,
+		},
+		{
+			name:  "partly drafted",
+			squad: map[Position]int{PositionGoalkeeper: 1, PositionDefender: 6, PositionAttacker: 2},
+			want:  map[Position]int{PositionGoalkeeper: 2, PositionDefender: 0, PositionMidfielder: 6, PositionAttacker: 3},
+		},
+		{
+			name:         "complete",
+			squad:        InitialSquadShape,
+			want:         map[Position]int{PositionGoalkeeper: 0, PositionDefender: 0, PositionMidfielder: 0, PositionAttacker: 0},
+			wantComplete: true,
+		},
+		{
+			name:  "surplus never goes negative",
+			squad: map[Position]int{PositionGoalkeeper: 5, PositionDefender: 6, PositionMidfielder: 6, PositionAttacker: 4},
+			want:  map[Position]int{PositionGoalkeeper: 0, PositionDefender: 0, PositionMidfielder: 0, PositionAttacker: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			remaining := RemainingPicks(squadOf(tt.squad))
+
+			assert.Equal(t, tt.want, remaining)
+			assert.Equal(t, tt.wantComplete, DraftComplete(remaining))
+		})
+	}
+}
+
+func TestNewDraftPool(t *testing.T) {
+	pool := NewDraftPool(func(position Position) *Player {
+		return &Player{Position: position}
+	})
+
+	assert.Len(t, pool, MaxPlayers*DraftPoolMultiplier)
+	counts := countByPosition(pool)
+	for position, target := range InitialSquadShape {
+		assert.Equal(t, target*DraftPoolMultiplier, counts[position], string(position))
+	}
+}
@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+
+type EmailTokenPurpose string
+
+const (
+	EmailTokenVerifyEmail   EmailTokenPurpose = "verify_email"
+	EmailTokenResetPassword EmailTokenPurpose = "reset_password"
+)
+
+const (
+	VerifyEmailTokenTTL   = 48 * time.Hour
+	ResetPasswordTokenTTL = time.Hour
+)
+
+
+type EmailToken struct {
+	ID        uuid.UUID         `json:"id" db:"id"`
+	UserID    uuid.UUID         `json:"user_id" db:"user_id"`
+	Email     string            `json:"email" db:"email"`
+	Purpose   EmailTokenPurpose `json:"purpose" db:"purpose"`
+	TokenHash string            `json:"-" db:"token_hash"`
+	ExpiresAt time.Time         `json:"expires_at" db:"expires_at"`
+	UsedAt    *time.Time        `json:"used_at,omitempty" db:"used_at"`
+	CreatedAt time.Time         `json:"created_at" db:"created_at"`
+}
+
+
+func NewEmailToken(userID uuid.UUID, email string, purpose EmailTokenPurpose) (*EmailToken, string, error) {
+	token, hash, err := NewOpaqueToken()
+	if err != nil {
+		return nil, "", err
+	}
+
+	now := time.Now()
+	return &EmailToken{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Email:     email,
+		Purpose:   purpose,
+		TokenHash: hash,
+		ExpiresAt: now.Add(purpose.TTL()),
+		CreatedAt: now,
+	}, token, nil
+}
+
+
+func (p EmailTokenPurpose) TTL() time.Duration {
+	if p == EmailTokenResetPassword {
+		return ResetPasswordTokenTTL
+	}
+	return VerifyEmailTokenTTL
+}
+
+
+func (t *EmailToken) IsUsable(now time.Time) bool {
+	return t.UsedAt == nil && now.Before(t.ExpiresAt)
+}
+
+
+func (t *EmailToken) IssuedTo(email string) bool {
+	return t.Email != "" && strings.EqualFold(t.Email, email)
+}
@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEmailTokenIssuedTo(t *testing.T) {
+	tests := []struct {
+		name   string
+		issued string
+		email  string
+		want   bool
+	}{
+		{"same address", "user@example.com", "user@example.com", true},
+		{"different case", "User@Example.com", "user@example.com", true},
+		{"changed address", "old@example.com", "new@example.com", false},
+		{"unknown address", "", "user@example.com", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token, raw, err := NewEmailToken(uuid.New(), tt.issued, EmailTokenResetPassword)
+			assert.NoError(t, err)
+			assert.NotEmpty(t, raw)
+			assert.Equal(t, tt.want, token.IssuedTo(tt.email))
+		})
+	}
+}
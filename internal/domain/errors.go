@@ -5,9 +5,31 @@ import "errors"
 
 var (
 
-	ErrUserNotFound       = errors.New("user not found")
-	ErrUserAlreadyExists  = errors.New("user already exists")
-	ErrInvalidCredentials = errors.New("invalid credentials")
+	ErrUserNotFound         = errors.New("user not found")
+	ErrUserAlreadyExists    = errors.New("user already exists")
+	ErrInvalidCredentials   = errors.New("invalid credentials")
+	ErrUserBanned           = errors.New("user is banned")
+	ErrEmailNotVerified     = errors.New("email address is not verified")
+	ErrEmailAlreadyVerified = errors.New("email address is already verified")
+	ErrInvalidEmailToken    = errors.New("invalid or expired email token")
+	ErrDeletionScheduled    = errors.New("account deletion is already scheduled")
+	ErrTooManyLoginAttempts = errors.New("too many login attempts; try again later")
+	ErrAccountLocked        = errors.New("account is temporarily locked; try again later")
+	ErrInvalidRole          = errors.New("role must be manager, moderator or admin")
+	ErrInsufficientRole     = errors.New("you cannot manage a user with the same or a higher role")
+	ErrInvalidUserStatus    = errors.New("status must be active or suspended")
+
+
+	ErrTwoFactorAlreadyEnabled = errors.New("two-factor authentication is already enabled")
+	ErrTwoFactorNotEnabled     = errors.New("two-factor authentication is not enabled")
+	ErrTwoFactorNotPending     = errors.New("two-factor authentication has not been set up")
+	ErrInvalidTwoFactorCode    = errors.New("invalid two-factor code")
+	ErrInvalidChallenge        = errors.New("invalid or expired two-factor challenge")
+
+
+	ErrSessionNotFound     = errors.New("session not found")
+	ErrInvalidRefreshToken = errors.New("refresh token is invalid or expired")
+	ErrRefreshTokenReused  = errors.New("refresh token was already used; session has been revoked")
 
 
 	ErrTeamNotFound       = errors.New("team not found")
@@ -15,17 +37,95 @@ var (
 	ErrTeamFull           = errors.New("team already has maximum number of players")
 	ErrInsufficientBudget = errors.New("insufficient budget")
 	ErrCannotBuyOwnPlayer = errors.New("cannot buy your own player")
+	ErrNotBotTeam         = errors.New("team is not a bot team")
+	ErrTeamNotOwned       = errors.New("team does not belong to you")
+	ErrTooManyTeams       = errors.New("user already has maximum number of teams")
+	ErrTeamNameTaken      = errors.New("team name is already taken")
+	ErrInvalidCountry     = errors.New("country must be an ISO 3166 country name or code")
+	ErrInvalidCrestColour = errors.New("crest colours must be hex colours like #1A2B3C")
+	ErrDraftNotFound      = errors.New("team has no draft in progress")
+	ErrInvalidDraftPick   = errors.New("player is not in the draft pool or the position is already filled")
 
 
 	ErrPlayerNotFound          = errors.New("player not found")
 	ErrPlayerNotOwned          = errors.New("player does not belong to your team")
 	ErrPlayerAlreadyListed     = errors.New("player is already on transfer list")
 	ErrPlayerNotOnTransferList = errors.New("player is not on transfer list")
+	ErrPlayerUnavailable       = errors.New("player is injured or suspended")
+	ErrInvalidAbsence          = errors.New("absence length must be positive")
+	ErrPlayerTeamChanged       = errors.New("player has changed team in the meantime")
+	ErrPlayerNotScouted        = errors.New("player details require ownership or a complete scout report")
 
 
 	ErrTransferNotFound        = errors.New("transfer not found")
 	ErrTransferListingNotFound = errors.New("transfer listing not found")
 	ErrInvalidAskingPrice      = errors.New("invalid asking price")
+	ErrTransferAlreadyReversed = errors.New("transfer has already been reversed")
+	ErrTransferNotReversible   = errors.New("transfer cannot be reversed once the player has left the buying team")
+
+
+	ErrLineupNotFound         = errors.New("lineup not found")
+	ErrInvalidFormation       = errors.New("invalid formation")
+	ErrInvalidLineup          = errors.New("lineup must have 11 unique starters and at most 7 substitutes")
+	ErrLineupPositionMismatch = errors.New("starters do not match formation positions")
+
+
+	ErrSeasonNotFound         = errors.New("season not found")
+	ErrSeasonAlreadyCompleted = errors.New("season has already been rolled over")
+
+
+	ErrAcademyNotFound  = errors.New("academy not found")
+	ErrAcademyFull      = errors.New("academy already has maximum number of prospects")
+	ErrProspectNotFound = errors.New("youth prospect not found")
+
+
+	ErrInvalidTrainingFocus       = errors.New("invalid training focus")
+	ErrInvalidTrainingTarget      = errors.New("training must target either a player or a position")
+	ErrTrainingAssignmentNotFound = errors.New("training assignment not found")
+
+
+	ErrContractNotFound      = errors.New("contract not found")
+	ErrInvalidContractLength = errors.New("contract length must be between 1 and 5 years")
+	ErrWageTooLow            = errors.New("offered wage is below the player's standard wage")
+	ErrPayrollAlreadyPaid    = errors.New("wages for this period have already been paid")
+
+	ErrMatchNotFound          = errors.New("match not found")
+	ErrInvalidMatch           = errors.New("invalid match")
+	ErrMatchAlreadyPlayed     = errors.New("match has already been played")
+	ErrInvalidPrize           = errors.New("invalid prize")
+	ErrSponsorshipNotFound    = errors.New("sponsorship not found")
+	ErrSponsorshipAlreadyPaid = errors.New("sponsorship payment has already been recorded")
+	ErrSponsorshipActive      = errors.New("team already has an active sponsorship deal")
+
+	ErrFacilitiesNotFound        = errors.New("facilities not found")
+	ErrInvalidFacility           = errors.New("invalid facility")
+	ErrFacilityMaxLevel          = errors.New("facility is already at maximum level")
+	ErrConstructionInProgress    = errors.New("facility upgrade is already in progress")
+	ErrConstructionNotInProgress = errors.New("construction project is not in progress")
+	ErrInvalidTicketPrice        = errors.New("ticket price must be between 5 and 200")
+
+	ErrInvalidLeaderboard = errors.New("invalid leaderboard category")
+
+
+	ErrScoutNotFound          = errors.New("scout not found")
+	ErrTooManyScouts          = errors.New("team already has maximum number of scouts")
+	ErrInvalidScoutSkill      = errors.New("scout skill must be between 1 and 5")
+	ErrInvalidScoutAssignment = errors.New("scout must be assigned to either another team's player or a region")
+	ErrScoutReportNotFound    = errors.New("scout report not found")
+
+
+	ErrNoFriendlyOpponent = errors.New("no opponent available for a friendly")
+
+
+	ErrLeagueNotFound          = errors.New("league not found")
+	ErrAlreadyInLeague         = errors.New("team is already in a league")
+	ErrLeagueFull              = errors.New("league already has maximum number of teams")
+	ErrNotCommissioner         = errors.New("only the league commissioner can do this")
+	ErrCommissionerCannotLeave = errors.New("commissioner cannot leave the league")
+	ErrInvalidLeagueSettings   = errors.New("league caps must be positive")
+	ErrTransferOutsideLeague   = errors.New("league only allows transfers between its members")
+	ErrTransferBudgetCap       = errors.New("transfer fee exceeds the league transfer budget cap")
+	ErrSquadValueCap           = errors.New("transfer would exceed the league max squad value")
 )
 
 
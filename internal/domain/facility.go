@@ -0,0 +1,195 @@
+package domain
+
+import (
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+
+type FacilityType string
+
+const (
+	FacilityStadium        FacilityType = "stadium"
+	FacilityTrainingGround FacilityType = "training_ground"
+	FacilityMedicalCentre  FacilityType = "medical_centre"
+	FacilityAcademy        FacilityType = "academy"
+)
+
+
+func (f FacilityType) IsValid() bool {
+	switch f {
+	case FacilityStadium, FacilityTrainingGround, FacilityMedicalCentre, FacilityAcademy:
+		return true
+	}
+	return false
+}
+
+
+type ConstructionStatus string
+
+const (
+	ConstructionInProgress ConstructionStatus = "in_progress"
+	ConstructionCompleted  ConstructionStatus = "completed"
+)
+
+const (
+	MinFacilityLevel        = 1
+	MaxFacilityLevel        = 5
+	StadiumCapacityPerLevel = 10000
+	MinTicketPrice          = 5.00
+	MaxTicketPrice          = 200.00
+	MedicalRecoveryPerLevel = 0.10
+)
+
+
+var facilityBaseCosts = map[FacilityType]float64{
+	FacilityStadium:        1000000.00,
+	FacilityTrainingGround: 400000.00,
+	FacilityMedicalCentre:  300000.00,
+	FacilityAcademy:        AcademyUpgradeBaseCost,
+}
+
+
+var facilityBuildDays = map[FacilityType]int{
+	FacilityStadium:        14,
+	FacilityTrainingGround: 7,
+	FacilityMedicalCentre:  7,
+	FacilityAcademy:        7,
+}
+
+
+type Facilities struct {
+	TeamID              uuid.UUID `json:"team_id" db:"team_id"`
+	StadiumLevel        int       `json:"stadium_level" db:"stadium_level"`
+	TicketPrice         float64   `json:"ticket_price" db:"ticket_price"`
+	TrainingGroundLevel int       `json:"training_ground_level" db:"training_ground_level"`
+	MedicalCentreLevel  int       `json:"medical_centre_level" db:"medical_centre_level"`
+	UpdatedAt           time.Time `json:"updated_at" db:"updated_at"`
+}
+
+
+type ConstructionProject struct {
+	ID          uuid.UUID          `json:"id" db:"id"`
+	TeamID      uuid.UUID          `json:"team_id" db:"team_id"`
+	Facility    FacilityType       `json:"facility" db:"facility"`
+	TargetLevel int                `json:"target_level" db:"target_level"`
+	Cost        float64            `json:"cost" db:"cost"`
+	Status      ConstructionStatus `json:"status" db:"status"`
+	StartedAt   time.Time          `json:"started_at" db:"started_at"`
+	CompletesAt time.Time          `json:"completes_at" db:"completes_at"`
+	CompletedAt *time.Time         `json:"completed_at,omitempty" db:"completed_at"`
+}
+
+
+type FacilitiesOverview struct {
+	Facilities
+	StadiumCapacity int                      `json:"stadium_capacity"`
+	AcademyLevel    int                      `json:"academy_level"`
+	UpgradeCosts    map[FacilityType]float64 `json:"upgrade_costs"`
+	Projects        []*ConstructionProject   `json:"projects"`
+}
+
+
+func NewFacilities(teamID uuid.UUID) *Facilities {
+	return &Facilities{
+		TeamID:              teamID,
+		StadiumLevel:        MinFacilityLevel,
+		TicketPrice:         DefaultTicketPrice,
+		TrainingGroundLevel: MinFacilityLevel,
+		MedicalCentreLevel:  MinFacilityLevel,
+		UpdatedAt:           time.Now(),
+	}
+}
+
+
+func (f *Facilities) StadiumCapacity() int {
+	return DefaultStadiumCapacity + (f.StadiumLevel-MinFacilityLevel)*StadiumCapacityPerLevel
+}
+
+
+func (f *Facilities) Level(facility FacilityType) int {
+	switch facility {
+	case FacilityStadium:
+		return f.StadiumLevel
+	case FacilityTrainingGround:
+		return f.TrainingGroundLevel
+	case FacilityMedicalCentre:
+		return f.MedicalCentreLevel
+	}
+	return 0
+}
+
+
+func (f *Facilities) SetLevel(facility FacilityType, level int) {
+	switch facility {
+	case FacilityStadium:
+		f.StadiumLevel = level
+	case FacilityTrainingGround:
+		f.TrainingGroundLevel = level
+	case FacilityMedicalCentre:
+		f.MedicalCentreLevel = level
+	}
+	f.UpdatedAt = time.Now()
+}
+
+
+func (f *Facilities) SetTicketPrice(price float64) error {
+	if price < MinTicketPrice || price > MaxTicketPrice {
+		return ErrInvalidTicketPrice
+	}
+	f.TicketPrice = price
+	f.UpdatedAt = time.Now()
+	return nil
+}
+
+
+func FacilityUpgradeCost(facility FacilityType, targetLevel int) float64 {
+	return facilityBaseCosts[facility] * float64(targetLevel)
+}
+
+
+func InjuryDays(days, medicalCentreLevel int) int {
+	reduction := MedicalRecoveryPerLevel * float64(medicalCentreLevel-MinFacilityLevel)
+	adjusted := int(math.Round(float64(days) * (1 - reduction)))
+	if adjusted < 1 {
+		return 1
+	}
+	return adjusted
+}
+
+
+func NewConstructionProject(teamID uuid.UUID, facility FacilityType, currentLevel int) (*ConstructionProject, error) {
+	if !facility.IsValid() {
+		return nil, ErrInvalidFacility
+	}
+	if currentLevel >= MaxFacilityLevel {
+		return nil, ErrFacilityMaxLevel
+	}
+
+	target := currentLevel + 1
+	now := time.Now()
+	return &ConstructionProject{
+		ID:          uuid.New(),
+		TeamID:      teamID,
+		Facility:    facility,
+		TargetLevel: target,
+		Cost:        FacilityUpgradeCost(facility, target),
+		Status:      ConstructionInProgress,
+		StartedAt:   now,
+		CompletesAt: now.AddDate(0, 0, facilityBuildDays[facility]*target),
+	}, nil
+}
+
+
+func (p *ConstructionProject) IsDueAt(now time.Time) bool {
+	return p.Status == ConstructionInProgress && !p.CompletesAt.After(now)
+}
+
+
+func (p *ConstructionProject) Complete() {
+	now := time.Now()
+	p.Status = ConstructionCompleted
+	p.CompletedAt = &now
+}
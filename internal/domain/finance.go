@@ -0,0 +1,108 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+
+type FinanceCategory string
+
+const (
+	FinanceSponsorship      FinanceCategory = "sponsorship"
+	FinanceGateReceipts     FinanceCategory = "gate_receipts"
+	FinancePrizeMoney       FinanceCategory = "prize_money"
+	FinanceWages            FinanceCategory = "wages"
+	FinanceConstruction     FinanceCategory = "construction"
+	FinanceScouting         FinanceCategory = "scouting"
+	FinanceAdjustment       FinanceCategory = "adjustment"
+	FinanceTransferReversal FinanceCategory = "transfer_reversal"
+	FinanceTransfer         FinanceCategory = "transfer"
+)
+
+const (
+	DefaultForecastWeeks = 4
+	MaxForecastWeeks     = 52
+)
+
+
+type FinanceTransaction struct {
+	ID           uuid.UUID       `json:"id" db:"id"`
+	TeamID       uuid.UUID       `json:"team_id" db:"team_id"`
+	Category     FinanceCategory `json:"category" db:"category"`
+	Amount       float64         `json:"amount" db:"amount"`
+	Description  string          `json:"description" db:"description"`
+	ReferenceID  *uuid.UUID      `json:"reference_id,omitempty" db:"reference_id"`
+	BalanceAfter float64         `json:"balance_after" db:"balance_after"`
+	CreatedAt    time.Time       `json:"created_at" db:"created_at"`
+}
+
+
+type FinanceForecast struct {
+	Weeks        int     `json:"weeks"`
+	Budget       float64 `json:"budget"`
+	Sponsorship  float64 `json:"sponsorship"`
+	GateReceipts float64 `json:"gate_receipts"`
+	HomeFixtures int     `json:"home_fixtures"`
+	Wages        float64 `json:"wages"`
+	Net          float64 `json:"net"`
+	Projected    float64 `json:"projected_budget"`
+}
+
+
+func (c FinanceCategory) AllowsOverdraft() bool {
+	switch c {
+	case FinanceWages, FinanceAdjustment, FinanceTransferReversal:
+		return true
+	}
+	return false
+}
+
+
+func NewFinanceTransaction(teamID uuid.UUID, category FinanceCategory, amount float64, description string, referenceID *uuid.UUID) *FinanceTransaction {
+	return &FinanceTransaction{
+		ID:          uuid.New(),
+		TeamID:      teamID,
+		Category:    category,
+		Amount:      amount,
+		Description: description,
+		ReferenceID: referenceID,
+		CreatedAt:   time.Now(),
+	}
+}
+
+
+func PrizeMoney(competition Competition, position int) (float64, error) {
+	if position < 1 {
+		return 0, ErrInvalidPrize
+	}
+
+	switch competition {
+	case CompetitionLeague:
+		switch {
+		case position == 1:
+			return 2000000, nil
+		case position == 2:
+			return 1500000, nil
+		case position == 3:
+			return 1000000, nil
+		case position <= 10:
+			return 500000, nil
+		default:
+			return 250000, nil
+		}
+	case CompetitionCup:
+		switch {
+		case position == 1:
+			return 1000000, nil
+		case position == 2:
+			return 500000, nil
+		case position <= 4:
+			return 250000, nil
+		default:
+			return 100000, nil
+		}
+	}
+	return 0, ErrInvalidPrize
+}
@@ -0,0 +1,120 @@
+package domain
+
+import (
+	"crypto/rand"
+	"math/big"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+
+const (
+	InviteCodeLength   = 8
+	inviteCodeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+	MaxLeagueMembers   = 20
+)
+
+
+type League struct {
+	ID                  uuid.UUID `json:"id" db:"id"`
+	Name                string    `json:"name" db:"name"`
+	InviteCode          string    `json:"invite_code" db:"invite_code"`
+	CommissionerID      uuid.UUID `json:"commissioner_id" db:"commissioner_id"`
+	TransferBudgetCap   *float64  `json:"transfer_budget_cap,omitempty" db:"transfer_budget_cap"`
+	MaxSquadValue       *float64  `json:"max_squad_value,omitempty" db:"max_squad_value"`
+	LeagueOnlyTransfers bool      `json:"league_only_transfers" db:"league_only_transfers"`
+	CreatedAt           time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt           time.Time `json:"updated_at" db:"updated_at"`
+}
+
+
+type LeagueSettings struct {
+	TransferBudgetCap   *float64
+	MaxSquadValue       *float64
+	LeagueOnlyTransfers bool
+}
+
+
+type LeagueWithMembers struct {
+	League
+	Members []*Team `json:"members"`
+}
+
+
+func NewLeague(commissionerID uuid.UUID, name string, settings LeagueSettings) (*League, error) {
+	code, err := NewInviteCode()
+	if err != nil {
+		return nil, err
+	}
+
+	league := &League{
+		ID:             uuid.New(),
+		Name:           name,
+		InviteCode:     code,
+		CommissionerID: commissionerID,
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	}
+	if err := league.UpdateSettings(settings); err != nil {
+		return nil, err
+	}
+	return league, nil
+}
+
+
+func NewInviteCode() (string, error) {
+	code := make([]byte, InviteCodeLength)
+	max := big.NewInt(int64(len(inviteCodeAlphabet)))
+	for i := range code {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		code[i] = inviteCodeAlphabet[n.Int64()]
+	}
+	return string(code), nil
+}
+
+
+func (l *League) IsCommissioner(userID uuid.UUID) bool {
+	return l.CommissionerID == userID
+}
+
+
+func (l *League) UpdateSettings(settings LeagueSettings) error {
+	if settings.TransferBudgetCap != nil && *settings.TransferBudgetCap <= 0 {
+		return ErrInvalidLeagueSettings
+	}
+	if settings.MaxSquadValue != nil && *settings.MaxSquadValue <= 0 {
+		return ErrInvalidLeagueSettings
+	}
+
+	l.TransferBudgetCap = settings.TransferBudgetCap
+	l.MaxSquadValue = settings.MaxSquadValue
+	l.LeagueOnlyTransfers = settings.LeagueOnlyTransfers
+	l.UpdatedAt = time.Now()
+	return nil
+}
+
+
+func CheckLeagueTransfer(buyerLeague, sellerLeague *League, price, squadValueAfter float64) error {
+	sameLeague := buyerLeague != nil && sellerLeague != nil && buyerLeague.ID == sellerLeague.ID
+	if buyerLeague != nil && buyerLeague.LeagueOnlyTransfers && !sameLeague {
+		return ErrTransferOutsideLeague
+	}
+	if sellerLeague != nil && sellerLeague.LeagueOnlyTransfers && !sameLeague {
+		return ErrTransferOutsideLeague
+	}
+	if buyerLeague == nil {
+		return nil
+	}
+
+	if buyerLeague.TransferBudgetCap != nil && price > *buyerLeague.TransferBudgetCap {
+		return ErrTransferBudgetCap
+	}
+	if buyerLeague.MaxSquadValue != nil && squadValueAfter > *buyerLeague.MaxSquadValue {
+		return ErrSquadValueCap
+	}
+	return nil
+}
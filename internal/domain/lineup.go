@@ -0,0 +1,140 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+
+type Formation string
+
+const (
+	Formation442 Formation = "4-4-2"
+	Formation433 Formation = "4-3-3"
+	Formation352 Formation = "3-5-2"
+	Formation451 Formation = "4-5-1"
+	Formation532 Formation = "5-3-2"
+	Formation343 Formation = "3-4-3"
+	Formation541 Formation = "5-4-1"
+)
+
+
+type FormationShape struct {
+	Defenders   int `json:"defenders"`
+	Midfielders int `json:"midfielders"`
+	Attackers   int `json:"attackers"`
+}
+
+var formationShapes = map[Formation]FormationShape{
+	Formation442: {Defenders: 4, Midfielders: 4, Attackers: 2},
+	Formation433: {Defenders: 4, Midfielders: 3, Attackers: 3},
+	Formation352: {Defenders: 3, Midfielders: 5, Attackers: 2},
+	Formation451: {Defenders: 4, Midfielders: 5, Attackers: 1},
+	Formation532: {Defenders: 5, Midfielders: 3, Attackers: 2},
+	Formation343: {Defenders: 3, Midfielders: 4, Attackers: 3},
+	Formation541: {Defenders: 5, Midfielders: 4, Attackers: 1},
+}
+
+const (
+	StartingPlayers = 11
+	MaxSubstitutes  = 7
+)
+
+
+func (f Formation) Shape() (FormationShape, bool) {
+	shape, ok := formationShapes[f]
+	return shape, ok
+}
+
+
+type LineupRole string
+
+const (
+	LineupRoleStarter    LineupRole = "starter"
+	LineupRoleSubstitute LineupRole = "substitute"
+)
+
+
+type Lineup struct {
+	TeamID      uuid.UUID   `json:"team_id" db:"team_id"`
+	Formation   Formation   `json:"formation" db:"formation"`
+	Starters    []uuid.UUID `json:"starters" db:"-"`
+	Substitutes []uuid.UUID `json:"substitutes" db:"-"`
+	Valid       bool        `json:"valid" db:"is_valid"`
+	UpdatedAt   time.Time   `json:"updated_at" db:"updated_at"`
+}
+
+
+func NewLineup(teamID uuid.UUID, formation Formation, starters, substitutes []uuid.UUID) *Lineup {
+	return &Lineup{
+		TeamID:      teamID,
+		Formation:   formation,
+		Starters:    starters,
+		Substitutes: substitutes,
+		Valid:       true,
+		UpdatedAt:   time.Now(),
+	}
+}
+
+
+func (l *Lineup) Validate(squad map[uuid.UUID]*Player) error {
+	shape, ok := l.Formation.Shape()
+	if !ok {
+		return ErrInvalidFormation
+	}
+
+	if len(l.Starters) != StartingPlayers || len(l.Substitutes) > MaxSubstitutes {
+		return ErrInvalidLineup
+	}
+
+	seen := make(map[uuid.UUID]bool, len(l.Starters)+len(l.Substitutes))
+	for _, id := range append(append([]uuid.UUID{}, l.Starters...), l.Substitutes...) {
+		if seen[id] {
+			return ErrInvalidLineup
+		}
+		seen[id] = true
+
+		player, ok := squad[id]
+		if !ok {
+			return ErrPlayerNotOwned
+		}
+		if !player.IsAvailable() {
+			return ErrPlayerUnavailable
+		}
+	}
+
+	counts := make(map[Position]int)
+	for _, id := range l.Starters {
+		counts[squad[id].Position]++
+	}
+
+	if counts[PositionGoalkeeper] != 1 ||
+		counts[PositionDefender] != shape.Defenders ||
+		counts[PositionMidfielder] != shape.Midfielders ||
+		counts[PositionAttacker] != shape.Attackers {
+		return ErrLineupPositionMismatch
+	}
+
+	return nil
+}
+
+
+func (l *Lineup) Includes(playerID uuid.UUID) bool {
+	for _, id := range l.Starters {
+		if id == playerID {
+			return true
+		}
+	}
+	for _, id := range l.Substitutes {
+		if id == playerID {
+			return true
+		}
+	}
+	return false
+}
+
+
+func (l *Lineup) IsComplete() bool {
+	return len(l.Starters) == StartingPlayers
+}
@@ -0,0 +1,17 @@
+package domain
+
+import "time"
+
+
+type LoginThrottleError struct {
+	Err        error
+	RetryAfter time.Duration
+}
+
+func (e *LoginThrottleError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *LoginThrottleError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,164 @@
+package domain
+
+import (
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+
+type Competition string
+
+const (
+	CompetitionLeague   Competition = "league"
+	CompetitionCup      Competition = "cup"
+	CompetitionFriendly Competition = "friendly"
+)
+
+
+func (c Competition) IsValid() bool {
+	switch c {
+	case CompetitionLeague, CompetitionCup, CompetitionFriendly:
+		return true
+	}
+	return false
+}
+
+
+type MatchStatus string
+
+const (
+	MatchStatusScheduled MatchStatus = "scheduled"
+	MatchStatusPlayed    MatchStatus = "played"
+)
+
+const (
+	DefaultStadiumCapacity = 20000
+	DefaultTicketPrice     = 25.00
+	ExpectedAttendanceRate = 0.80
+	BaseExpectedGoals      = 1.35
+	HomeAdvantage          = 1.10
+	MaxSimulatedGoals      = 9
+)
+
+
+type Match struct {
+	ID           uuid.UUID   `json:"id" db:"id"`
+	HomeTeamID   uuid.UUID   `json:"home_team_id" db:"home_team_id"`
+	AwayTeamID   uuid.UUID   `json:"away_team_id" db:"away_team_id"`
+	Competition  Competition `json:"competition" db:"competition"`
+	Status       MatchStatus `json:"status" db:"status"`
+	ScheduledAt  time.Time   `json:"scheduled_at" db:"scheduled_at"`
+	HomeGoals    int         `json:"home_goals" db:"home_goals"`
+	AwayGoals    int         `json:"away_goals" db:"away_goals"`
+	Attendance   int         `json:"attendance" db:"attendance"`
+	GateReceipts float64     `json:"gate_receipts" db:"gate_receipts"`
+	PlayedAt     *time.Time  `json:"played_at,omitempty" db:"played_at"`
+	CreatedAt    time.Time   `json:"created_at" db:"created_at"`
+}
+
+
+func NewMatch(homeTeamID, awayTeamID uuid.UUID, competition Competition, scheduledAt time.Time) (*Match, error) {
+	if homeTeamID == awayTeamID || !competition.IsValid() {
+		return nil, ErrInvalidMatch
+	}
+
+	return &Match{
+		ID:          uuid.New(),
+		HomeTeamID:  homeTeamID,
+		AwayTeamID:  awayTeamID,
+		Competition: competition,
+		Status:      MatchStatusScheduled,
+		ScheduledAt: scheduledAt,
+		CreatedAt:   time.Now(),
+	}, nil
+}
+
+
+func (m *Match) IsPlayed() bool {
+	return m.Status == MatchStatusPlayed
+}
+
+
+func (m *Match) IsCompetitive() bool {
+	return m.Competition != CompetitionFriendly
+}
+
+
+func (m *Match) GoalsFor(teamID uuid.UUID) (int, int) {
+	if teamID == m.HomeTeamID {
+		return m.HomeGoals, m.AwayGoals
+	}
+	return m.AwayGoals, m.HomeGoals
+}
+
+
+func (m *Match) RecordResult(homeGoals, awayGoals int) error {
+	if m.IsPlayed() {
+		return ErrMatchAlreadyPlayed
+	}
+	if homeGoals < 0 || awayGoals < 0 {
+		return ErrInvalidMatch
+	}
+
+	now := time.Now()
+	m.HomeGoals = homeGoals
+	m.AwayGoals = awayGoals
+	m.Status = MatchStatusPlayed
+	m.PlayedAt = &now
+	return nil
+}
+
+
+func (m *Match) SellTickets(capacity int, ticketPrice float64) {
+	occupancy := (0.6 + rand.Float64()*0.4) * TicketDemand(ticketPrice)
+	m.Attendance = int(float64(capacity) * occupancy)
+	m.GateReceipts = math.Round(float64(m.Attendance) * ticketPrice)
+}
+
+
+func ExpectedGateReceipts(capacity int, ticketPrice float64) float64 {
+	return math.Round(float64(capacity) * ExpectedAttendanceRate * TicketDemand(ticketPrice) * ticketPrice)
+}
+
+
+func TicketDemand(ticketPrice float64) float64 {
+	if ticketPrice <= DefaultTicketPrice {
+		return 1
+	}
+	return math.Sqrt(DefaultTicketPrice / ticketPrice)
+}
+
+
+func TeamStrength(starters []*Player, chemistry int) float64 {
+	total := 0.0
+	for _, player := range starters {
+		total += float64(player.Rating()) * (1 + float64(player.Morale-DefaultMorale)/200)
+	}
+	strength := total / float64(max(len(starters), StartingPlayers))
+	return strength * (1 + float64(chemistry-50)/400)
+}
+
+
+func SimulateScore(homeStrength, awayStrength float64) (int, int) {
+	combined := homeStrength + awayStrength
+	if combined <= 0 {
+		homeStrength, awayStrength, combined = 1, 1, 2
+	}
+	homeGoals := poissonGoals(2 * BaseExpectedGoals * HomeAdvantage * homeStrength / combined)
+	awayGoals := poissonGoals(2 * BaseExpectedGoals * awayStrength / combined)
+	return homeGoals, awayGoals
+}
+
+func poissonGoals(mean float64) int {
+	limit := math.Exp(-mean)
+	product := rand.Float64()
+	goals := 0
+	for product > limit && goals < MaxSimulatedGoals {
+		product *= rand.Float64()
+		goals++
+	}
+	return goals
+}
@@ -0,0 +1,158 @@
+package domain
+
+import (
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+
+type MoraleDriver string
+
+const (
+	MoraleDriverPlayingTime MoraleDriver = "playing_time"
+	MoraleDriverResults     MoraleDriver = "results"
+	MoraleDriverTransfers   MoraleDriver = "transfers"
+	MoraleDriverWages       MoraleDriver = "wages"
+	MoraleDriverRecovery    MoraleDriver = "recovery"
+)
+
+const (
+	DefaultMorale          = 70
+	MinMorale              = 0
+	MaxMorale              = 100
+	HappyMorale            = 75
+	UnhappyMorale          = 35
+	TransferRequestMorale  = 25
+	MoraleDriverWindowDays = 28
+	ChemistryTenureDays    = 365
+)
+
+const (
+	MoraleStarted      = 2
+	MoraleSubstitute   = 1
+	MoraleLeftOut      = -3
+	MoraleWin          = 3
+	MoraleLoss         = -3
+	MoraleListed       = -8
+	MoraleJoined       = 10
+	MoraleRenegotiated = 5
+	MoraleWellPaid     = 2
+	MoraleUnderpaid    = -4
+	WellPaidWageRatio  = 1.25
+	MoraleRecoveryStep = 2
+)
+
+
+type MoraleEvent struct {
+	ID          uuid.UUID    `json:"id" db:"id"`
+	PlayerID    uuid.UUID    `json:"player_id" db:"player_id"`
+	TeamID      *uuid.UUID   `json:"team_id,omitempty" db:"team_id"`
+	Driver      MoraleDriver `json:"driver" db:"driver"`
+	Delta       int          `json:"delta" db:"delta"`
+	MoraleAfter int          `json:"morale_after" db:"morale_after"`
+	Description string       `json:"description" db:"description"`
+	CreatedAt   time.Time    `json:"created_at" db:"created_at"`
+}
+
+
+type MoraleDriverTotal struct {
+	PlayerID uuid.UUID    `db:"player_id"`
+	Driver   MoraleDriver `db:"driver"`
+	Total    int          `db:"total"`
+}
+
+
+type PlayerMorale struct {
+	PlayerID          uuid.UUID            `json:"player_id"`
+	FirstName         string               `json:"first_name"`
+	LastName          string               `json:"last_name"`
+	Morale            int                  `json:"morale"`
+	Status            string               `json:"status"`
+	DaysAtClub        int                  `json:"days_at_club"`
+	TransferRequested bool                 `json:"transfer_requested"`
+	Drivers           map[MoraleDriver]int `json:"drivers"`
+}
+
+
+type TeamChemistry struct {
+	Score       int `json:"score"`
+	Nationality int `json:"nationality"`
+	Tenure      int `json:"tenure"`
+}
+
+
+type TeamMorale struct {
+	TeamID        uuid.UUID       `json:"team_id"`
+	AverageMorale int             `json:"average_morale"`
+	Chemistry     TeamChemistry   `json:"chemistry"`
+	Players       []*PlayerMorale `json:"players"`
+}
+
+
+func NewMoraleEvent(player *Player, driver MoraleDriver, delta int, description string) *MoraleEvent {
+	return &MoraleEvent{
+		ID:          uuid.New(),
+		PlayerID:    player.ID,
+		TeamID:      player.TeamID,
+		Driver:      driver,
+		Delta:       delta,
+		MoraleAfter: player.Morale,
+		Description: description,
+		CreatedAt:   time.Now(),
+	}
+}
+
+
+func MoraleRecovery(morale int) int {
+	switch {
+	case morale < DefaultMorale:
+		return min(DefaultMorale-morale, MoraleRecoveryStep)
+	case morale > DefaultMorale:
+		return -min(morale-DefaultMorale, MoraleRecoveryStep)
+	}
+	return 0
+}
+
+
+func MoraleStatus(morale int) string {
+	switch {
+	case morale >= HappyMorale:
+		return "happy"
+	case morale <= UnhappyMorale:
+		return "unhappy"
+	default:
+		return "content"
+	}
+}
+
+
+func NewTeamChemistry(players []*Player, now time.Time) TeamChemistry {
+	if len(players) == 0 {
+		return TeamChemistry{}
+	}
+
+	countries := make(map[string]int)
+	for _, player := range players {
+		countries[player.Country]++
+	}
+
+	settled := 0
+	tenureDays := 0.0
+	for _, player := range players {
+		if countries[player.Country] > 1 {
+			settled++
+		}
+		tenureDays += math.Min(player.DaysAtClub(now), ChemistryTenureDays)
+	}
+
+
+	nationality := int(math.Round(50 * float64(settled) / float64(len(players))))
+	tenure := int(math.Round(50 * tenureDays / float64(len(players)) / ChemistryTenureDays))
+	return TeamChemistry{
+		Score:       nationality + tenure,
+		Nationality: nationality,
+		Tenure:      tenure,
+	}
+}
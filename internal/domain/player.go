@@ -26,34 +26,283 @@ type Player struct {
 	Country     string     `json:"country" db:"country"`
 	Age         int        `json:"age" db:"age"`
 	Position    Position   `json:"position" db:"position"`
+	Potential   int        `json:"potential" db:"potential"`
+	Finishing   int        `json:"finishing" db:"finishing"`
+	Passing     int        `json:"passing" db:"passing"`
+	Defending   int        `json:"defending" db:"defending"`
+	Goalkeeping int        `json:"goalkeeping" db:"goalkeeping"`
+	Fitness     int        `json:"fitness" db:"fitness"`
 	MarketValue float64    `json:"market_value" db:"market_value"`
-	CreatedAt   time.Time  `json:"created_at" db:"created_at"`
-	UpdatedAt   time.Time  `json:"updated_at" db:"updated_at"`
+	FormFactor  float64    `json:"form_factor" db:"form_factor"`
+	Morale      int        `json:"morale" db:"morale"`
+	JoinedAt    time.Time  `json:"joined_at" db:"joined_at"`
+
+	Availability       AvailabilityStatus `json:"availability" db:"availability"`
+	UnavailableUntil   *time.Time         `json:"unavailable_until,omitempty" db:"unavailable_until"`
+	UnavailableMatches int                `json:"unavailable_matches,omitempty" db:"unavailable_matches"`
+
+	RetiredAt *time.Time `json:"retired_at,omitempty" db:"retired_at"`
+	CreatedAt time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at" db:"updated_at"`
 }
 
 const (
 	InitialPlayerValue = 1000000.00
 	MinAge             = 18
 	MaxAge             = 40
+	RetirementAge      = 34
+	MinPotential       = 1
+	MaxPotential       = 100
+	MinAttribute       = 1
+	MaxAttribute       = 100
+)
+
+
+type AvailabilityStatus string
+
+const (
+	AvailabilityAvailable AvailabilityStatus = "available"
+	AvailabilityInjured   AvailabilityStatus = "injured"
+	AvailabilitySuspended AvailabilityStatus = "suspended"
+)
+
+
+type Attribute string
+
+const (
+	AttributeFinishing   Attribute = "finishing"
+	AttributePassing     Attribute = "passing"
+	AttributeDefending   Attribute = "defending"
+	AttributeGoalkeeping Attribute = "goalkeeping"
+	AttributeFitness     Attribute = "fitness"
 )
 
 
+func (a Attribute) IsValid() bool {
+	switch a {
+	case AttributeFinishing, AttributePassing, AttributeDefending, AttributeGoalkeeping, AttributeFitness:
+		return true
+	}
+	return false
+}
+
+
+func (p Position) IsValid() bool {
+	switch p {
+	case PositionGoalkeeper, PositionDefender, PositionMidfielder, PositionAttacker:
+		return true
+	}
+	return false
+}
+
+
 func NewPlayer(teamID *uuid.UUID, firstName, lastName, country string, position Position) *Player {
 	rand.Seed(time.Now().UnixNano())
 	age := MinAge + rand.Intn(MaxAge-MinAge+1)
 
-	return &Player{
-		ID:          uuid.New(),
-		TeamID:      teamID,
-		FirstName:   firstName,
-		LastName:    lastName,
-		Country:     country,
-		Age:         age,
-		Position:    position,
-		MarketValue: InitialPlayerValue,
-		CreatedAt:   time.Now(),
-		UpdatedAt:   time.Now(),
+	player := &Player{
+		ID:           uuid.New(),
+		TeamID:       teamID,
+		FirstName:    firstName,
+		LastName:     lastName,
+		Country:      country,
+		Age:          age,
+		Position:     position,
+		MarketValue:  InitialPlayerValue,
+		FormFactor:   1,
+		Morale:       DefaultMorale,
+		Availability: AvailabilityAvailable,
+		JoinedAt:     time.Now(),
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+	player.rollAttributes(40, 65)
+	player.Potential = player.Rating() + rand.Intn(21)
+	if player.Potential > MaxPotential {
+		player.Potential = MaxPotential
+	}
+	return player
+}
+
+
+func (p *Player) rollAttributes(min, max int) {
+	roll := func() int {
+		return min + rand.Intn(max-min+1)
+	}
+	p.Finishing = roll()
+	p.Passing = roll()
+	p.Defending = roll()
+	p.Goalkeeping = roll()
+	p.Fitness = roll()
+
+	focus := map[Position]*int{
+		PositionGoalkeeper: &p.Goalkeeping,
+		PositionDefender:   &p.Defending,
+		PositionMidfielder: &p.Passing,
+		PositionAttacker:   &p.Finishing,
+	}
+	if attr, ok := focus[p.Position]; ok {
+		*attr = clampAttribute(*attr + 15)
+	}
+}
+
+
+func (p *Player) AttributeValue(attr Attribute) int {
+	switch attr {
+	case AttributeFinishing:
+		return p.Finishing
+	case AttributePassing:
+		return p.Passing
+	case AttributeDefending:
+		return p.Defending
+	case AttributeGoalkeeping:
+		return p.Goalkeeping
+	case AttributeFitness:
+		return p.Fitness
+	}
+	return 0
+}
+
+
+func (p *Player) setAttribute(attr Attribute, value int) {
+	value = clampAttribute(value)
+	switch attr {
+	case AttributeFinishing:
+		p.Finishing = value
+	case AttributePassing:
+		p.Passing = value
+	case AttributeDefending:
+		p.Defending = value
+	case AttributeGoalkeeping:
+		p.Goalkeeping = value
+	case AttributeFitness:
+		p.Fitness = value
+	}
+}
+
+
+func (p *Player) Rating() int {
+	var weighted float64
+	switch p.Position {
+	case PositionGoalkeeper:
+		weighted = 0.70*float64(p.Goalkeeping) + 0.15*float64(p.Passing) + 0.15*float64(p.Fitness)
+	case PositionDefender:
+		weighted = 0.55*float64(p.Defending) + 0.20*float64(p.Passing) + 0.25*float64(p.Fitness)
+	case PositionMidfielder:
+		weighted = 0.45*float64(p.Passing) + 0.20*float64(p.Finishing) + 0.15*float64(p.Defending) + 0.20*float64(p.Fitness)
+	case PositionAttacker:
+		weighted = 0.55*float64(p.Finishing) + 0.20*float64(p.Passing) + 0.25*float64(p.Fitness)
+	}
+	return int(weighted + 0.5)
+}
+
+
+func (p *Player) Train(attr Attribute, points int) (int, int) {
+	previousValue := p.AttributeValue(attr)
+	previousRating := p.Rating()
+
+	p.setAttribute(attr, previousValue+points)
+	p.AdjustMarketValueForRating(previousRating)
+
+	return previousValue, p.AttributeValue(attr)
+}
+
+
+func (p *Player) AdjustMarketValueForRating(previousRating int) {
+	delta := p.Rating() - previousRating
+	if delta == 0 {
+		return
+	}
+	p.MarketValue = p.MarketValue * (1 + 0.02*float64(delta))
+	p.UpdatedAt = time.Now()
+}
+
+
+func (p *Player) AdjustMarketValueForForm(averageRating float64) {
+	factor := FormValueFactor(averageRating)
+	p.MarketValue = p.BaseValue() * factor
+	p.FormFactor = factor
+	p.UpdatedAt = time.Now()
+}
+
+
+func (p *Player) BaseValue() float64 {
+	if p.FormFactor <= 0 {
+		return p.MarketValue
+	}
+	return p.MarketValue / p.FormFactor
+}
+
+
+func FormValueFactor(averageRating float64) float64 {
+	if averageRating <= 0 {
+		return 1
+	}
+	return 1 + FormValueStep*(averageRating-NeutralFormRating)
+}
+
+
+func (p *Player) IsAvailable() bool {
+	return p.Availability == "" || p.Availability == AvailabilityAvailable
+}
+
+
+func (p *Player) Injure(days int) {
+	until := time.Now().AddDate(0, 0, days)
+	if p.Availability == AvailabilityInjured && p.UnavailableUntil != nil && p.UnavailableUntil.After(until) {
+		return
+	}
+	p.Availability = AvailabilityInjured
+	p.UnavailableUntil = &until
+	p.UpdatedAt = time.Now()
+}
+
+
+func (p *Player) Suspend(matches int) {
+	if p.Availability != AvailabilityInjured {
+		p.Availability = AvailabilitySuspended
+	}
+	p.UnavailableMatches += matches
+	p.UpdatedAt = time.Now()
+}
+
+
+func (p *Player) ServeMatch() bool {
+	if p.Availability != AvailabilitySuspended || p.UnavailableMatches == 0 {
+		return false
 	}
+	p.UnavailableMatches--
+	if p.UnavailableMatches == 0 {
+		p.Recover()
+	}
+	p.UpdatedAt = time.Now()
+	return true
+}
+
+
+func (p *Player) IsRecoveredAt(now time.Time) bool {
+	return p.Availability == AvailabilityInjured && p.UnavailableUntil != nil && !p.UnavailableUntil.After(now)
+}
+
+
+func (p *Player) Recover() {
+	p.UnavailableUntil = nil
+	if p.UnavailableMatches > 0 {
+		p.Availability = AvailabilitySuspended
+	} else {
+		p.Availability = AvailabilityAvailable
+	}
+	p.UpdatedAt = time.Now()
+}
+
+func clampAttribute(value int) int {
+	if value < MinAttribute {
+		return MinAttribute
+	}
+	if value > MaxAttribute {
+		return MaxAttribute
+	}
+	return value
 }
 
 
@@ -67,11 +316,112 @@ func (p *Player) UpdateMarketValue() {
 
 func (p *Player) Transfer(newTeamID uuid.UUID) {
 	p.TeamID = &newTeamID
+	p.JoinedAt = time.Now()
 	p.UpdateMarketValue()
 	p.UpdatedAt = time.Now()
 }
 
 
+func (p *Player) ReturnTo(teamID uuid.UUID) {
+	p.TeamID = &teamID
+	p.JoinedAt = time.Now()
+	p.UpdatedAt = time.Now()
+}
+
+
+func (p *Player) Draft(teamID uuid.UUID) {
+	p.TeamID = &teamID
+	p.JoinedAt = time.Now()
+	p.UpdatedAt = time.Now()
+}
+
+
+func (p *Player) AdjustMorale(delta int) {
+	p.Morale += delta
+	if p.Morale < MinMorale {
+		p.Morale = MinMorale
+	}
+	if p.Morale > MaxMorale {
+		p.Morale = MaxMorale
+	}
+	p.UpdatedAt = time.Now()
+}
+
+
+func (p *Player) WantsTransfer() bool {
+	return p.TeamID != nil && p.Morale <= TransferRequestMorale
+}
+
+
+func (p *Player) DaysAtClub(now time.Time) float64 {
+	if p.JoinedAt.IsZero() || now.Before(p.JoinedAt) {
+		return 0
+	}
+	return now.Sub(p.JoinedAt).Hours() / 24
+}
+
+
 func (p *Player) IsOwnedBy(teamID uuid.UUID) bool {
 	return p.TeamID != nil && *p.TeamID == teamID
 }
+
+
+func (p *Player) Release() {
+	p.TeamID = nil
+	p.UpdatedAt = time.Now()
+}
+
+
+func (p *Player) IsFreeAgent() bool {
+	return p.TeamID == nil && p.RetiredAt == nil
+}
+
+
+func (p *Player) IsRetired() bool {
+	return p.RetiredAt != nil
+}
+
+
+func (p *Player) ShouldRetire() bool {
+	if p.Age >= MaxAge {
+		return true
+	}
+	if p.Age < RetirementAge {
+		return false
+	}
+	probability := float64(p.Age-RetirementAge+1) * 0.15
+	return rand.Float64() < probability
+}
+
+
+func (p *Player) Retire() {
+	now := time.Now()
+	p.TeamID = nil
+	p.RetiredAt = &now
+	p.UpdatedAt = now
+}
+
+
+func (p *Player) AgeOneYear() {
+	p.Age++
+	p.MarketValue = p.MarketValue * AgeValueMultiplier(p.Age)
+	p.UpdatedAt = time.Now()
+}
+
+
+func AgeValueMultiplier(age int) float64 {
+	switch {
+	case age <= 21:
+		return 1.10
+	case age <= 25:
+		return 1.05
+	case age <= 29:
+		return 1.00
+	case age <= 31:
+		return 0.90
+	case age <= 33:
+		return 0.80
+	default:
+		return 0.65
+	}
+}
@@ -0,0 +1,77 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormValueFactor(t *testing.T) {
+	tests := []struct {
+		name          string
+		averageRating float64
+		want          float64
+	}{
+		{"no ratings", 0, 1},
+		{"negative is ignored", -3, 1},
+		{"neutral form", NeutralFormRating, 1},
+		{"good form", 8.5, 1.02},
+		{"poor form", 4.5, 0.98},
+		{"perfect form", 10, 1.035},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.want, FormValueFactor(tt.averageRating), 1e-9)
+		})
+	}
+}
+
+func TestAdjustMarketValueForForm(t *testing.T) {
+	tests := []struct {
+		name        string
+		marketValue float64
+		formFactor  float64
+		ratings     []float64
+		wantValue   float64
+		wantFactor  float64
+	}{
+		{"good form raises the value", 1000000, 1, []float64{8.5}, 1020000, 1.02},
+		{"poor form lowers the value", 1000000, 1, []float64{4.5}, 980000, 0.98},
+		{"adjustments do not compound", 1000000, 1, []float64{8.5, 8.5, 8.5}, 1020000, 1.02},
+		{"form swings apply to the base value", 1000000, 1, []float64{8.5, 4.5}, 980000, 0.98},
+		{"returning to neutral restores the base value", 1000000, 1, []float64{8.5, NeutralFormRating}, 1000000, 1},
+		{"existing factor is removed first", 1020000, 1.02, []float64{4.5}, 980000, 0.98},
+		{"missing factor treats the value as base", 1000000, 0, []float64{8.5}, 1020000, 1.02},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			player := &Player{MarketValue: tt.marketValue, FormFactor: tt.formFactor}
+			for _, rating := range tt.ratings {
+				player.AdjustMarketValueForForm(rating)
+			}
+
+			assert.InDelta(t, tt.wantValue, player.MarketValue, 1e-6)
+			assert.InDelta(t, tt.wantFactor, player.FormFactor, 1e-9)
+		})
+	}
+}
+
+func TestBaseValue(t *testing.T) {
+	tests := []struct {
+		marketValue float64
+		formFactor  float64
+		want        float64
+	}{
+		{1000000, 1, 1000000},
+		{1020000, 1.02, 1000000},
+		{980000, 0.98, 1000000},
+		{500000, 0, 500000},
+	}
+
+	for _, tt := range tests {
+		player := &Player{MarketValue: tt.marketValue, FormFactor: tt.formFactor}
+		assert.InDelta(t, tt.want, player.BaseValue(), 1e-6)
+	}
+}
@@ -0,0 +1,115 @@
+package domain
+
+import (
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+
+const (
+	DefaultRating        = 1500
+	LeagueKFactor        = 32
+	CupKFactor           = 40
+	DefaultRankingsLimit = 50
+	MaxRankingsLimit     = 200
+	FriendlyOpponentPool = 3
+	DefaultRatingHistory = 50
+)
+
+
+type RatingChange struct {
+	ID           uuid.UUID `json:"id" db:"id"`
+	TeamID       uuid.UUID `json:"team_id" db:"team_id"`
+	MatchID      uuid.UUID `json:"match_id" db:"match_id"`
+	OpponentID   uuid.UUID `json:"opponent_id" db:"opponent_id"`
+	RatingBefore int       `json:"rating_before" db:"rating_before"`
+	RatingAfter  int       `json:"rating_after" db:"rating_after"`
+	CreatedAt    time.Time `json:"created_at" db:"created_at"`
+}
+
+
+type TeamRanking struct {
+	Rank    int       `json:"rank"`
+	TeamID  uuid.UUID `json:"team_id"`
+	Name    string    `json:"name"`
+	Country string    `json:"country"`
+	Rating  int       `json:"rating"`
+	IsBot   bool      `json:"is_bot"`
+}
+
+
+func KFactor(competition Competition) int {
+	if competition == CompetitionCup {
+		return CupKFactor
+	}
+	return LeagueKFactor
+}
+
+
+func ExpectedScore(rating, opponentRating int) float64 {
+	return 1 / (1 + math.Pow(10, float64(opponentRating-rating)/400))
+}
+
+
+func NewRatingChanges(match *Match, home, away *Team) (*RatingChange, *RatingChange) {
+	homeScore := 0.5
+	switch {
+	case match.HomeGoals > match.AwayGoals:
+		homeScore = 1
+	case match.HomeGoals < match.AwayGoals:
+		homeScore = 0
+	}
+
+	k := float64(KFactor(match.Competition))
+	delta := int(math.Round(k * (homeScore - ExpectedScore(home.Rating, away.Rating))))
+	now := time.Now()
+
+	homeChange := &RatingChange{
+		ID:           uuid.New(),
+		TeamID:       home.ID,
+		MatchID:      match.ID,
+		OpponentID:   away.ID,
+		RatingBefore: home.Rating,
+		RatingAfter:  home.Rating + delta,
+		CreatedAt:    now,
+	}
+	awayChange := &RatingChange{
+		ID:           uuid.New(),
+		TeamID:       away.ID,
+		MatchID:      match.ID,
+		OpponentID:   home.ID,
+		RatingBefore: away.Rating,
+		RatingAfter:  away.Rating - delta,
+		CreatedAt:    now,
+	}
+	return homeChange, awayChange
+}
+
+
+func (c *RatingChange) Delta() int {
+	return c.RatingAfter - c.RatingBefore
+}
+
+
+func (c *RatingChange) Settle(rating int) {
+	c.RatingBefore = rating - c.Delta()
+	c.RatingAfter = rating
+}
+
+
+func NewTeamRankings(teams []*Team) []*TeamRanking {
+	rankings := make([]*TeamRanking, 0, len(teams))
+	for i, team := range teams {
+		rankings = append(rankings, &TeamRanking{
+			Rank:    i + 1,
+			TeamID:  team.ID,
+			Name:    team.Name,
+			Country: team.Country,
+			Rating:  team.Rating,
+			IsBot:   team.IsBot,
+		})
+	}
+	return rankings
+}
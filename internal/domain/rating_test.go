@@ -0,0 +1,95 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpectedScore(t *testing.T) {
+	tests := []struct {
+		rating, opponent int
+		want             float64
+	}{
+		{1500, 1500, 0.5},
+		{1900, 1500, 0.909},
+		{1500, 1900, 0.091},
+		{1600, 1500, 0.640},
+		{1500, 1600, 0.360},
+	}
+
+	for _, tt := range tests {
+		assert.InDelta(t, tt.want, ExpectedScore(tt.rating, tt.opponent), 0.001, "%d vs %d", tt.rating, tt.opponent)
+	}
+}
+
+func TestNewRatingChanges(t *testing.T) {
+	tests := []struct {
+		name                 string
+		homeRating           int
+		awayRating           int
+		homeGoals, awayGoals int
+		competition          Competition
+		wantDelta            int
+	}{
+		{"equal teams home win", 1500, 1500, 2, 0, CompetitionLeague, 16},
+		{"equal teams away win", 1500, 1500, 0, 1, CompetitionLeague, -16},
+		{"equal teams draw", 1500, 1500, 1, 1, CompetitionLeague, 0},
+		{"cup uses a larger k", 1500, 1500, 3, 1, CompetitionCup, 20},
+		{"favourite wins", 1900, 1500, 1, 0, CompetitionLeague, 3},
+		{"underdog wins", 1500, 1900, 1, 0, CompetitionLeague, 29},
+		{"favourite draws", 1900, 1500, 2, 2, CompetitionLeague, -13},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			home := &Team{ID: uuid.New(), Rating: tt.homeRating}
+			away := &Team{ID: uuid.New(), Rating: tt.awayRating}
+			match := &Match{ID: uuid.New(), HomeGoals: tt.homeGoals, AwayGoals: tt.awayGoals, Competition: tt.competition}
+
+			homeChange, awayChange := NewRatingChanges(match, home, away)
+
+			assert.Equal(t, tt.wantDelta, homeChange.Delta())
+			assert.Equal(t, -tt.wantDelta, awayChange.Delta())
+			assert.Equal(t, tt.homeRating, homeChange.RatingBefore)
+			assert.Equal(t, tt.awayRating, awayChange.RatingBefore)
+			assert.Equal(t, away.ID, homeChange.OpponentID)
+			assert.Equal(t, home.ID, awayChange.OpponentID)
+			assert.Equal(t, match.ID, homeChange.MatchID)
+		})
+	}
+}
+
+func TestRatingChangeSettle(t *testing.T) {
+	tests := []struct {
+		name       string
+		before     int
+		after      int
+		settled    int
+		wantBefore int
+	}{
+		{"rating unchanged since the read", 1500, 1516, 1516, 1500},
+		{"concurrent gain", 1500, 1516, 1530, 1514},
+		{"concurrent loss", 1500, 1484, 1470, 1486},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			change := &RatingChange{RatingBefore: tt.before, RatingAfter: tt.after}
+			delta := change.Delta()
+
+			change.Settle(tt.settled)
+
+			assert.Equal(t, tt.wantBefore, change.RatingBefore)
+			assert.Equal(t, tt.settled, change.RatingAfter)
+			assert.Equal(t, delta, change.Delta())
+		})
+	}
+}
+
+func TestKFactor(t *testing.T) {
+	assert.Equal(t, LeagueKFactor, KFactor(CompetitionLeague))
+	assert.Equal(t, CupKFactor, KFactor(CompetitionCup))
+	assert.Equal(t, LeagueKFactor, KFactor(""))
+}
@@ -0,0 +1,68 @@
+package domain
+
+import "github.com/google/uuid"
+
+
+type Role string
+
+const (
+	RoleManager   Role = "manager"
+	RoleModerator Role = "moderator"
+	RoleAdmin     Role = "admin"
+)
+
+
+var ServiceAccountID = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
+
+const (
+	DefaultUserSearchLimit = 50
+	MaxUserSearchLimit     = 200
+)
+
+
+var roleRanks = map[Role]int{
+	RoleManager:   1,
+	RoleModerator: 2,
+	RoleAdmin:     3,
+}
+
+
+func ParseRole(value string) Role {
+	if value == "" {
+		return RoleManager
+	}
+	return Role(value)
+}
+
+
+func (r Role) IsValid() bool {
+	_, ok := roleRanks[r]
+	return ok
+}
+
+
+func (r Role) Includes(required Role) bool {
+	rank, ok := roleRanks[r]
+	return ok && rank >= roleRanks[required]
+}
+
+
+func (r Role) Outranks(other Role) bool {
+	return roleRanks[r] > roleRanks[other]
+}
+
+
+type UserFilter struct {
+	Query  string
+	Role   Role
+	Status string
+	Limit  int
+	Offset int
+}
+
+
+type UserDetails struct {
+	User  *User   `json:"user"`
+	Teams []*Team `json:"teams"`
+}
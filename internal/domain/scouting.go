@@ -0,0 +1,235 @@
+package domain
+
+import (
+	"hash/fnv"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+
+const (
+	MaxScoutsPerTeam     = 3
+	MinScoutSkill        = 1
+	MaxScoutSkill        = 5
+	ScoutFeePerSkill     = 50000.00
+	FullScoutingAccuracy = 100
+	UnscoutedRangeWidth  = 30
+)
+
+
+type Scout struct {
+	ID         uuid.UUID  `json:"id" db:"id"`
+	TeamID     uuid.UUID  `json:"team_id" db:"team_id"`
+	Name       string     `json:"name" db:"name"`
+	Skill      int        `json:"skill" db:"skill"`
+	PlayerID   *uuid.UUID `json:"player_id,omitempty" db:"player_id"`
+	Region     *string    `json:"region,omitempty" db:"region"`
+	AssignedAt *time.Time `json:"assigned_at,omitempty" db:"assigned_at"`
+	HiredAt    time.Time  `json:"hired_at" db:"hired_at"`
+}
+
+
+type ScoutReport struct {
+	TeamID    uuid.UUID `json:"team_id" db:"team_id"`
+	PlayerID  uuid.UUID `json:"player_id" db:"player_id"`
+	Accuracy  int       `json:"accuracy" db:"accuracy"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+}
+
+
+type AttributeRange struct {
+	Min int `json:"min"`
+	Max int `json:"max"`
+}
+
+
+type ValueRange struct {
+	Min float64 `json:"min"`
+	Max float64 `json:"max"`
+}
+
+
+type ScoutedPlayer struct {
+	ID           uuid.UUID          `json:"id"`
+	TeamID       *uuid.UUID         `json:"team_id,omitempty"`
+	FirstName    string             `json:"first_name"`
+	LastName     string             `json:"last_name"`
+	Country      string             `json:"country"`
+	Age          int                `json:"age"`
+	Position     Position           `json:"position"`
+	Availability AvailabilityStatus `json:"availability"`
+	Accuracy     int                `json:"scouting_accuracy"`
+	Potential    AttributeRange     `json:"potential"`
+	Finishing    AttributeRange     `json:"finishing"`
+	Passing      AttributeRange     `json:"passing"`
+	Defending    AttributeRange     `json:"defending"`
+	Goalkeeping  AttributeRange     `json:"goalkeeping"`
+	Fitness      AttributeRange     `json:"fitness"`
+	MarketValue  ValueRange         `json:"market_value"`
+	Stats        []*PlayerStats     `json:"stats,omitempty"`
+}
+
+
+type ScoutedListing struct {
+	TransferListing
+	Player *ScoutedPlayer `json:"player"`
+}
+
+
+func NewScout(teamID uuid.UUID, name string, skill int) (*Scout, error) {
+	if skill < MinScoutSkill || skill > MaxScoutSkill {
+		return nil, ErrInvalidScoutSkill
+	}
+	return &Scout{
+		ID:      uuid.New(),
+		TeamID:  teamID,
+		Name:    name,
+		Skill:   skill,
+		HiredAt: time.Now(),
+	}, nil
+}
+
+
+func ScoutHiringFee(skill int) float64 {
+	return float64(skill) * ScoutFeePerSkill
+}
+
+
+func (s *Scout) AssignPlayer(playerID uuid.UUID) {
+	now := time.Now()
+	s.PlayerID = &playerID
+	s.Region = nil
+	s.AssignedAt = &now
+}
+
+
+func (s *Scout) AssignRegion(region string) error {
+	region = strings.TrimSpace(region)
+	if region == "" {
+		return ErrInvalidScoutAssignment
+	}
+
+	now := time.Now()
+	s.PlayerID = nil
+	s.Region = &region
+	s.AssignedAt = &now
+	return nil
+}
+
+
+func (s *Scout) Unassign() {
+	s.PlayerID = nil
+	s.Region = nil
+	s.AssignedAt = nil
+}
+
+
+func (s *Scout) PlayerProgress() int {
+	return 8 + s.Skill*4
+}
+
+
+func (s *Scout) RegionProgress() int {
+	return 2 + s.Skill*2
+}
+
+
+func (s *Scout) RegionCoverage() int {
+	return 3 + s.Skill
+}
+
+
+func NewScoutReport(teamID, playerID uuid.UUID) *ScoutReport {
+	return &ScoutReport{
+		TeamID:    teamID,
+		PlayerID:  playerID,
+		UpdatedAt: time.Now(),
+	}
+}
+
+
+func (r *ScoutReport) Improve(amount int) {
+	r.Accuracy += amount
+	if r.Accuracy > FullScoutingAccuracy {
+		r.Accuracy = FullScoutingAccuracy
+	}
+	r.UpdatedAt = time.Now()
+}
+
+
+func (r *ScoutReport) IsComplete() bool {
+	return r.Accuracy >= FullScoutingAccuracy
+}
+
+
+func NewScoutedPlayer(player *Player, viewerTeamID uuid.UUID, accuracy int) *ScoutedPlayer {
+	if accuracy < 0 {
+		accuracy = 0
+	}
+	if accuracy > FullScoutingAccuracy {
+		accuracy = FullScoutingAccuracy
+	}
+	width := UnscoutedRangeWidth * (FullScoutingAccuracy - accuracy) / FullScoutingAccuracy
+	seed := scoutingSeed(viewerTeamID, player.ID)
+
+	return &ScoutedPlayer{
+		ID:           player.ID,
+		TeamID:       player.TeamID,
+		FirstName:    player.FirstName,
+		LastName:     player.LastName,
+		Country:      player.Country,
+		Age:          player.Age,
+		Position:     player.Position,
+		Availability: player.Availability,
+		Accuracy:     accuracy,
+		Potential:    fuzzAttribute(player.Potential, width, seed, "potential"),
+		Finishing:    fuzzAttribute(player.Finishing, width, seed, string(AttributeFinishing)),
+		Passing:      fuzzAttribute(player.Passing, width, seed, string(AttributePassing)),
+		Defending:    fuzzAttribute(player.Defending, width, seed, string(AttributeDefending)),
+		Goalkeeping:  fuzzAttribute(player.Goalkeeping, width, seed, string(AttributeGoalkeeping)),
+		Fitness:      fuzzAttribute(player.Fitness, width, seed, string(AttributeFitness)),
+		MarketValue:  fuzzValue(player.MarketValue, width, seed),
+	}
+}
+
+func scoutingSeed(viewerTeamID, playerID uuid.UUID) []byte {
+	seed := make([]byte, 0, 32)
+	seed = append(seed, viewerTeamID[:]...)
+	return append(seed, playerID[:]...)
+}
+
+func scoutingShare(seed []byte, salt string) float64 {
+	hash := fnv.New32a()
+	hash.Write(seed)
+	hash.Write([]byte(salt))
+	return float64(hash.Sum32()) / float64(math.MaxUint32)
+}
+
+func fuzzAttribute(value, width int, seed []byte, salt string) AttributeRange {
+	low := value - int(math.Round(scoutingShare(seed, salt)*float64(width)))
+	high := low + width
+	if low < MinAttribute {
+		low = MinAttribute
+	}
+	if high > MaxAttribute {
+		high = MaxAttribute
+	}
+	return AttributeRange{Min: low, Max: high}
+}
+
+func fuzzValue(value float64, width int, seed []byte) ValueRange {
+	spread := value * float64(width) / 100
+	if spread == 0 {
+		return ValueRange{Min: value, Max: value}
+	}
+
+	share := scoutingShare(seed, "market_value")
+	low := math.Max(0, value-spread*share)
+	return ValueRange{
+		Min: math.Floor(low/1000) * 1000,
+		Max: math.Ceil((low+spread)/1000) * 1000,
+	}
+}
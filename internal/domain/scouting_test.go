@@ -0,0 +1,110 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func scoutingTestPlayer(potential, finishing int, marketValue float64) *Player {
+	return &Player{
+		ID:          uuid.New(),
+		Potential:   potential,
+		Finishing:   finishing,
+		Passing:     50,
+		Defending:   50,
+		Goalkeeping: 50,
+		Fitness:     50,
+		MarketValue: marketValue,
+	}
+}
+
+func TestNewScoutedPlayerRangesContainValue(t *testing.T) {
+	tests := []struct {
+		name   string
+		player *Player
+	}{
+		{"typical player", scoutingTestPlayer(70, 65, 1234567)},
+		{"attributes at the bounds", scoutingTestPlayer(MaxAttribute, MinAttribute, 1000000)},
+		{"low value", scoutingTestPlayer(40, 45, 1499)},
+		{"no value", scoutingTestPlayer(55, 55, 0)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for viewers := 0; viewers < 20; viewers++ {
+				viewer := uuid.New()
+				for accuracy := 0; accuracy <= FullScoutingAccuracy; accuracy++ {
+					scouted := NewScoutedPlayer(tt.player, viewer, accuracy)
+
+					assert.LessOrEqual(t, scouted.Potential.Min, tt.player.Potential)
+					assert.GreaterOrEqual(t, scouted.Potential.Max, tt.player.Potential)
+					assert.LessOrEqual(t, scouted.Finishing.Min, tt.player.Finishing)
+					assert.GreaterOrEqual(t, scouted.Finishing.Max, tt.player.Finishing)
+					assert.GreaterOrEqual(t, scouted.Finishing.Min, MinAttribute)
+					assert.LessOrEqual(t, scouted.Potential.Max, MaxAttribute)
+					assert.LessOrEqual(t, scouted.MarketValue.Min, tt.player.MarketValue)
+					assert.GreaterOrEqual(t, scouted.MarketValue.Max, tt.player.MarketValue)
+				}
+			}
+		})
+	}
+}
+
+func TestNewScoutedPlayerRangesNarrowMonotonically(t *testing.T) {
+	player := scoutingTestPlayer(72, 61, 2750000)
+
+	for viewers := 0; viewers < 50; viewers++ {
+		viewer := uuid.New()
+		previous := NewScoutedPlayer(player, viewer, 0)
+		for accuracy := 1; accuracy <= FullScoutingAccuracy; accuracy++ {
+			current := NewScoutedPlayer(player, viewer, accuracy)
+
+			assert.GreaterOrEqual(t, current.Potential.Min, previous.Potential.Min, "accuracy %d", accuracy)
+			assert.LessOrEqual(t, current.Potential.Max, previous.Potential.Max, "accuracy %d", accuracy)
+			assert.GreaterOrEqual(t, current.Finishing.Min, previous.Finishing.Min, "accuracy %d", accuracy)
+			assert.LessOrEqual(t, current.Finishing.Max, previous.Finishing.Max, "accuracy %d", accuracy)
+			assert.GreaterOrEqual(t, current.MarketValue.Min, previous.MarketValue.Min, "accuracy %d", accuracy)
+			assert.LessOrEqual(t, current.MarketValue.Max, previous.MarketValue.Max, "accuracy %d", accuracy)
+			previous = current
+		}
+	}
+}
+
+func TestNewScoutedPlayerAccuracy(t *testing.T) {
+	player := scoutingTestPlayer(72, 61, 2750000)
+	viewer := uuid.New()
+
+	tests := []struct {
+		name     string
+		accuracy int
+		want     int
+		exact    bool
+	}{
+		{"negative is clamped", -10, 0, false},
+		{"unscouted", 0, 0, false},
+		{"partial", 50, 50, false},
+		{"complete is exact", FullScoutingAccuracy, FullScoutingAccuracy, true},
+		{"above complete is clamped", FullScoutingAccuracy + 20, FullScoutingAccuracy, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scouted := NewScoutedPlayer(player, viewer, tt.accuracy)
+			assert.Equal(t, tt.want, scouted.Accuracy)
+			if tt.exact {
+				assert.Equal(t, AttributeRange{Min: 72, Max: 72}, scouted.Potential)
+			} else {
+				assert.Less(t, scouted.Potential.Min, scouted.Potential.Max)
+			}
+		})
+	}
+}
+
+func TestNewScoutedPlayerIsStablePerViewer(t *testing.T) {
+	player := scoutingTestPlayer(72, 61, 2750000)
+	viewer := uuid.New()
+
+	assert.Equal(t, NewScoutedPlayer(player, viewer, 40), NewScoutedPlayer(player, viewer, 40))
+}
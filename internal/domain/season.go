@@ -0,0 +1,74 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+
+type SeasonStatus string
+
+const (
+	SeasonStatusActive    SeasonStatus = "active"
+	SeasonStatusCompleted SeasonStatus = "completed"
+)
+
+
+type Season struct {
+	ID        uuid.UUID    `json:"id" db:"id"`
+	Number    int          `json:"number" db:"number"`
+	Status    SeasonStatus `json:"status" db:"status"`
+	StartedAt time.Time    `json:"started_at" db:"started_at"`
+	EndedAt   *time.Time   `json:"ended_at,omitempty" db:"ended_at"`
+}
+
+
+func NewSeason(number int) *Season {
+	return &Season{
+		ID:        uuid.New(),
+		Number:    number,
+		Status:    SeasonStatusActive,
+		StartedAt: time.Now(),
+	}
+}
+
+
+func (s *Season) Complete() {
+	now := time.Now()
+	s.Status = SeasonStatusCompleted
+	s.EndedAt = &now
+}
+
+
+type SeasonPlayerSnapshot struct {
+	SeasonID    uuid.UUID  `json:"season_id" db:"season_id"`
+	PlayerID    uuid.UUID  `json:"player_id" db:"player_id"`
+	TeamID      *uuid.UUID `json:"team_id,omitempty" db:"team_id"`
+	Age         int        `json:"age" db:"age"`
+	Position    Position   `json:"position" db:"position"`
+	MarketValue float64    `json:"market_value" db:"market_value"`
+	ArchivedAt  time.Time  `json:"archived_at" db:"archived_at"`
+}
+
+
+func NewSeasonPlayerSnapshot(seasonID uuid.UUID, player *Player) *SeasonPlayerSnapshot {
+	return &SeasonPlayerSnapshot{
+		SeasonID:    seasonID,
+		PlayerID:    player.ID,
+		TeamID:      player.TeamID,
+		Age:         player.Age,
+		Position:    player.Position,
+		MarketValue: player.MarketValue,
+		ArchivedAt:  time.Now(),
+	}
+}
+
+
+type SeasonRollover struct {
+	CompletedSeason *Season `json:"completed_season"`
+	NewSeason       *Season `json:"new_season"`
+	PlayersAged     int     `json:"players_aged"`
+	PlayersRetired  int     `json:"players_retired"`
+	StatsArchived   int     `json:"stats_archived"`
+}
@@ -0,0 +1,132 @@
+package domain
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+
+const (
+	OpaqueTokenBytes   = 32
+	MaxUserAgentLength = 255
+)
+
+
+type SessionClient struct {
+	UserAgent string
+	IPAddress string
+}
+
+
+type Session struct {
+	ID           uuid.UUID  `json:"id" db:"id"`
+	UserID       uuid.UUID  `json:"user_id" db:"user_id"`
+	UserAgent    string     `json:"user_agent" db:"user_agent"`
+	IPAddress    string     `json:"ip_address" db:"ip_address"`
+	CreatedAt    time.Time  `json:"created_at" db:"created_at"`
+	LastUsedAt   time.Time  `json:"last_used_at" db:"last_used_at"`
+	ExpiresAt    time.Time  `json:"expires_at" db:"expires_at"`
+	MaxExpiresAt time.Time  `json:"max_expires_at" db:"max_expires_at"`
+	RevokedAt    *time.Time `json:"revoked_at,omitempty" db:"revoked_at"`
+	Current      bool       `json:"current" db:"-"`
+}
+
+
+type RefreshToken struct {
+	ID        uuid.UUID  `json:"id" db:"id"`
+	SessionID uuid.UUID  `json:"session_id" db:"session_id"`
+	TokenHash string     `json:"-" db:"token_hash"`
+	ExpiresAt time.Time  `json:"expires_at" db:"expires_at"`
+	RotatedAt *time.Time `json:"rotated_at,omitempty" db:"rotated_at"`
+	CreatedAt time.Time  `json:"created_at" db:"created_at"`
+}
+
+
+func (c SessionClient) ShortUserAgent() string {
+	if len(c.UserAgent) > MaxUserAgentLength {
+		return c.UserAgent[:MaxUserAgentLength]
+	}
+	return c.UserAgent
+}
+
+
+func NewSession(userID uuid.UUID, client SessionClient, ttl, lifetime time.Duration) *Session {
+	now := time.Now()
+	session := &Session{
+		ID:           uuid.New(),
+		UserID:       userID,
+		UserAgent:    client.ShortUserAgent(),
+		IPAddress:    client.IPAddress,
+		CreatedAt:    now,
+		LastUsedAt:   now,
+		MaxExpiresAt: now.Add(lifetime),
+	}
+	session.ExpiresAt = session.capExpiry(now.Add(ttl))
+	return session
+}
+
+
+func (s *Session) IsActive(now time.Time) bool {
+	return s.RevokedAt == nil && now.Before(s.ExpiresAt)
+}
+
+
+func (s *Session) Extend(ttl time.Duration) {
+	now := time.Now()
+	s.LastUsedAt = now
+	s.ExpiresAt = s.capExpiry(now.Add(ttl))
+}
+
+func (s *Session) capExpiry(expiresAt time.Time) time.Time {
+	if expiresAt.After(s.MaxExpiresAt) {
+		return s.MaxExpiresAt
+	}
+	return expiresAt
+}
+
+
+func NewRefreshToken(sessionID uuid.UUID, expiresAt time.Time) (*RefreshToken, string, error) {
+	token, hash, err := NewOpaqueToken()
+	if err != nil {
+		return nil, "", err
+	}
+
+	return &RefreshToken{
+		ID:        uuid.New(),
+		SessionID: sessionID,
+		TokenHash: hash,
+		ExpiresAt: expiresAt,
+		CreatedAt: time.Now(),
+	}, token, nil
+}
+
+
+func NewOpaqueToken() (string, string, error) {
+	raw := make([]byte, OpaqueTokenBytes)
+	if _, err := rand.Read(raw); err != nil {
+		return "", "", err
+	}
+	token := base64.RawURLEncoding.EncodeToString(raw)
+	return token, HashToken(token), nil
+}
+
+
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+
+func (t *RefreshToken) IsRotated() bool {
+	return t.RotatedAt != nil
+}
+
+
+func (t *RefreshToken) IsExpired(now time.Time) bool {
+	return !now.Before(t.ExpiresAt)
+}
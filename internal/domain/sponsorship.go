@@ -0,0 +1,162 @@
+package domain
+
+import (
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+
+type SponsorshipSchedule string
+
+const (
+	SponsorshipUpfront  SponsorshipSchedule = "upfront"
+	SponsorshipWeekly   SponsorshipSchedule = "weekly"
+	SponsorshipPerMatch SponsorshipSchedule = "per_match"
+)
+
+
+type SponsorshipStatus string
+
+const (
+	SponsorshipStatusActive    SponsorshipStatus = "active"
+	SponsorshipStatusCompleted SponsorshipStatus = "completed"
+)
+
+const (
+	SponsorshipBaseAmount  = 60000.00
+	SponsorshipPayments    = 38
+	SponsorshipOfferDays   = 7
+	SponsorshipUpfrontRate = 0.85
+	SponsorshipMatchBonus  = 1.25
+)
+
+
+type SponsorshipOffer struct {
+	ID          uuid.UUID           `json:"id" db:"id"`
+	TeamID      uuid.UUID           `json:"team_id" db:"team_id"`
+	SponsorName string              `json:"sponsor_name" db:"sponsor_name"`
+	Schedule    SponsorshipSchedule `json:"schedule" db:"schedule"`
+	Amount      float64             `json:"amount" db:"amount"`
+	Payments    int                 `json:"payments" db:"payments"`
+	ExpiresAt   time.Time           `json:"expires_at" db:"expires_at"`
+	CreatedAt   time.Time           `json:"created_at" db:"created_at"`
+}
+
+
+type SponsorshipDeal struct {
+	ID            uuid.UUID           `json:"id" db:"id"`
+	TeamID        uuid.UUID           `json:"team_id" db:"team_id"`
+	SponsorName   string              `json:"sponsor_name" db:"sponsor_name"`
+	Schedule      SponsorshipSchedule `json:"schedule" db:"schedule"`
+	Amount        float64             `json:"amount" db:"amount"`
+	PaymentsTotal int                 `json:"payments_total" db:"payments_total"`
+	PaymentsMade  int                 `json:"payments_made" db:"payments_made"`
+	Status        SponsorshipStatus   `json:"status" db:"status"`
+	NextPaymentAt *time.Time          `json:"next_payment_at,omitempty" db:"next_payment_at"`
+	SignedAt      time.Time           `json:"signed_at" db:"signed_at"`
+	UpdatedAt     time.Time           `json:"updated_at" db:"updated_at"`
+}
+
+
+type Sponsorships struct {
+	Deal   *SponsorshipDeal    `json:"deal,omitempty"`
+	Offers []*SponsorshipOffer `json:"offers"`
+}
+
+
+func NewSponsorshipOffers(teamID uuid.UUID, sponsorNames []string) []*SponsorshipOffer {
+	schedules := []SponsorshipSchedule{SponsorshipUpfront, SponsorshipWeekly, SponsorshipPerMatch}
+	offers := make([]*SponsorshipOffer, 0, len(schedules))
+	now := time.Now()
+
+	for i, schedule := range schedules {
+		amount := SponsorshipBaseAmount * (0.8 + rand.Float64()*0.4)
+		payments := SponsorshipPayments
+
+		switch schedule {
+		case SponsorshipUpfront:
+			amount = amount * SponsorshipPayments * SponsorshipUpfrontRate
+			payments = 1
+		case SponsorshipPerMatch:
+			amount = amount * SponsorshipMatchBonus
+		}
+
+		offers = append(offers, &SponsorshipOffer{
+			ID:          uuid.New(),
+			TeamID:      teamID,
+			SponsorName: sponsorNames[i%len(sponsorNames)],
+			Schedule:    schedule,
+			Amount:      math.Round(amount),
+			Payments:    payments,
+			ExpiresAt:   now.AddDate(0, 0, SponsorshipOfferDays),
+			CreatedAt:   now,
+		})
+	}
+
+	return offers
+}
+
+
+func (o *SponsorshipOffer) TotalValue() float64 {
+	return o.Amount * float64(o.Payments)
+}
+
+
+func (o *SponsorshipOffer) IsExpiredAt(now time.Time) bool {
+	return !o.ExpiresAt.After(now)
+}
+
+
+func (o *SponsorshipOffer) Accept() *SponsorshipDeal {
+	now := time.Now()
+	deal := &SponsorshipDeal{
+		ID:            uuid.New(),
+		TeamID:        o.TeamID,
+		SponsorName:   o.SponsorName,
+		Schedule:      o.Schedule,
+		Amount:        o.Amount,
+		PaymentsTotal: o.Payments,
+		Status:        SponsorshipStatusActive,
+		SignedAt:      now,
+		UpdatedAt:     now,
+	}
+	if o.Schedule == SponsorshipWeekly {
+		next := now.AddDate(0, 0, 7)
+		deal.NextPaymentAt = &next
+	}
+	return deal
+}
+
+
+func (d *SponsorshipDeal) IsActive() bool {
+	return d.Status == SponsorshipStatusActive
+}
+
+
+func (d *SponsorshipDeal) RemainingPayments() int {
+	return d.PaymentsTotal - d.PaymentsMade
+}
+
+
+func (d *SponsorshipDeal) IsDueAt(now time.Time) bool {
+	return d.IsActive() && d.Schedule == SponsorshipWeekly && d.NextPaymentAt != nil && !d.NextPaymentAt.After(now)
+}
+
+
+func (d *SponsorshipDeal) RecordPayment() {
+	d.PaymentsMade++
+	d.UpdatedAt = time.Now()
+
+	if d.RemainingPayments() <= 0 {
+		d.Status = SponsorshipStatusCompleted
+		d.NextPaymentAt = nil
+		return
+	}
+	if d.NextPaymentAt != nil {
+		next := d.NextPaymentAt.AddDate(0, 0, 7)
+		d.NextPaymentAt = &next
+	}
+}
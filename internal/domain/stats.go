@@ -0,0 +1,279 @@
+package domain
+
+import (
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+
+const (
+	MaxMatchSubstitutions    = 3
+	AssistProbability        = 0.75
+	YellowCardProbability    = 0.12
+	SecondYellowProbability  = 0.05
+	RedCardProbability       = 0.01
+	RedCardSuspensionMatches = 1
+	BaseMatchRating          = 6.0
+	MinMatchRating           = 1.0
+	MaxMatchRating           = 10.0
+	RecentFormMatches        = 5
+	NeutralFormRating        = 6.5
+	FormValueStep            = 0.01
+	DefaultLeaderboardLimit  = 20
+	MaxLeaderboardLimit      = 100
+)
+
+
+type LeaderboardCategory string
+
+const (
+	LeaderboardScorers LeaderboardCategory = "scorers"
+	LeaderboardAssists LeaderboardCategory = "assists"
+)
+
+
+func (c LeaderboardCategory) IsValid() bool {
+	switch c {
+	case LeaderboardScorers, LeaderboardAssists:
+		return true
+	}
+	return false
+}
+
+
+type PlayerMatchStats struct {
+	ID          uuid.UUID   `json:"id" db:"id"`
+	MatchID     uuid.UUID   `json:"match_id" db:"match_id"`
+	PlayerID    uuid.UUID   `json:"player_id" db:"player_id"`
+	TeamID      uuid.UUID   `json:"team_id" db:"team_id"`
+	SeasonID    *uuid.UUID  `json:"season_id,omitempty" db:"season_id"`
+	Competition Competition `json:"competition" db:"competition"`
+	Started     bool        `json:"started" db:"started"`
+	Goals       int         `json:"goals" db:"goals"`
+	Assists     int         `json:"assists" db:"assists"`
+	CleanSheet  bool        `json:"clean_sheet" db:"clean_sheet"`
+	YellowCards int         `json:"yellow_cards" db:"yellow_cards"`
+	RedCards    int         `json:"red_cards" db:"red_cards"`
+	Rating      float64     `json:"rating" db:"rating"`
+	CreatedAt   time.Time   `json:"created_at" db:"created_at"`
+}
+
+
+type PlayerStats struct {
+	PlayerID      uuid.UUID   `json:"player_id" db:"player_id"`
+	FirstName     string      `json:"first_name,omitempty" db:"first_name"`
+	LastName      string      `json:"last_name,omitempty" db:"last_name"`
+	TeamName      string      `json:"team_name,omitempty" db:"team_name"`
+	Season        *int        `json:"season,omitempty" db:"season_number"`
+	Competition   Competition `json:"competition,omitempty" db:"competition"`
+	Appearances   int         `json:"appearances" db:"appearances"`
+	Starts        int         `json:"starts" db:"starts"`
+	Goals         int         `json:"goals" db:"goals"`
+	Assists       int         `json:"assists" db:"assists"`
+	CleanSheets   int         `json:"clean_sheets" db:"clean_sheets"`
+	YellowCards   int         `json:"yellow_cards" db:"yellow_cards"`
+	RedCards      int         `json:"red_cards" db:"red_cards"`
+	AverageRating float64     `json:"average_rating" db:"average_rating"`
+}
+
+
+type TeamRecord struct {
+	Competition  Competition `json:"competition"`
+	Played       int         `json:"played"`
+	Won          int         `json:"won"`
+	Drawn        int         `json:"drawn"`
+	Lost         int         `json:"lost"`
+	GoalsFor     int         `json:"goals_for"`
+	GoalsAgainst int         `json:"goals_against"`
+	CleanSheets  int         `json:"clean_sheets"`
+}
+
+
+type TeamStats struct {
+	TeamID  uuid.UUID      `json:"team_id"`
+	Season  *int           `json:"season,omitempty"`
+	Records []*TeamRecord  `json:"records"`
+	Players []*PlayerStats `json:"players"`
+}
+
+
+func NewTeamRecords(teamID uuid.UUID, matches []*Match, since time.Time) []*TeamRecord {
+	competitions := []Competition{CompetitionLeague, CompetitionCup, CompetitionFriendly}
+	records := make(map[Competition]*TeamRecord, len(competitions))
+	result := make([]*TeamRecord, 0, len(competitions))
+	for _, competition := range competitions {
+		record := &TeamRecord{Competition: competition}
+		records[competition] = record
+		result = append(result, record)
+	}
+
+	for _, match := range matches {
+		if !match.IsPlayed() || match.PlayedAt.Before(since) {
+			continue
+		}
+		record, ok := records[match.Competition]
+		if !ok {
+			continue
+		}
+
+		goalsFor, goalsAgainst := match.GoalsFor(teamID)
+		record.Played++
+		record.GoalsFor += goalsFor
+		record.GoalsAgainst += goalsAgainst
+		switch {
+		case goalsFor > goalsAgainst:
+			record.Won++
+		case goalsFor < goalsAgainst:
+			record.Lost++
+		default:
+			record.Drawn++
+		}
+		if goalsAgainst == 0 {
+			record.CleanSheets++
+		}
+	}
+
+	return result
+}
+
+
+func SimulatePlayerStats(match *Match, teamID uuid.UUID, seasonID *uuid.UUID, chemistry int, starters, substitutes []*Player) []*PlayerMatchStats {
+	goalsFor, goalsAgainst := match.GoalsFor(teamID)
+	assistProbability := AssistProbability + float64(chemistry-50)/200
+
+	participants := make([]*Player, 0, len(starters)+MaxMatchSubstitutions)
+	stats := make(map[uuid.UUID]*PlayerMatchStats, len(starters)+MaxMatchSubstitutions)
+	appear := func(player *Player, started bool) {
+		participants = append(participants, player)
+		stats[player.ID] = &PlayerMatchStats{
+			ID:          uuid.New(),
+			MatchID:     match.ID,
+			PlayerID:    player.ID,
+			TeamID:      teamID,
+			SeasonID:    seasonID,
+			Competition: match.Competition,
+			Started:     started,
+			CreatedAt:   time.Now(),
+		}
+	}
+	for _, player := range starters {
+		appear(player, true)
+	}
+	for _, i := range rand.Perm(len(substitutes)) {
+		if len(participants) >= len(starters)+MaxMatchSubstitutions {
+			break
+		}
+		appear(substitutes[i], false)
+	}
+	if len(participants) == 0 {
+		return nil
+	}
+
+
+	for goal := 0; goal < goalsFor; goal++ {
+		scorer := pickWeighted(participants, nil, goalWeight)
+		if scorer == nil {
+			continue
+		}
+		stats[scorer.ID].Goals++
+
+		if rand.Float64() < assistProbability {
+			if assister := pickWeighted(participants, scorer, assistWeight); assister != nil {
+				stats[assister.ID].Assists++
+			}
+		}
+	}
+
+
+	result := make([]*PlayerMatchStats, 0, len(participants))
+	for _, player := range participants {
+		line := stats[player.ID]
+		if goalsAgainst == 0 && line.Started && (player.Position == PositionGoalkeeper || player.Position == PositionDefender) {
+			line.CleanSheet = true
+		}
+		if rand.Float64() < YellowCardProbability {
+			line.YellowCards = 1
+			if rand.Float64() < SecondYellowProbability {
+				line.YellowCards = 2
+				line.RedCards = 1
+			}
+		} else if rand.Float64() < RedCardProbability {
+			line.RedCards = 1
+		}
+		line.Rating = matchRating(line, goalsFor, goalsAgainst, float64(player.Morale-50+chemistry-50)/100)
+		result = append(result, line)
+	}
+
+	return result
+}
+
+
+func matchRating(line *PlayerMatchStats, goalsFor, goalsAgainst int, form float64) float64 {
+	rating := BaseMatchRating + rand.Float64() - 0.5 + form
+	rating += float64(line.Goals) + 0.5*float64(line.Assists)
+	if line.CleanSheet {
+		rating += 0.5
+	}
+	switch {
+	case goalsFor > goalsAgainst:
+		rating += 0.5
+	case goalsFor < goalsAgainst:
+		rating -= 0.5
+	}
+	rating -= 0.3 * float64(line.YellowCards)
+	rating -= 1.5 * float64(line.RedCards)
+
+	rating = math.Max(MinMatchRating, math.Min(MaxMatchRating, rating))
+	return math.Round(rating*10) / 10
+}
+
+func goalWeight(player *Player) float64 {
+	switch player.Position {
+	case PositionAttacker:
+		return 3.0 * float64(player.Finishing)
+	case PositionMidfielder:
+		return 1.5 * float64(player.Finishing)
+	case PositionDefender:
+		return 0.4 * float64(player.Finishing)
+	}
+	return 0
+}
+
+func assistWeight(player *Player) float64 {
+	switch player.Position {
+	case PositionMidfielder:
+		return 2.5 * float64(player.Passing)
+	case PositionAttacker:
+		return 1.5 * float64(player.Passing)
+	case PositionDefender:
+		return 1.0 * float64(player.Passing)
+	}
+	return 0.1 * float64(player.Passing)
+}
+
+func pickWeighted(players []*Player, exclude *Player, weight func(*Player) float64) *Player {
+	total := 0.0
+	for _, player := range players {
+		if player != exclude {
+			total += weight(player)
+		}
+	}
+	if total <= 0 {
+		return nil
+	}
+
+	roll := rand.Float64() * total
+	for _, player := range players {
+		if player == exclude {
+			continue
+		}
+		roll -= weight(player)
+		if roll < 0 {
+			return player
+		}
+	}
+	return nil
+}
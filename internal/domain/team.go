@@ -1,6 +1,8 @@
 package domain
 
 import (
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -8,13 +10,18 @@ import (
 
 
 type Team struct {
-	ID        uuid.UUID `json:"id" db:"id"`
-	UserID    uuid.UUID `json:"user_id" db:"user_id"`
-	Name      string    `json:"name" db:"name"`
-	Country   string    `json:"country" db:"country"`
-	Budget    float64   `json:"budget" db:"budget"`
-	CreatedAt time.Time `json:"created_at" db:"created_at"`
-	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+	ID             uuid.UUID `json:"id" db:"id"`
+	UserID         uuid.UUID `json:"user_id" db:"user_id"`
+	Name           string    `json:"name" db:"name"`
+	Country        string    `json:"country" db:"country"`
+	Budget         float64   `json:"budget" db:"budget"`
+	Rating         int       `json:"rating" db:"rating"`
+	IsBot          bool      `json:"is_bot" db:"is_bot"`
+	IsDefault      bool      `json:"is_default" db:"is_default"`
+	CrestPrimary   string    `json:"crest_primary" db:"crest_primary"`
+	CrestSecondary string    `json:"crest_secondary" db:"crest_secondary"`
+	CreatedAt      time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at" db:"updated_at"`
 }
 
 
@@ -24,24 +31,56 @@ type TeamWithValue struct {
 }
 
 const (
-	InitialBudget = 5000000.00
-	MaxPlayers    = 20
+	InitialBudget         = 5000000.00
+	MaxPlayers            = 20
+	MaxTeamsPerUser       = 5
+	DefaultCountry        = "Unknown"
+	DefaultCrestPrimary   = "#FFFFFF"
+	DefaultCrestSecondary = "#000000"
 )
 
+var crestColourPattern = regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`)
+
 
 func NewTeam(userID uuid.UUID, name, country string) *Team {
 	return &Team{
-		ID:        uuid.New(),
-		UserID:    userID,
-		Name:      name,
-		Country:   country,
-		Budget:    InitialBudget,
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
+		ID:             uuid.New(),
+		UserID:         userID,
+		Name:           name,
+		Country:        country,
+		Budget:         InitialBudget,
+		Rating:         DefaultRating,
+		CrestPrimary:   DefaultCrestPrimary,
+		CrestSecondary: DefaultCrestSecondary,
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
 	}
 }
 
 
+func (t *Team) SetCrest(primary, secondary string) error {
+	if primary == "" {
+		primary = t.CrestPrimary
+	}
+	if secondary == "" {
+		secondary = t.CrestSecondary
+	}
+	if !crestColourPattern.MatchString(primary) || !crestColourPattern.MatchString(secondary) {
+		return ErrInvalidCrestColour
+	}
+
+	t.CrestPrimary = strings.ToUpper(primary)
+	t.CrestSecondary = strings.ToUpper(secondary)
+	t.UpdatedAt = time.Now()
+	return nil
+}
+
+
+func (t *Team) IsOwnedBy(userID uuid.UUID) bool {
+	return t.UserID == userID
+}
+
+
 func (t *Team) CanAfford(price float64) bool {
 	return t.Budget >= price
 }
@@ -52,8 +91,3 @@ func (t *Team) DeductBudget(amount float64) {
 	t.UpdatedAt = time.Now()
 }
 
-
-func (t *Team) AddBudget(amount float64) {
-	t.Budget += amount
-	t.UpdatedAt = time.Now()
-}
@@ -0,0 +1,101 @@
+package domain
+
+import (
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const BaseFacilityLevel = 1
+
+
+type TrainingAssignment struct {
+	ID        uuid.UUID  `json:"id" db:"id"`
+	TeamID    uuid.UUID  `json:"team_id" db:"team_id"`
+	PlayerID  *uuid.UUID `json:"player_id,omitempty" db:"player_id"`
+	Position  *Position  `json:"position,omitempty" db:"position"`
+	Focus     Attribute  `json:"focus" db:"focus"`
+	CreatedAt time.Time  `json:"created_at" db:"created_at"`
+}
+
+
+func NewTrainingAssignment(teamID uuid.UUID, playerID *uuid.UUID, position *Position, focus Attribute) *TrainingAssignment {
+	return &TrainingAssignment{
+		ID:        uuid.New(),
+		TeamID:    teamID,
+		PlayerID:  playerID,
+		Position:  position,
+		Focus:     focus,
+		CreatedAt: time.Now(),
+	}
+}
+
+
+func ResolveTrainingFocus(player *Player, assignments []*TrainingAssignment) (Attribute, bool) {
+	var groupFocus Attribute
+	for _, assignment := range assignments {
+		if assignment.PlayerID != nil && *assignment.PlayerID == player.ID {
+			return assignment.Focus, true
+		}
+		if assignment.Position != nil && *assignment.Position == player.Position {
+			groupFocus = assignment.Focus
+		}
+	}
+	return groupFocus, groupFocus != ""
+}
+
+
+func TrainingGrowth(age, potential, rating, facilityLevel int) int {
+	if rating >= potential {
+		return 0
+	}
+
+	var base int
+	switch {
+	case age <= 21:
+		base = 4
+	case age <= 25:
+		base = 3
+	case age <= 29:
+		base = 2
+	case age <= 32:
+		base = 1
+	default:
+		return 0
+	}
+
+	facilityBonus := 1 + 0.25*float64(facilityLevel-BaseFacilityLevel)
+	return int(math.Round(float64(1+rand.Intn(base)) * facilityBonus))
+}
+
+
+type TrainingSession struct {
+	ID             uuid.UUID `json:"id" db:"id"`
+	PlayerID       uuid.UUID `json:"player_id" db:"player_id"`
+	TeamID         uuid.UUID `json:"team_id" db:"team_id"`
+	Focus          Attribute `json:"focus" db:"focus"`
+	PreviousValue  int       `json:"previous_value" db:"previous_value"`
+	NewValue       int       `json:"new_value" db:"new_value"`
+	PreviousRating int       `json:"previous_rating" db:"previous_rating"`
+	NewRating      int       `json:"new_rating" db:"new_rating"`
+	MarketValue    float64   `json:"market_value" db:"market_value"`
+	TrainedAt      time.Time `json:"trained_at" db:"trained_at"`
+}
+
+
+func NewTrainingSession(player *Player, focus Attribute, previousValue, previousRating int) *TrainingSession {
+	return &TrainingSession{
+		ID:             uuid.New(),
+		PlayerID:       player.ID,
+		TeamID:         *player.TeamID,
+		Focus:          focus,
+		PreviousValue:  previousValue,
+		NewValue:       player.AttributeValue(focus),
+		PreviousRating: previousRating,
+		NewRating:      player.Rating(),
+		MarketValue:    player.MarketValue,
+		TrainedAt:      time.Now(),
+	}
+}
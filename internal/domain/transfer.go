@@ -42,6 +42,25 @@ func NewTransferListing(playerID uuid.UUID, askingPrice float64) *TransferListin
 }
 
 
+func NewFreeAgentListing(player *Player) *TransferListing {
+	return NewTransferListing(player.ID, player.MarketValue)
+}
+
+
+func ValidateListing(player *Player, teamID uuid.UUID, existing *TransferListing, askingPrice float64) error {
+	if !player.IsOwnedBy(teamID) {
+		return ErrPlayerNotOwned
+	}
+	if existing != nil && existing.IsActive() {
+		return ErrPlayerAlreadyListed
+	}
+	if askingPrice <= 0 {
+		return ErrInvalidAskingPrice
+	}
+	return nil
+}
+
+
 func (tl *TransferListing) MarkAsSold() {
 	tl.Status = TransferStatusSold
 }
@@ -58,22 +77,34 @@ func (tl *TransferListing) IsActive() bool {
 
 
 type Transfer struct {
-	ID            uuid.UUID `json:"id" db:"id"`
-	PlayerID      uuid.UUID `json:"player_id" db:"player_id"`
-	SellerTeamID  uuid.UUID `json:"seller_team_id" db:"seller_team_id"`
-	BuyerTeamID   uuid.UUID `json:"buyer_team_id" db:"buyer_team_id"`
-	TransferPrice float64   `json:"transfer_price" db:"transfer_price"`
-	TransferredAt time.Time `json:"transferred_at" db:"transferred_at"`
+	ID            uuid.UUID  `json:"id" db:"id"`
+	PlayerID      uuid.UUID  `json:"player_id" db:"player_id"`
+	SellerTeamID  *uuid.UUID `json:"seller_team_id" db:"seller_team_id"`
+	BuyerTeamID   *uuid.UUID `json:"buyer_team_id" db:"buyer_team_id"`
+	TransferPrice float64    `json:"transfer_price" db:"transfer_price"`
+	TransferredAt time.Time  `json:"transferred_at" db:"transferred_at"`
+	ReversedAt    *time.Time `json:"reversed_at,omitempty" db:"reversed_at"`
 }
 
 
-func NewTransfer(playerID, sellerTeamID, buyerTeamID uuid.UUID, transferPrice float64) *Transfer {
+func NewTransfer(playerID uuid.UUID, sellerTeamID *uuid.UUID, buyerTeamID uuid.UUID, transferPrice float64) *Transfer {
 	return &Transfer{
 		ID:            uuid.New(),
 		PlayerID:      playerID,
 		SellerTeamID:  sellerTeamID,
-		BuyerTeamID:   buyerTeamID,
+		BuyerTeamID:   &buyerTeamID,
 		TransferPrice: transferPrice,
 		TransferredAt: time.Now(),
 	}
 }
+
+
+func (t *Transfer) IsReversed() bool {
+	return t.ReversedAt != nil
+}
+
+
+func (t *Transfer) Reverse() {
+	now := time.Now()
+	t.ReversedAt = &now
+}
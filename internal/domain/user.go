@@ -8,11 +8,18 @@ import (
 
 
 type User struct {
-	ID           uuid.UUID `json:"id" db:"id"`
-	Email        string    `json:"email" db:"email"`
-	PasswordHash string    `json:"-" db:"password_hash"`
-	CreatedAt    time.Time `json:"created_at" db:"created_at"`
-	UpdatedAt    time.Time `json:"updated_at" db:"updated_at"`
+	ID                  uuid.UUID  `json:"id" db:"id"`
+	Email               string     `json:"email" db:"email"`
+	Role                Role       `json:"role" db:"role"`
+	PasswordHash        string     `json:"-" db:"password_hash"`
+	BannedAt            *time.Time `json:"banned_at,omitempty" db:"banned_at"`
+	EmailVerifiedAt     *time.Time `json:"email_verified_at,omitempty" db:"email_verified_at"`
+	DeletionScheduledAt *time.Time `json:"deletion_scheduled_at,omitempty" db:"deletion_scheduled_at"`
+	TOTPSecret          *string    `json:"-" db:"totp_secret"`
+	TOTPEnabledAt       *time.Time `json:"totp_enabled_at,omitempty" db:"totp_enabled_at"`
+	TOTPLastStep        int64      `json:"-" db:"totp_last_step"`
+	CreatedAt           time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt           time.Time  `json:"updated_at" db:"updated_at"`
 }
 
 
@@ -20,8 +27,96 @@ func NewUser(email, passwordHash string) *User {
 	return &User{
 		ID:           uuid.New(),
 		Email:        email,
+		Role:         RoleManager,
 		PasswordHash: passwordHash,
 		CreatedAt:    time.Now(),
 		UpdatedAt:    time.Now(),
 	}
 }
+
+
+func (u *User) IsBanned() bool {
+	return u.BannedAt != nil
+}
+
+
+func (u *User) Ban() {
+	now := time.Now()
+	u.BannedAt = &now
+	u.UpdatedAt = now
+}
+
+
+func (u *User) Unban() {
+	u.BannedAt = nil
+	u.UpdatedAt = time.Now()
+}
+
+
+func (u *User) IsVerified() bool {
+	return u.EmailVerifiedAt != nil
+}
+
+
+func (u *User) VerifyEmail() {
+	if u.EmailVerifiedAt != nil {
+		return
+	}
+	now := time.Now()
+	u.EmailVerifiedAt = &now
+	u.UpdatedAt = now
+}
+
+
+func (u *User) IsDeletionScheduled() bool {
+	return u.DeletionScheduledAt != nil
+}
+
+
+func (u *User) ScheduleDeletion(grace time.Duration) {
+	now := time.Now()
+	at := now.Add(grace)
+	u.DeletionScheduledAt = &at
+	u.UpdatedAt = now
+}
+
+
+func (u *User) CancelDeletion() {
+	u.DeletionScheduledAt = nil
+	u.UpdatedAt = time.Now()
+}
+
+
+func (u *User) HasTwoFactor() bool {
+	return u.TOTPEnabledAt != nil
+}
+
+
+func (u *User) SetTOTPSecret(secret string) {
+	u.TOTPSecret = &secret
+	u.TOTPEnabledAt = nil
+	u.TOTPLastStep = 0
+	u.UpdatedAt = time.Now()
+}
+
+
+func (u *User) EnableTwoFactor(step int64) {
+	now := time.Now()
+	u.TOTPEnabledAt = &now
+	u.TOTPLastStep = step
+	u.UpdatedAt = now
+}
+
+
+func (u *User) DisableTwoFactor() {
+	u.TOTPSecret = nil
+	u.TOTPEnabledAt = nil
+	u.TOTPLastStep = 0
+	u.UpdatedAt = time.Now()
+}
+
+
+func (u *User) SetRole(role Role) {
+	u.Role = role
+	u.UpdatedAt = time.Now()
+}
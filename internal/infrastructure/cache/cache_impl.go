@@ -50,6 +50,7 @@ func (h *CacheHelper) InvalidateTeamCache(ctx context.Context, teamID string) er
 		CacheKey("team", teamID),
 		CacheKey("team:players", teamID),
 		CacheKey("team:value", teamID),
+		CacheKey("team:lineup", teamID),
 	}
 	for _, pattern := range patterns {
 		if err := h.cache.Delete(ctx, pattern); err != nil {
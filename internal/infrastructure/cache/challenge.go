@@ -0,0 +1,105 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"soccer-manager-api/internal/domain"
+	cachePort "soccer-manager-api/internal/ports/cache"
+
+	"github.com/google/uuid"
+)
+
+
+type ChallengeStore struct {
+	cache cachePort.Cache
+	ttl   time.Duration
+}
+
+type pendingChallenge struct {
+	UserID    uuid.UUID `json:"user_id"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+
+func NewChallengeStore(c cachePort.Cache, ttl time.Duration) *ChallengeStore {
+	return &ChallengeStore{cache: c, ttl: ttl}
+}
+
+
+func (s *ChallengeStore) Issue(ctx context.Context, userID uuid.UUID) (*domain.TwoFactorChallenge, error) {
+	token, hash, err := domain.NewOpaqueToken()
+	if err != nil {
+		return nil, err
+	}
+
+	pending := pendingChallenge{UserID: userID, ExpiresAt: time.Now().Add(s.ttl)}
+	if err := s.save(ctx, hash, pending); err != nil {
+		return nil, err
+	}
+	return &domain.TwoFactorChallenge{Token: token, ExpiresAt: pending.ExpiresAt}, nil
+}
+
+
+func (s *ChallengeStore) Lookup(ctx context.Context, token string) (uuid.UUID, error) {
+	pending, err := s.load(ctx, domain.HashToken(token))
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return pending.UserID, nil
+}
+
+
+func (s *ChallengeStore) Fail(ctx context.Context, token string) error {
+	hash := domain.HashToken(token)
+	pending, err := s.load(ctx, hash)
+	if err != nil {
+		return err
+	}
+
+	attempts, err := s.cache.Increment(ctx, CacheKey("2fa:attempts", hash), ttlSeconds(time.Until(pending.ExpiresAt)))
+	if err != nil {
+		return err
+	}
+	if attempts >= domain.MaxChallengeAttempts {
+		return s.Consume(ctx, token)
+	}
+	return nil
+}
+
+
+func (s *ChallengeStore) Consume(ctx context.Context, token string) error {
+	hash := domain.HashToken(token)
+	if err := s.cache.Delete(ctx, CacheKey("2fa:challenge", hash)); err != nil {
+		return err
+	}
+	return s.cache.Delete(ctx, CacheKey("2fa:attempts", hash))
+}
+
+func (s *ChallengeStore) load(ctx context.Context, hash string) (*pendingChallenge, error) {
+	data, err := s.cache.Get(ctx, CacheKey("2fa:challenge", hash))
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, domain.ErrInvalidChallenge
+	}
+
+	var pending pendingChallenge
+	if err := json.Unmarshal(data, &pending); err != nil {
+		return nil, err
+	}
+	if !time.Now().Before(pending.ExpiresAt) {
+		return nil, domain.ErrInvalidChallenge
+	}
+	return &pending, nil
+}
+
+func (s *ChallengeStore) save(ctx context.Context, hash string, pending pendingChallenge) error {
+	data, err := json.Marshal(pending)
+	if err != nil {
+		return err
+	}
+	return s.cache.Set(ctx, CacheKey("2fa:challenge", hash), data, ttlSeconds(time.Until(pending.ExpiresAt)))
+}
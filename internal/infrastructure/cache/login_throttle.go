@@ -0,0 +1,150 @@
+package cache
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"soccer-manager-api/internal/domain"
+	cachePort "soccer-manager-api/internal/ports/cache"
+	"soccer-manager-api/pkg/logger"
+
+	"go.uber.org/zap"
+)
+
+
+type LoginThrottle struct {
+	cache            cachePort.Cache
+	maxAttempts      int
+	maxAttemptsPerIP int
+	lockout          time.Duration
+}
+
+func NewLoginThrottle(c cachePort.Cache, maxAttempts, maxAttemptsPerIP int, lockout time.Duration) *LoginThrottle {
+	return &LoginThrottle{
+		cache:            c,
+		maxAttempts:      maxAttempts,
+		maxAttemptsPerIP: maxAttemptsPerIP,
+		lockout:          lockout,
+	}
+}
+
+
+func (t *LoginThrottle) Check(ctx context.Context, email, ip string) error {
+	now := time.Now()
+
+	key := emailKey(email)
+	until, err := t.blockedUntil(ctx, key+":locked")
+	if err != nil {
+		return err
+	}
+	if now.Before(until) {
+		return &domain.LoginThrottleError{Err: domain.ErrAccountLocked, RetryAfter: until.Sub(now)}
+	}
+	until, err = t.blockedUntil(ctx, key+":next")
+	if err != nil {
+		return err
+	}
+	if now.Before(until) {
+		return &domain.LoginThrottleError{Err: domain.ErrTooManyLoginAttempts, RetryAfter: until.Sub(now)}
+	}
+
+
+	key = ipKey(ip)
+	for _, suffix := range []string{":locked", ":next"} {
+		until, err = t.blockedUntil(ctx, key+suffix)
+		if err != nil {
+			return err
+		}
+		if now.Before(until) {
+			return &domain.LoginThrottleError{Err: domain.ErrTooManyLoginAttempts, RetryAfter: until.Sub(now)}
+		}
+	}
+	return nil
+}
+
+
+func (t *LoginThrottle) RecordFailure(ctx context.Context, email, ip string) error {
+	failures, err := t.recordFailure(ctx, emailKey(email), t.maxAttempts)
+	if err != nil {
+		return err
+	}
+	if failures >= int64(t.maxAttempts) {
+		logger.Logger.Warn("Login locked out for email", zap.String("email", email), zap.String("ip_address", ip), zap.Int64("failures", failures))
+	}
+
+
+	failures, err = t.recordFailure(ctx, ipKey(ip), t.maxAttemptsPerIP)
+	if err != nil {
+		return err
+	}
+	if failures >= int64(t.maxAttemptsPerIP) {
+		logger.Logger.Warn("Login locked out for IP address", zap.String("ip_address", ip), zap.Int64("failures", failures))
+	}
+	return nil
+}
+
+
+func (t *LoginThrottle) Reset(ctx context.Context, email string) error {
+	key := emailKey(email)
+	if err := t.cache.Delete(ctx, key); err != nil {
+		return err
+	}
+	return t.cache.Delete(ctx, key+":next")
+}
+
+func (t *LoginThrottle) recordFailure(ctx context.Context, key string, limit int) (int64, error) {
+	failures, err := t.cache.Increment(ctx, key, ttlSeconds(t.lockout))
+	if err != nil {
+		return 0, err
+	}
+
+	if failures >= int64(limit) {
+		return failures, t.block(ctx, key+":locked", t.lockout)
+	}
+	delay := t.backoff(failures * int64(t.maxAttempts) / int64(limit))
+	if delay == 0 {
+		return failures, nil
+	}
+	return failures, t.block(ctx, key+":next", delay)
+}
+
+func (t *LoginThrottle) backoff(failures int64) time.Duration {
+	if failures <= 0 {
+		return 0
+	}
+	if failures > 62 {
+		return t.lockout
+	}
+	delay := time.Second << (failures - 1)
+	if delay <= 0 || delay > t.lockout {
+		return t.lockout
+	}
+	return delay
+}
+
+func (t *LoginThrottle) block(ctx context.Context, key string, delay time.Duration) error {
+	until, err := time.Now().Add(delay).MarshalText()
+	if err != nil {
+		return err
+	}
+	return t.cache.Set(ctx, key, until, ttlSeconds(delay))
+}
+
+func (t *LoginThrottle) blockedUntil(ctx context.Context, key string) (time.Time, error) {
+	var until time.Time
+	data, err := t.cache.Get(ctx, key)
+	if err != nil || data == nil {
+		return until, err
+	}
+	err = until.UnmarshalText(data)
+	return until, err
+}
+
+func emailKey(email string) string {
+	return CacheKey("login:email", domain.HashToken(strings.ToLower(strings.TrimSpace(email))))
+}
+
+func ipKey(ip string) string {
+	return CacheKey("login:ip", ip)
+}
@@ -0,0 +1,136 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"soccer-manager-api/internal/domain"
+	"soccer-manager-api/internal/infrastructure/cache/memory"
+	"soccer-manager-api/pkg/logger"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestMain(m *testing.M) {
+	logger.Logger = zap.NewNop()
+	os.Exit(m.Run())
+}
+
+func throttleErr(t *testing.T, err error) *domain.LoginThrottleError {
+	var throttled *domain.LoginThrottleError
+	if !errors.As(err, &throttled) {
+		t.Fatalf("expected a throttle error, got %v", err)
+	}
+	return throttled
+}
+
+func TestLoginThrottleBackoff(t *testing.T) {
+	throttle := NewLoginThrottle(memory.NewMemoryCache(), 5, 20, 15*time.Minute)
+
+	tests := []struct {
+		failures int64
+		want     time.Duration
+	}{
+		{0, 0},
+		{1, time.Second},
+		{2, 2 * time.Second},
+		{5, 16 * time.Second},
+		{10, 512 * time.Second},
+		{11, 15 * time.Minute},
+		{63, 15 * time.Minute},
+		{1000, 15 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, throttle.backoff(tt.failures), "failures %d", tt.failures)
+	}
+}
+
+func TestLoginThrottleEmail(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		failures int
+		reset    bool
+		wantErr  error
+	}{
+		{"no failures", 0, false, nil},
+		{"one failure backs off", 1, false, domain.ErrTooManyLoginAttempts},
+		{"below the limit backs off", 2, false, domain.ErrTooManyLoginAttempts},
+		{"limit locks the account", 3, false, domain.ErrAccountLocked},
+		{"reset clears the backoff", 2, true, nil},
+		{"reset keeps the lock", 3, true, domain.ErrAccountLocked},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			throttle := NewLoginThrottle(memory.NewMemoryCache(), 3, 100, time.Minute)
+			for i := 0; i < tt.failures; i++ {
+				assert.NoError(t, throttle.RecordFailure(ctx, "user@example.com", "10.0.0.1"))
+			}
+			if tt.reset {
+				assert.NoError(t, throttle.Reset(ctx, "user@example.com"))
+			}
+
+			err := throttle.Check(ctx, "User@Example.com ", "10.0.0.1")
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			throttled := throttleErr(t, err)
+			assert.ErrorIs(t, throttled, tt.wantErr)
+			assert.Greater(t, throttled.RetryAfter, time.Duration(0))
+			assert.LessOrEqual(t, throttled.RetryAfter, time.Minute)
+
+			assert.NoError(t, throttle.Check(ctx, "other@example.com", "10.0.0.2"))
+		})
+	}
+}
+
+func TestLoginThrottleIP(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		failures int
+		wantErr  error
+	}{
+		{"below the first delay", 1, nil},
+		{"scaled backoff", 2, domain.ErrTooManyLoginAttempts},
+		{"limit locks the address", 4, domain.ErrTooManyLoginAttempts},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			throttle := NewLoginThrottle(memory.NewMemoryCache(), 2, 4, time.Minute)
+			for i := 0; i < tt.failures; i++ {
+				assert.NoError(t, throttle.RecordFailure(ctx, fmt.Sprintf("user%d@example.com", i), "10.0.0.1"))
+			}
+
+			err := throttle.Check(ctx, "fresh@example.com", "10.0.0.1")
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, throttleErr(t, err), tt.wantErr)
+			}
+			assert.NoError(t, throttle.Check(ctx, "fresh@example.com", "10.0.0.2"))
+		})
+	}
+}
+
+func TestLoginThrottleLockLastsForLockout(t *testing.T) {
+	ctx := context.Background()
+	throttle := NewLoginThrottle(memory.NewMemoryCache(), 1, 100, time.Minute)
+
+	assert.NoError(t, throttle.RecordFailure(ctx, "user@example.com", "10.0.0.1"))
+
+	throttled := throttleErr(t, throttle.Check(ctx, "user@example.com", "10.0.0.1"))
+	assert.ErrorIs(t, throttled, domain.ErrAccountLocked)
+	assert.InDelta(t, time.Minute.Seconds(), throttled.RetryAfter.Seconds(), 1)
+}
@@ -0,0 +1,104 @@
+package memory
+
+import (
+	"context"
+	"path"
+	"strconv"
+	"sync"
+	"time"
+
+	"soccer-manager-api/internal/ports/cache"
+)
+
+type entry struct {
+	value     []byte
+	expiresAt time.Time
+}
+
+type memoryCache struct {
+	mu      sync.RWMutex
+	entries map[string]entry
+}
+
+
+func NewMemoryCache() cache.Cache {
+	return &memoryCache{entries: make(map[string]entry)}
+}
+
+func (m *memoryCache) Get(ctx context.Context, key string) ([]byte, error) {
+	m.mu.RLock()
+	e, ok := m.entries[key]
+	m.mu.RUnlock()
+	if !ok || e.isExpired(time.Now()) {
+		return nil, nil
+	}
+	return e.value, nil
+}
+
+func (m *memoryCache) Set(ctx context.Context, key string, value []byte, ttl int) error {
+	e := entry{value: value}
+	if ttl > 0 {
+		e.expiresAt = time.Now().Add(time.Duration(ttl) * time.Second)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.evictExpired(time.Now())
+	m.entries[key] = e
+	return nil
+}
+
+func (m *memoryCache) Delete(ctx context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.entries, key)
+	return nil
+}
+
+func (m *memoryCache) DeleteByPattern(ctx context.Context, pattern string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for key := range m.entries {
+		if matched, _ := path.Match(pattern, key); matched {
+			delete(m.entries, key)
+		}
+	}
+	return nil
+}
+
+func (m *memoryCache) Increment(ctx context.Context, key string, ttl int) (int64, error) {
+	now := time.Now()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	e, ok := m.entries[key]
+	if !ok || e.isExpired(now) {
+		e = entry{}
+		if ttl > 0 {
+			e.expiresAt = now.Add(time.Duration(ttl) * time.Second)
+		}
+	}
+
+	count, _ := strconv.ParseInt(string(e.value), 10, 64)
+	count++
+	e.value = []byte(strconv.FormatInt(count, 10))
+	m.entries[key] = e
+	return count, nil
+}
+
+func (m *memoryCache) Exists(ctx context.Context, key string) (bool, error) {
+	value, err := m.Get(ctx, key)
+	return value != nil, err
+}
+
+func (m *memoryCache) evictExpired(now time.Time) {
+	for key, e := range m.entries {
+		if e.isExpired(now) {
+			delete(m.entries, key)
+		}
+	}
+}
+
+func (e entry) isExpired(now time.Time) bool {
+	return !e.expiresAt.IsZero() && !now.Before(e.expiresAt)
+}
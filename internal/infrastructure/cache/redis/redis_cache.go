@@ -47,6 +47,19 @@ func (r *redisCache) DeleteByPattern(ctx context.Context, pattern string) error
 	return iter.Err()
 }
 
+func (r *redisCache) Increment(ctx context.Context, key string, ttl int) (int64, error) {
+	var incr *redis.IntCmd
+	_, err := r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		incr = pipe.Incr(ctx, key)
+		pipe.ExpireNX(ctx, key, time.Duration(ttl)*time.Second)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return incr.Val(), nil
+}
+
 func (r *redisCache) Exists(ctx context.Context, key string) (bool, error) {
 	count, err := r.client.Exists(ctx, key).Result()
 	if err != nil {
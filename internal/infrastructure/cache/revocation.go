@@ -0,0 +1,68 @@
+package cache
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	cachePort "soccer-manager-api/internal/ports/cache"
+	"soccer-manager-api/pkg/jwt"
+)
+
+
+type RevocationStore struct {
+	cache    cachePort.Cache
+	tokenTTL time.Duration
+}
+
+
+func NewRevocationStore(c cachePort.Cache, tokenTTL time.Duration) *RevocationStore {
+	return &RevocationStore{cache: c, tokenTTL: tokenTTL}
+}
+
+
+func (s *RevocationStore) RevokeToken(ctx context.Context, tokenID string) error {
+	return s.cache.Set(ctx, CacheKey("revoked:token", tokenID), []byte("1"), ttlSeconds(s.tokenTTL))
+}
+
+
+func (s *RevocationStore) RevokeSession(ctx context.Context, sessionID string) error {
+	return s.cache.Set(ctx, CacheKey("revoked:session", sessionID), []byte("1"), ttlSeconds(s.tokenTTL))
+}
+
+
+func (s *RevocationStore) RevokeUserTokens(ctx context.Context, userID string, before time.Time) error {
+	cutoff := []byte(strconv.FormatInt(before.Unix(), 10))
+	return s.cache.Set(ctx, CacheKey("revoked:user", userID), cutoff, ttlSeconds(s.tokenTTL))
+}
+
+
+func (s *RevocationStore) IsRevoked(ctx context.Context, claims *jwt.Claims) (bool, error) {
+	revoked, err := s.cache.Exists(ctx, CacheKey("revoked:token", claims.ID))
+	if err != nil || revoked {
+		return revoked, err
+	}
+
+	revoked, err = s.cache.Exists(ctx, CacheKey("revoked:session", claims.SessionID.String()))
+	if err != nil || revoked {
+		return revoked, err
+	}
+
+	cutoff, err := s.cache.Get(ctx, CacheKey("revoked:user", claims.UserID.String()))
+	if err != nil || cutoff == nil {
+		return false, err
+	}
+	before, err := strconv.ParseInt(string(cutoff), 10, 64)
+	if err != nil {
+		return false, err
+	}
+	return claims.IssuedAt == nil || claims.IssuedAt.Unix() < before, nil
+}
+
+func ttlSeconds(ttl time.Duration) int {
+	seconds := int(ttl / time.Second)
+	if ttl%time.Second != 0 {
+		seconds++
+	}
+	return seconds
+}
@@ -0,0 +1,138 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"soccer-manager-api/internal/infrastructure/cache/memory"
+	cachePort "soccer-manager-api/internal/ports/cache"
+	"soccer-manager-api/pkg/jwt"
+
+	gojwt "github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var errCacheDown = errors.New("cache unavailable")
+
+type failingCache struct {
+	cachePort.Cache
+}
+
+func (failingCache) Get(ctx context.Context, key string) ([]byte, error) {
+	return nil, errCacheDown
+}
+
+func (failingCache) Exists(ctx context.Context, key string) (bool, error) {
+	return false, errCacheDown
+}
+
+func newClaims(issuedAt time.Time) *jwt.Claims {
+	return &jwt.Claims{
+		UserID:    uuid.New(),
+		SessionID: uuid.New(),
+		RegisteredClaims: gojwt.RegisteredClaims{
+			ID:       uuid.NewString(),
+			IssuedAt: gojwt.NewNumericDate(issuedAt),
+		},
+	}
+}
+
+func TestRevocationStore(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	tests := []struct {
+		name   string
+		claims *jwt.Claims
+		revoke func(s *RevocationStore, claims *jwt.Claims) error
+		want   bool
+	}{
+		{
+			name:   "nothing revoked",
+			claims: newClaims(now),
+			revoke: func(s *RevocationStore, claims *jwt.Claims) error { return nil },
+			want:   false,
+		},
+		{
+			name:   "token revoked",
+			claims: newClaims(now),
+			revoke: func(s *RevocationStore, claims *jwt.Claims) error { return s.RevokeToken(ctx, claims.ID) },
+			want:   true,
+		},
+		{
+			name:   "other token revoked",
+			claims: newClaims(now),
+			revoke: func(s *RevocationStore, claims *jwt.Claims) error { return s.RevokeToken(ctx, uuid.NewString()) },
+			want:   false,
+		},
+		{
+			name:   "session revoked",
+			claims: newClaims(now),
+			revoke: func(s *RevocationStore, claims *jwt.Claims) error {
+				return s.RevokeSession(ctx, claims.SessionID.String())
+			},
+			want: true,
+		},
+		{
+			name:   "issued before user cutoff",
+			claims: newClaims(now.Add(-time.Minute)),
+			revoke: func(s *RevocationStore, claims *jwt.Claims) error {
+				return s.RevokeUserTokens(ctx, claims.UserID.String(), now)
+			},
+			want: true,
+		},
+		{
+			name:   "issued after user cutoff",
+			claims: newClaims(now.Add(time.Minute)),
+			revoke: func(s *RevocationStore, claims *jwt.Claims) error {
+				return s.RevokeUserTokens(ctx, claims.UserID.String(), now)
+			},
+			want: false,
+		},
+		{
+			name:   "missing issued at with user cutoff",
+			claims: &jwt.Claims{UserID: uuid.New(), SessionID: uuid.New()},
+			revoke: func(s *RevocationStore, claims *jwt.Claims) error {
+				return s.RevokeUserTokens(ctx, claims.UserID.String(), now)
+			},
+			want: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := NewRevocationStore(memory.NewMemoryCache(), 15*time.Minute)
+			assert.NoError(t, tt.revoke(store, tt.claims))
+
+			revoked, err := store.IsRevoked(ctx, tt.claims)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, revoked)
+		})
+	}
+}
+
+func TestRevocationStoreReportsCacheErrors(t *testing.T) {
+	store := NewRevocationStore(failingCache{}, 15*time.Minute)
+
+	_, err := store.IsRevoked(context.Background(), newClaims(time.Now()))
+	assert.ErrorIs(t, err, errCacheDown)
+}
+
+func TestTTLSeconds(t *testing.T) {
+	tests := []struct {
+		ttl  time.Duration
+		want int
+	}{
+		{0, 0},
+		{time.Second, 1},
+		{1500 * time.Millisecond, 2},
+		{15 * time.Minute, 900},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, ttlSeconds(tt.ttl), "ttl %s", tt.ttl)
+	}
+}
@@ -1,24 +1,41 @@
 package config
 
 import (
+	"errors"
 	"fmt"
+	"net"
 	"os"
 	"strconv"
+	"strings"
 )
 
 
+const DefaultJWTSecret = "your-secret-key-change-in-production"
+
+
+var ErrDefaultJWTSecret = errors.New("JWT_SECRET must be changed or JWT_KEYS_DIR set when ENVIRONMENT=production")
+
+
+var ErrMemoryRevocationStore = errors.New("JWT_REVOCATION_STORE=memory is only allowed when ENVIRONMENT is development or test")
+
+
 type Config struct {
 	Server   ServerConfig
 	Database DatabaseConfig
 	Redis    RedisConfig
 	JWT      JWTConfig
 	App      AppConfig
+	Admin    AdminConfig
+	Jobs     JobsConfig
+	Mail     MailConfig
+	Login    LoginConfig
 }
 
 
 type ServerConfig struct {
-	Port string
-	Host string
+	Port           string
+	Host           string
+	TrustedProxies []string
 }
 
 
@@ -52,21 +69,69 @@ func (r RedisConfig) Address() string {
 
 
 type JWTConfig struct {
-	Secret          string
-	ExpirationHours int
+	Secret             string
+	AccessTokenMinutes int
+	RefreshTokenDays   int
+	SessionMaxDays     int
+	RevocationStore    string
+	KeysDir            string
+	SigningKeyID       string
 }
 
 
 type AppConfig struct {
-	Environment string
+	Environment       string
+	BaseURL           string
+	DeletionGraceDays int
+}
+
+
+type AdminConfig struct {
+	APIKey string
+}
+
+
+type MailConfig struct {
+	Driver       string
+	From         string
+	FilePath     string
+	SMTPHost     string
+	SMTPPort     string
+	SMTPUsername string
+	SMTPPassword string
+}
+
+
+type LoginConfig struct {
+	MaxAttempts      int
+	MaxAttemptsPerIP int
+	LockoutMinutes   int
+}
+
+
+type JobsConfig struct {
+	SeasonRolloverIntervalHours  int
+	AcademyIntakeIntervalHours   int
+	TrainingIntervalHours        int
+	RecoveryIntervalHours        int
+	PayrollIntervalHours         int
+	ContractExpiryIntervalHours  int
+	SponsorshipIntervalHours     int
+	ConstructionIntervalHours    int
+	BotIntervalHours             int
+	ScoutingIntervalHours        int
+	AccountDeletionIntervalHours int
+	MatchdayIntervalHours        int
+	MoraleRecoveryIntervalHours  int
 }
 
 
 func Load() (*Config, error) {
 	cfg := &Config{
 		Server: ServerConfig{
-			Port: getEnv("SERVER_PORT", "8080"),
-			Host: getEnv("SERVER_HOST", "0.0.0.0"),
+			Port:           getEnv("SERVER_PORT", "8080"),
+			Host:           getEnv("SERVER_HOST", "0.0.0.0"),
+			TrustedProxies: getEnvAsList("TRUSTED_PROXIES"),
 		},
 		Database: DatabaseConfig{
 			Host:     getEnv("DB_HOST", "localhost"),
@@ -83,12 +148,67 @@ func Load() (*Config, error) {
 			DB:       getEnvAsInt("REDIS_DB", 0),
 		},
 		JWT: JWTConfig{
-			Secret:          getEnv("JWT_SECRET", "your-secret-key-change-in-production"),
-			ExpirationHours: getEnvAsInt("JWT_EXPIRATION_HOURS", 24),
+			Secret:             getEnv("JWT_SECRET", DefaultJWTSecret),
+			AccessTokenMinutes: getEnvAsInt("JWT_ACCESS_TOKEN_MINUTES", 15),
+			RefreshTokenDays:   getEnvAsInt("JWT_REFRESH_TOKEN_DAYS", 30),
+			SessionMaxDays:     getEnvAsInt("JWT_SESSION_MAX_DAYS", 90),
+			RevocationStore:    getEnv("JWT_REVOCATION_STORE", "redis"),
+			KeysDir:            getEnv("JWT_KEYS_DIR", ""),
+			SigningKeyID:       getEnv("JWT_SIGNING_KEY_ID", ""),
 		},
 		App: AppConfig{
-			Environment: getEnv("ENVIRONMENT", "development"),
+			Environment:       getEnv("ENVIRONMENT", "development"),
+			BaseURL:           getEnv("APP_BASE_URL", "http://localhost:8080"),
+			DeletionGraceDays: getEnvAsInt("ACCOUNT_DELETION_GRACE_DAYS", 14),
+		},
+		Admin: AdminConfig{
+			APIKey: getEnv("ADMIN_API_KEY", ""),
+		},
+		Mail: MailConfig{
+			Driver:       getEnv("MAIL_DRIVER", "file"),
+			From:         getEnv("MAIL_FROM", "Soccer Manager <no-reply@soccer-manager.local>"),
+			FilePath:     getEnv("MAIL_FILE_PATH", ""),
+			SMTPHost:     getEnv("SMTP_HOST", "localhost"),
+			SMTPPort:     getEnv("SMTP_PORT", "587"),
+			SMTPUsername: getEnv("SMTP_USERNAME", ""),
+			SMTPPassword: getEnv("SMTP_PASSWORD", ""),
+		},
+		Login: LoginConfig{
+			MaxAttempts:      getEnvAsInt("LOGIN_MAX_ATTEMPTS", 5),
+			MaxAttemptsPerIP: getEnvAsInt("LOGIN_MAX_ATTEMPTS_PER_IP", 20),
+			LockoutMinutes:   getEnvAsInt("LOGIN_LOCKOUT_MINUTES", 15),
 		},
+		Jobs: JobsConfig{
+			SeasonRolloverIntervalHours:  getEnvAsInt("SEASON_ROLLOVER_INTERVAL_HOURS", 0),
+			AcademyIntakeIntervalHours:   getEnvAsInt("ACADEMY_INTAKE_INTERVAL_HOURS", 168),
+			TrainingIntervalHours:        getEnvAsInt("TRAINING_INTERVAL_HOURS", 24),
+			RecoveryIntervalHours:        getEnvAsInt("RECOVERY_INTERVAL_HOURS", 1),
+			PayrollIntervalHours:         getEnvAsInt("PAYROLL_INTERVAL_HOURS", 168),
+			ContractExpiryIntervalHours:  getEnvAsInt("CONTRACT_EXPIRY_INTERVAL_HOURS", 24),
+			SponsorshipIntervalHours:     getEnvAsInt("SPONSORSHIP_INTERVAL_HOURS", 24),
+			ConstructionIntervalHours:    getEnvAsInt("CONSTRUCTION_INTERVAL_HOURS", 1),
+			BotIntervalHours:             getEnvAsInt("BOT_INTERVAL_HOURS", 6),
+			ScoutingIntervalHours:        getEnvAsInt("SCOUTING_INTERVAL_HOURS", 24),
+			AccountDeletionIntervalHours: getEnvAsInt("ACCOUNT_DELETION_INTERVAL_HOURS", 24),
+			MatchdayIntervalHours:        getEnvAsInt("MATCHDAY_INTERVAL_HOURS", 1),
+			MoraleRecoveryIntervalHours:  getEnvAsInt("MORALE_RECOVERY_INTERVAL_HOURS", 24),
+		},
+	}
+
+
+	if cfg.App.Environment == "production" && cfg.JWT.KeysDir == "" && cfg.JWT.Secret == DefaultJWTSecret {
+		return nil, ErrDefaultJWTSecret
+	}
+	if cfg.JWT.RevocationStore == "memory" && cfg.App.Environment != "development" && cfg.App.Environment != "test" {
+		return nil, ErrMemoryRevocationStore
+	}
+
+	for _, proxy := range cfg.Server.TrustedProxies {
+		if net.ParseIP(proxy) == nil {
+			if _, _, err := net.ParseCIDR(proxy); err != nil {
+				return nil, fmt.Errorf("invalid TRUSTED_PROXIES entry %q", proxy)
+			}
+		}
 	}
 
 	return cfg, nil
@@ -101,6 +221,16 @@ func getEnv(key, defaultValue string) string {
 	return defaultValue
 }
 
+func getEnvAsList(key string) []string {
+	var values []string
+	for _, value := range strings.Split(os.Getenv(key), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
 func getEnvAsInt(key string, defaultValue int) int {
 	if value := os.Getenv(key); value != "" {
 		if intValue, err := strconv.Atoi(value); err == nil {
@@ -0,0 +1,43 @@
+package file
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"soccer-manager-api/internal/ports/mailer"
+	"soccer-manager-api/pkg/logger"
+
+	"go.uber.org/zap"
+)
+
+type fileMailer struct {
+	mu   sync.Mutex
+	path string
+}
+
+
+func NewFileMailer(path string) mailer.Mailer {
+	return &fileMailer{path: path}
+}
+
+func (m *fileMailer) Send(ctx context.Context, msg mailer.Message) error {
+	logger.Logger.Info("Email sent", zap.String("to", msg.To), zap.String("subject", msg.Subject), zap.String("body", msg.Body))
+	if m.path == "" {
+		return nil
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	f, err := os.OpenFile(m.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = fmt.Fprintf(f, "To: %s\nSubject: %s\nDate: %s\n\n%s\n\n", msg.To, msg.Subject, time.Now().Format(time.RFC1123Z), msg.Body)
+	return err
+}
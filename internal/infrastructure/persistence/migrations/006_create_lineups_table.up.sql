@@ -0,0 +1,15 @@
+CREATE TABLE lineups (
+    team_id UUID PRIMARY KEY REFERENCES teams(id) ON DELETE CASCADE,
+    formation VARCHAR(10) NOT NULL CHECK (formation IN ('4-4-2', '4-3-3', '3-5-2', '4-5-1', '5-3-2', '3-4-3', '5-4-1')),
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE lineup_players (
+    team_id UUID NOT NULL REFERENCES lineups(team_id) ON DELETE CASCADE,
+    player_id UUID NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    role VARCHAR(20) NOT NULL CHECK (role IN ('starter', 'substitute')),
+    slot INT NOT NULL,
+    PRIMARY KEY (team_id, player_id)
+);
+
+CREATE INDEX idx_lineup_players_player_id ON lineup_players(player_id);
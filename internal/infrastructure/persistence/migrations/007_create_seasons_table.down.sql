@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_players_retired_at;
+ALTER TABLE players DROP COLUMN IF EXISTS retired_at;
+DROP TABLE IF EXISTS season_player_snapshots;
+DROP TABLE IF EXISTS seasons;
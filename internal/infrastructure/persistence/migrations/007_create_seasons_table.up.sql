@@ -0,0 +1,28 @@
+CREATE TABLE seasons (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    number INT UNIQUE NOT NULL,
+    status VARCHAR(50) NOT NULL DEFAULT 'active' CHECK (status IN ('active', 'completed')),
+    started_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    ended_at TIMESTAMP
+);
+
+CREATE UNIQUE INDEX idx_seasons_single_active ON seasons(status) WHERE status = 'active';
+
+INSERT INTO seasons (number, status) VALUES (1, 'active');
+
+CREATE TABLE season_player_snapshots (
+    season_id UUID NOT NULL REFERENCES seasons(id) ON DELETE CASCADE,
+    player_id UUID NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    team_id UUID REFERENCES teams(id) ON DELETE SET NULL,
+    age INT NOT NULL,
+    position VARCHAR(50) NOT NULL,
+    market_value DECIMAL(15,2) NOT NULL,
+    archived_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (season_id, player_id)
+);
+
+CREATE INDEX idx_season_player_snapshots_player_id ON season_player_snapshots(player_id);
+
+ALTER TABLE players ADD COLUMN retired_at TIMESTAMP;
+
+CREATE INDEX idx_players_retired_at ON players(retired_at);
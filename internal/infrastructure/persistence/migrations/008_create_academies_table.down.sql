@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS youth_prospects;
+DROP TABLE IF EXISTS academies;
+
+ALTER TABLE players DROP COLUMN IF EXISTS potential;
+
+ALTER TABLE players DROP CONSTRAINT IF EXISTS players_age_check;
+ALTER TABLE players ADD CONSTRAINT players_age_check CHECK (age BETWEEN 18 AND 40);
@@ -0,0 +1,29 @@
+ALTER TABLE players DROP CONSTRAINT IF EXISTS players_age_check;
+ALTER TABLE players ADD CONSTRAINT players_age_check CHECK (age BETWEEN 16 AND 40);
+
+ALTER TABLE players ADD COLUMN potential INT NOT NULL DEFAULT 60 CHECK (potential BETWEEN 1 AND 100);
+
+UPDATE players SET potential = 40 + floor(random() * 41)::int;
+
+CREATE TABLE academies (
+    team_id UUID PRIMARY KEY REFERENCES teams(id) ON DELETE CASCADE,
+    level INT NOT NULL DEFAULT 1 CHECK (level BETWEEN 1 AND 5),
+    last_intake_at TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+INSERT INTO academies (team_id) SELECT id FROM teams;
+
+CREATE TABLE youth_prospects (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    team_id UUID NOT NULL REFERENCES academies(team_id) ON DELETE CASCADE,
+    first_name VARCHAR(255) NOT NULL,
+    last_name VARCHAR(255) NOT NULL,
+    country VARCHAR(255) NOT NULL,
+    age INT NOT NULL CHECK (age BETWEEN 16 AND 19),
+    position VARCHAR(50) NOT NULL CHECK (position IN ('goalkeeper', 'defender', 'midfielder', 'attacker')),
+    potential INT NOT NULL CHECK (potential BETWEEN 1 AND 100),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_youth_prospects_team_id ON youth_prospects(team_id);
@@ -0,0 +1,9 @@
+DROP TABLE IF EXISTS training_sessions;
+DROP TABLE IF EXISTS training_assignments;
+
+ALTER TABLE players
+    DROP COLUMN IF EXISTS finishing,
+    DROP COLUMN IF EXISTS passing,
+    DROP COLUMN IF EXISTS defending,
+    DROP COLUMN IF EXISTS goalkeeping,
+    DROP COLUMN IF EXISTS fitness;
@@ -0,0 +1,42 @@
+ALTER TABLE players
+    ADD COLUMN finishing INT NOT NULL DEFAULT 50 CHECK (finishing BETWEEN 1 AND 100),
+    ADD COLUMN passing INT NOT NULL DEFAULT 50 CHECK (passing BETWEEN 1 AND 100),
+    ADD COLUMN defending INT NOT NULL DEFAULT 50 CHECK (defending BETWEEN 1 AND 100),
+    ADD COLUMN goalkeeping INT NOT NULL DEFAULT 50 CHECK (goalkeeping BETWEEN 1 AND 100),
+    ADD COLUMN fitness INT NOT NULL DEFAULT 50 CHECK (fitness BETWEEN 1 AND 100);
+
+UPDATE players SET
+    finishing = 40 + floor(random() * 26)::int + CASE WHEN position = 'attacker' THEN 15 ELSE 0 END,
+    passing = 40 + floor(random() * 26)::int + CASE WHEN position = 'midfielder' THEN 15 ELSE 0 END,
+    defending = 40 + floor(random() * 26)::int + CASE WHEN position = 'defender' THEN 15 ELSE 0 END,
+    goalkeeping = 40 + floor(random() * 26)::int + CASE WHEN position = 'goalkeeper' THEN 15 ELSE 0 END,
+    fitness = 40 + floor(random() * 26)::int,
+    potential = LEAST(100, 55 + floor(random() * 26)::int);
+
+CREATE TABLE training_assignments (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    player_id UUID REFERENCES players(id) ON DELETE CASCADE,
+    position VARCHAR(50) CHECK (position IN ('goalkeeper', 'defender', 'midfielder', 'attacker')),
+    focus VARCHAR(50) NOT NULL CHECK (focus IN ('finishing', 'passing', 'defending', 'goalkeeping', 'fitness')),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CHECK ((player_id IS NULL) <> (position IS NULL))
+);
+
+CREATE UNIQUE INDEX idx_training_assignments_team_player ON training_assignments(team_id, player_id) WHERE player_id IS NOT NULL;
+CREATE UNIQUE INDEX idx_training_assignments_team_position ON training_assignments(team_id, position) WHERE position IS NOT NULL;
+
+CREATE TABLE training_sessions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    player_id UUID NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    focus VARCHAR(50) NOT NULL,
+    previous_value INT NOT NULL,
+    new_value INT NOT NULL,
+    previous_rating INT NOT NULL,
+    new_rating INT NOT NULL,
+    market_value DECIMAL(15,2) NOT NULL,
+    trained_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_training_sessions_player_id ON training_sessions(player_id, trained_at DESC);
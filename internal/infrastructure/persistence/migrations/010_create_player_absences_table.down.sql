@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS player_absences;
+
+DROP INDEX IF EXISTS idx_players_availability;
+
+ALTER TABLE players
+    DROP COLUMN IF EXISTS availability,
+    DROP COLUMN IF EXISTS unavailable_until,
+    DROP COLUMN IF EXISTS unavailable_matches;
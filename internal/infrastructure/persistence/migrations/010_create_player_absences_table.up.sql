@@ -0,0 +1,21 @@
+ALTER TABLE players
+    ADD COLUMN availability VARCHAR(50) NOT NULL DEFAULT 'available' CHECK (availability IN ('available', 'injured', 'suspended')),
+    ADD COLUMN unavailable_until TIMESTAMP,
+    ADD COLUMN unavailable_matches INT NOT NULL DEFAULT 0 CHECK (unavailable_matches >= 0);
+
+CREATE INDEX idx_players_availability ON players(availability) WHERE availability <> 'available';
+
+CREATE TABLE player_absences (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    player_id UUID NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    team_id UUID REFERENCES teams(id) ON DELETE SET NULL,
+    type VARCHAR(50) NOT NULL CHECK (type IN ('injury', 'suspension')),
+    description VARCHAR(255) NOT NULL DEFAULT '',
+    days INT NOT NULL DEFAULT 0,
+    matches INT NOT NULL DEFAULT 0,
+    started_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expected_return TIMESTAMP,
+    ended_at TIMESTAMP
+);
+
+CREATE INDEX idx_player_absences_player_id ON player_absences(player_id, started_at DESC);
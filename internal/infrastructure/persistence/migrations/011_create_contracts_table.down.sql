@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS payroll_payments;
+DROP TABLE IF EXISTS contracts;
@@ -0,0 +1,30 @@
+CREATE TABLE contracts (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    player_id UUID NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    weekly_wage DECIMAL(15,2) NOT NULL CHECK (weekly_wage >= 0),
+    status VARCHAR(50) NOT NULL DEFAULT 'active' CHECK (status IN ('active', 'expired', 'terminated')),
+    started_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMP NOT NULL,
+    ended_at TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX idx_contracts_active_player ON contracts(player_id) WHERE status = 'active';
+CREATE INDEX idx_contracts_team_id ON contracts(team_id) WHERE status = 'active';
+CREATE INDEX idx_contracts_expires_at ON contracts(expires_at) WHERE status = 'active';
+
+INSERT INTO contracts (player_id, team_id, weekly_wage, expires_at)
+SELECT id, team_id, GREATEST(500, ROUND(market_value * 0.001)), CURRENT_TIMESTAMP + (1 + floor(random() * 5)::int) * INTERVAL '1 year'
+FROM players
+WHERE team_id IS NOT NULL AND retired_at IS NULL;
+
+CREATE TABLE payroll_payments (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    amount DECIMAL(15,2) NOT NULL,
+    players INT NOT NULL,
+    paid_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_payroll_payments_team_id ON payroll_payments(team_id, paid_at DESC);
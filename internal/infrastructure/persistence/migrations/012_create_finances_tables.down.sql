@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS sponsorship_deals;
+DROP TABLE IF EXISTS sponsorship_offers;
+DROP TABLE IF EXISTS matches;
+DROP TABLE IF EXISTS finance_transactions;
@@ -0,0 +1,61 @@
+CREATE TABLE finance_transactions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    category VARCHAR(50) NOT NULL CHECK (category IN ('sponsorship', 'gate_receipts', 'prize_money', 'wages')),
+    amount DECIMAL(15,2) NOT NULL,
+    description VARCHAR(255) NOT NULL DEFAULT '',
+    reference_id UUID,
+    balance_after DECIMAL(15,2) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_finance_transactions_team_id ON finance_transactions(team_id, created_at DESC);
+
+CREATE TABLE matches (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    home_team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    away_team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    competition VARCHAR(50) NOT NULL CHECK (competition IN ('league', 'cup', 'friendly')),
+    status VARCHAR(50) NOT NULL DEFAULT 'scheduled' CHECK (status IN ('scheduled', 'played')),
+    scheduled_at TIMESTAMP NOT NULL,
+    home_goals INT NOT NULL DEFAULT 0,
+    away_goals INT NOT NULL DEFAULT 0,
+    attendance INT NOT NULL DEFAULT 0,
+    gate_receipts DECIMAL(15,2) NOT NULL DEFAULT 0,
+    played_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CHECK (home_team_id <> away_team_id)
+);
+
+CREATE INDEX idx_matches_home_team_id ON matches(home_team_id, scheduled_at);
+CREATE INDEX idx_matches_away_team_id ON matches(away_team_id, scheduled_at);
+
+CREATE TABLE sponsorship_offers (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    sponsor_name VARCHAR(255) NOT NULL,
+    schedule VARCHAR(50) NOT NULL CHECK (schedule IN ('upfront', 'weekly', 'per_match')),
+    amount DECIMAL(15,2) NOT NULL CHECK (amount > 0),
+    payments INT NOT NULL CHECK (payments > 0),
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_sponsorship_offers_team_id ON sponsorship_offers(team_id);
+
+CREATE TABLE sponsorship_deals (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    sponsor_name VARCHAR(255) NOT NULL,
+    schedule VARCHAR(50) NOT NULL CHECK (schedule IN ('upfront', 'weekly', 'per_match')),
+    amount DECIMAL(15,2) NOT NULL,
+    payments_total INT NOT NULL,
+    payments_made INT NOT NULL DEFAULT 0,
+    status VARCHAR(50) NOT NULL DEFAULT 'active' CHECK (status IN ('active', 'completed')),
+    next_payment_at TIMESTAMP,
+    signed_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX idx_sponsorship_deals_active_team ON sponsorship_deals(team_id) WHERE status = 'active';
+CREATE INDEX idx_sponsorship_deals_next_payment ON sponsorship_deals(next_payment_at) WHERE status = 'active';
@@ -0,0 +1,7 @@
+DELETE FROM finance_transactions WHERE category = 'construction';
+ALTER TABLE IF EXISTS finance_transactions DROP CONSTRAINT IF EXISTS finance_transactions_category_check;
+ALTER TABLE IF EXISTS finance_transactions ADD CONSTRAINT finance_transactions_category_check
+    CHECK (category IN ('sponsorship', 'gate_receipts', 'prize_money', 'wages'));
+
+DROP TABLE IF EXISTS construction_projects;
+DROP TABLE IF EXISTS team_facilities;
@@ -0,0 +1,30 @@
+CREATE TABLE team_facilities (
+    team_id UUID PRIMARY KEY REFERENCES teams(id) ON DELETE CASCADE,
+    stadium_level INT NOT NULL DEFAULT 1 CHECK (stadium_level BETWEEN 1 AND 5),
+    ticket_price DECIMAL(10,2) NOT NULL DEFAULT 25.00 CHECK (ticket_price BETWEEN 5 AND 200),
+    training_ground_level INT NOT NULL DEFAULT 1 CHECK (training_ground_level BETWEEN 1 AND 5),
+    medical_centre_level INT NOT NULL DEFAULT 1 CHECK (medical_centre_level BETWEEN 1 AND 5),
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+INSERT INTO team_facilities (team_id)
+SELECT id FROM teams;
+
+CREATE TABLE construction_projects (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    facility VARCHAR(50) NOT NULL CHECK (facility IN ('stadium', 'training_ground', 'medical_centre', 'academy')),
+    target_level INT NOT NULL CHECK (target_level BETWEEN 2 AND 5),
+    cost DECIMAL(15,2) NOT NULL,
+    status VARCHAR(50) NOT NULL DEFAULT 'in_progress' CHECK (status IN ('in_progress', 'completed')),
+    started_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    completes_at TIMESTAMP NOT NULL,
+    completed_at TIMESTAMP
+);
+
+CREATE UNIQUE INDEX idx_construction_projects_in_progress ON construction_projects(team_id, facility) WHERE status = 'in_progress';
+CREATE INDEX idx_construction_projects_completes_at ON construction_projects(completes_at) WHERE status = 'in_progress';
+
+ALTER TABLE finance_transactions DROP CONSTRAINT IF EXISTS finance_transactions_category_check;
+ALTER TABLE finance_transactions ADD CONSTRAINT finance_transactions_category_check
+    CHECK (category IN ('sponsorship', 'gate_receipts', 'prize_money', 'wages', 'construction'));
@@ -0,0 +1,21 @@
+CREATE TABLE player_match_stats (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    match_id UUID NOT NULL REFERENCES matches(id) ON DELETE CASCADE,
+    player_id UUID NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    season_id UUID REFERENCES seasons(id) ON DELETE SET NULL,
+    competition VARCHAR(50) NOT NULL CHECK (competition IN ('league', 'cup', 'friendly')),
+    started BOOLEAN NOT NULL DEFAULT TRUE,
+    goals INT NOT NULL DEFAULT 0 CHECK (goals >= 0),
+    assists INT NOT NULL DEFAULT 0 CHECK (assists >= 0),
+    clean_sheet BOOLEAN NOT NULL DEFAULT FALSE,
+    yellow_cards INT NOT NULL DEFAULT 0 CHECK (yellow_cards BETWEEN 0 AND 2),
+    red_cards INT NOT NULL DEFAULT 0 CHECK (red_cards BETWEEN 0 AND 1),
+    rating DECIMAL(3,1) NOT NULL CHECK (rating BETWEEN 1 AND 10),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (match_id, player_id)
+);
+
+CREATE INDEX idx_player_match_stats_player_id ON player_match_stats(player_id, created_at DESC);
+CREATE INDEX idx_player_match_stats_team_id ON player_match_stats(team_id, season_id);
+CREATE INDEX idx_player_match_stats_season_id ON player_match_stats(season_id, competition);
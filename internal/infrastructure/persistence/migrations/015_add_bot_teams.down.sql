@@ -0,0 +1,5 @@
+DELETE FROM users WHERE id IN (SELECT user_id FROM teams WHERE is_bot);
+
+DROP INDEX IF EXISTS idx_teams_is_bot;
+
+ALTER TABLE teams DROP COLUMN IF EXISTS is_bot;
@@ -0,0 +1,3 @@
+ALTER TABLE teams ADD COLUMN is_bot BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE INDEX idx_teams_is_bot ON teams(is_bot) WHERE is_bot;
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS morale_events;
+
+ALTER TABLE players
+    DROP COLUMN IF EXISTS morale,
+    DROP COLUMN IF EXISTS joined_at;
@@ -0,0 +1,23 @@
+ALTER TABLE players
+    ADD COLUMN morale INT NOT NULL DEFAULT 70 CHECK (morale BETWEEN 0 AND 100),
+    ADD COLUMN joined_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP;
+
+UPDATE players p
+SET joined_at = COALESCE(
+    (SELECT MAX(t.transferred_at) FROM transfers t WHERE t.player_id = p.id),
+    p.created_at
+);
+
+CREATE TABLE morale_events (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    player_id UUID NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    team_id UUID REFERENCES teams(id) ON DELETE SET NULL,
+    driver VARCHAR(50) NOT NULL CHECK (driver IN ('playing_time', 'results', 'transfers', 'wages')),
+    delta INT NOT NULL,
+    morale_after INT NOT NULL,
+    description VARCHAR(255) NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_morale_events_team_id ON morale_events(team_id, created_at DESC);
+CREATE INDEX idx_morale_events_player_id ON morale_events(player_id, created_at DESC);
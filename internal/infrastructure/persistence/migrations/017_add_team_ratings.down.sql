@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS rating_history;
+
+DROP INDEX IF EXISTS idx_teams_rating;
+
+ALTER TABLE teams DROP COLUMN IF EXISTS rating;
@@ -0,0 +1,16 @@
+ALTER TABLE teams ADD COLUMN rating INT NOT NULL DEFAULT 1500;
+
+CREATE INDEX idx_teams_rating ON teams(rating DESC);
+
+CREATE TABLE rating_history (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    match_id UUID NOT NULL REFERENCES matches(id) ON DELETE CASCADE,
+    opponent_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    rating_before INT NOT NULL,
+    rating_after INT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (team_id, match_id)
+);
+
+CREATE INDEX idx_rating_history_team_id ON rating_history(team_id, created_at DESC);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS league_members;
+
+DROP TABLE IF EXISTS leagues;
@@ -0,0 +1,20 @@
+CREATE TABLE leagues (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name VARCHAR(255) NOT NULL,
+    invite_code VARCHAR(16) NOT NULL UNIQUE,
+    commissioner_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    transfer_budget_cap DECIMAL(15,2) CHECK (transfer_budget_cap > 0),
+    max_squad_value DECIMAL(15,2) CHECK (max_squad_value > 0),
+    league_only_transfers BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE league_members (
+    league_id UUID NOT NULL REFERENCES leagues(id) ON DELETE CASCADE,
+    team_id UUID NOT NULL UNIQUE REFERENCES teams(id) ON DELETE CASCADE,
+    joined_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (league_id, team_id)
+);
+
+CREATE INDEX idx_leagues_commissioner_id ON leagues(commissioner_id);
@@ -0,0 +1,7 @@
+DELETE FROM teams WHERE NOT is_default;
+
+DROP INDEX IF EXISTS idx_teams_user_default;
+
+ALTER TABLE teams DROP COLUMN IF EXISTS is_default;
+
+ALTER TABLE teams ADD CONSTRAINT teams_user_id_key UNIQUE (user_id);
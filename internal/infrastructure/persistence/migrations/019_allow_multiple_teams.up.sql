@@ -0,0 +1,7 @@
+ALTER TABLE teams DROP CONSTRAINT IF EXISTS teams_user_id_key;
+
+ALTER TABLE teams ADD COLUMN is_default BOOLEAN NOT NULL DEFAULT FALSE;
+
+UPDATE teams SET is_default = TRUE;
+
+CREATE UNIQUE INDEX idx_teams_user_default ON teams(user_id) WHERE is_default;
@@ -0,0 +1,8 @@
+DELETE FROM players WHERE id IN (SELECT player_id FROM draft_pool);
+
+DROP TABLE IF EXISTS draft_pool;
+
+DROP INDEX IF EXISTS idx_teams_name_lower;
+
+ALTER TABLE teams DROP COLUMN IF EXISTS crest_secondary;
+ALTER TABLE teams DROP COLUMN IF EXISTS crest_primary;
@@ -0,0 +1,16 @@
+ALTER TABLE teams ADD COLUMN crest_primary VARCHAR(7) NOT NULL DEFAULT '#FFFFFF';
+ALTER TABLE teams ADD COLUMN crest_secondary VARCHAR(7) NOT NULL DEFAULT '#000000';
+
+UPDATE teams SET name = name || ' ' || LEFT(id::text, 8)
+WHERE id NOT IN (
+    SELECT DISTINCT ON (LOWER(name)) id FROM teams ORDER BY LOWER(name), created_at, id
+);
+
+CREATE UNIQUE INDEX idx_teams_name_lower ON teams (LOWER(name));
+
+CREATE TABLE draft_pool (
+    team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    player_id UUID NOT NULL UNIQUE REFERENCES players(id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (team_id, player_id)
+);
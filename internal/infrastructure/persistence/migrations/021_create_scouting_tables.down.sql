@@ -0,0 +1,7 @@
+DELETE FROM finance_transactions WHERE category = 'scouting';
+ALTER TABLE IF EXISTS finance_transactions DROP CONSTRAINT IF EXISTS finance_transactions_category_check;
+ALTER TABLE IF EXISTS finance_transactions ADD CONSTRAINT finance_transactions_category_check
+    CHECK (category IN ('sponsorship', 'gate_receipts', 'prize_money', 'wages', 'construction'));
+
+DROP TABLE IF EXISTS scout_reports;
+DROP TABLE IF EXISTS scouts;
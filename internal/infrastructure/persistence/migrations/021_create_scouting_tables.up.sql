@@ -0,0 +1,25 @@
+CREATE TABLE scouts (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    skill INTEGER NOT NULL CHECK (skill BETWEEN 1 AND 5),
+    player_id UUID REFERENCES players(id) ON DELETE SET NULL,
+    region VARCHAR(100),
+    assigned_at TIMESTAMP,
+    hired_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CHECK (player_id IS NULL OR region IS NULL)
+);
+
+CREATE TABLE scout_reports (
+    team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    player_id UUID NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    accuracy INTEGER NOT NULL DEFAULT 0 CHECK (accuracy BETWEEN 0 AND 100),
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (team_id, player_id)
+);
+
+CREATE INDEX idx_scouts_team_id ON scouts(team_id);
+
+ALTER TABLE finance_transactions DROP CONSTRAINT IF EXISTS finance_transactions_category_check;
+ALTER TABLE finance_transactions ADD CONSTRAINT finance_transactions_category_check
+    CHECK (category IN ('sponsorship', 'gate_receipts', 'prize_money', 'wages', 'construction', 'scouting'));
@@ -0,0 +1,14 @@
+DELETE FROM transfers WHERE seller_team_id IS NULL OR buyer_team_id IS NULL;
+
+ALTER TABLE transfers DROP CONSTRAINT IF EXISTS transfers_seller_team_id_fkey;
+ALTER TABLE transfers DROP CONSTRAINT IF EXISTS transfers_buyer_team_id_fkey;
+
+ALTER TABLE transfers ALTER COLUMN seller_team_id SET NOT NULL;
+ALTER TABLE transfers ALTER COLUMN buyer_team_id SET NOT NULL;
+
+ALTER TABLE transfers ADD CONSTRAINT transfers_seller_team_id_fkey FOREIGN KEY (seller_team_id) REFERENCES teams(id) ON DELETE CASCADE;
+ALTER TABLE transfers ADD CONSTRAINT transfers_buyer_team_id_fkey FOREIGN KEY (buyer_team_id) REFERENCES teams(id) ON DELETE CASCADE;
+
+DROP INDEX IF EXISTS idx_users_deletion_scheduled_at;
+
+ALTER TABLE users DROP COLUMN IF EXISTS deletion_scheduled_at;
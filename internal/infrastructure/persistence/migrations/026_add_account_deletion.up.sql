@@ -0,0 +1,12 @@
+ALTER TABLE users ADD COLUMN deletion_scheduled_at TIMESTAMP;
+
+CREATE INDEX idx_users_deletion_scheduled_at ON users(deletion_scheduled_at) WHERE deletion_scheduled_at IS NOT NULL;
+
+ALTER TABLE transfers DROP CONSTRAINT IF EXISTS transfers_seller_team_id_fkey;
+ALTER TABLE transfers DROP CONSTRAINT IF EXISTS transfers_buyer_team_id_fkey;
+
+ALTER TABLE transfers ALTER COLUMN seller_team_id DROP NOT NULL;
+ALTER TABLE transfers ALTER COLUMN buyer_team_id DROP NOT NULL;
+
+ALTER TABLE transfers ADD CONSTRAINT transfers_seller_team_id_fkey FOREIGN KEY (seller_team_id) REFERENCES teams(id) ON DELETE SET NULL;
+ALTER TABLE transfers ADD CONSTRAINT transfers_buyer_team_id_fkey FOREIGN KEY (buyer_team_id) REFERENCES teams(id) ON DELETE SET NULL;
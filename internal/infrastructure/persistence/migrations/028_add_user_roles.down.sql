@@ -0,0 +1,11 @@
+DELETE FROM finance_transactions WHERE category IN ('adjustment', 'transfer_reversal');
+ALTER TABLE IF EXISTS finance_transactions DROP CONSTRAINT IF EXISTS finance_transactions_category_check;
+ALTER TABLE IF EXISTS finance_transactions ADD CONSTRAINT finance_transactions_category_check
+    CHECK (category IN ('sponsorship', 'gate_receipts', 'prize_money', 'wages', 'construction', 'scouting'));
+
+ALTER TABLE transfers DROP COLUMN IF EXISTS reversed_at;
+
+ALTER TABLE audit_log DROP COLUMN IF EXISTS actor_id;
+
+DROP INDEX IF EXISTS idx_users_role;
+ALTER TABLE users DROP COLUMN IF EXISTS role;
@@ -0,0 +1,12 @@
+ALTER TABLE users ADD COLUMN role VARCHAR(20) NOT NULL DEFAULT 'manager'
+    CHECK (role IN ('manager', 'moderator', 'admin'));
+
+CREATE INDEX idx_users_role ON users(role) WHERE role <> 'manager';
+
+ALTER TABLE audit_log ADD COLUMN actor_id UUID REFERENCES users(id) ON DELETE SET NULL;
+
+ALTER TABLE transfers ADD COLUMN reversed_at TIMESTAMP;
+
+ALTER TABLE finance_transactions DROP CONSTRAINT IF EXISTS finance_transactions_category_check;
+ALTER TABLE finance_transactions ADD CONSTRAINT finance_transactions_category_check
+    CHECK (category IN ('sponsorship', 'gate_receipts', 'prize_money', 'wages', 'construction', 'scouting', 'adjustment', 'transfer_reversal'));
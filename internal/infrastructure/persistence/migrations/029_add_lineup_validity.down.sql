@@ -0,0 +1 @@
+ALTER TABLE lineups DROP COLUMN IF EXISTS is_valid;
@@ -0,0 +1 @@
+ALTER TABLE lineups ADD COLUMN is_valid BOOLEAN NOT NULL DEFAULT TRUE;
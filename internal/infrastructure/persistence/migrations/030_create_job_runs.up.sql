@@ -0,0 +1,4 @@
+CREATE TABLE job_runs (
+    name VARCHAR(50) PRIMARY KEY,
+    last_run_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
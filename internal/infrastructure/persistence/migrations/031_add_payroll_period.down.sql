@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_payroll_payments_team_period;
+ALTER TABLE payroll_payments DROP COLUMN IF EXISTS period;
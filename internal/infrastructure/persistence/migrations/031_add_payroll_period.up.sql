@@ -0,0 +1,15 @@
+ALTER TABLE payroll_payments ADD COLUMN period VARCHAR(20);
+
+WITH numbered AS (
+    SELECT id, to_char(paid_at, 'IYYY-"W"IW') AS week,
+        ROW_NUMBER() OVER (PARTITION BY team_id, to_char(paid_at, 'IYYY-"W"IW') ORDER BY paid_at) AS n
+    FROM payroll_payments
+)
+UPDATE payroll_payments pp
+SET period = CASE WHEN numbered.n = 1 THEN numbered.week ELSE numbered.week || '-' || numbered.n END
+FROM numbered
+WHERE pp.id = numbered.id;
+
+ALTER TABLE payroll_payments ALTER COLUMN period SET NOT NULL;
+
+CREATE UNIQUE INDEX idx_payroll_payments_team_period ON payroll_payments(team_id, period);
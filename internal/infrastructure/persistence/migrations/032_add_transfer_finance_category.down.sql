@@ -0,0 +1,4 @@
+DELETE FROM finance_transactions WHERE category = 'transfer';
+ALTER TABLE IF EXISTS finance_transactions DROP CONSTRAINT IF EXISTS finance_transactions_category_check;
+ALTER TABLE IF EXISTS finance_transactions ADD CONSTRAINT finance_transactions_category_check
+    CHECK (category IN ('sponsorship', 'gate_receipts', 'prize_money', 'wages', 'construction', 'scouting', 'adjustment', 'transfer_reversal'));
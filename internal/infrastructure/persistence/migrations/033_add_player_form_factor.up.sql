@@ -0,0 +1 @@
+ALTER TABLE players ADD COLUMN form_factor DOUBLE PRECISION NOT NULL DEFAULT 1 CHECK (form_factor > 0);
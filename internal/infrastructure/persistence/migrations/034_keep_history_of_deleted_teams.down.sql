@@ -0,0 +1,18 @@
+DELETE FROM matches WHERE home_team_id IS NULL OR away_team_id IS NULL;
+DELETE FROM player_match_stats WHERE team_id IS NULL;
+DELETE FROM rating_history WHERE opponent_id IS NULL;
+
+ALTER TABLE matches DROP CONSTRAINT IF EXISTS matches_home_team_id_fkey;
+ALTER TABLE matches DROP CONSTRAINT IF EXISTS matches_away_team_id_fkey;
+ALTER TABLE player_match_stats DROP CONSTRAINT IF EXISTS player_match_stats_team_id_fkey;
+ALTER TABLE rating_history DROP CONSTRAINT IF EXISTS rating_history_opponent_id_fkey;
+
+ALTER TABLE matches ALTER COLUMN home_team_id SET NOT NULL;
+ALTER TABLE matches ALTER COLUMN away_team_id SET NOT NULL;
+ALTER TABLE player_match_stats ALTER COLUMN team_id SET NOT NULL;
+ALTER TABLE rating_history ALTER COLUMN opponent_id SET NOT NULL;
+
+ALTER TABLE matches ADD CONSTRAINT matches_home_team_id_fkey FOREIGN KEY (home_team_id) REFERENCES teams(id) ON DELETE CASCADE;
+ALTER TABLE matches ADD CONSTRAINT matches_away_team_id_fkey FOREIGN KEY (away_team_id) REFERENCES teams(id) ON DELETE CASCADE;
+ALTER TABLE player_match_stats ADD CONSTRAINT player_match_stats_team_id_fkey FOREIGN KEY (team_id) REFERENCES teams(id) ON DELETE CASCADE;
+ALTER TABLE rating_history ADD CONSTRAINT rating_history_opponent_id_fkey FOREIGN KEY (opponent_id) REFERENCES teams(id) ON DELETE CASCADE;
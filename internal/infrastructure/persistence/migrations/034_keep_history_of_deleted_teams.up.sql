@@ -0,0 +1,14 @@
+ALTER TABLE matches DROP CONSTRAINT IF EXISTS matches_home_team_id_fkey;
+ALTER TABLE matches DROP CONSTRAINT IF EXISTS matches_away_team_id_fkey;
+ALTER TABLE player_match_stats DROP CONSTRAINT IF EXISTS player_match_stats_team_id_fkey;
+ALTER TABLE rating_history DROP CONSTRAINT IF EXISTS rating_history_opponent_id_fkey;
+
+ALTER TABLE matches ALTER COLUMN home_team_id DROP NOT NULL;
+ALTER TABLE matches ALTER COLUMN away_team_id DROP NOT NULL;
+ALTER TABLE player_match_stats ALTER COLUMN team_id DROP NOT NULL;
+ALTER TABLE rating_history ALTER COLUMN opponent_id DROP NOT NULL;
+
+ALTER TABLE matches ADD CONSTRAINT matches_home_team_id_fkey FOREIGN KEY (home_team_id) REFERENCES teams(id) ON DELETE SET NULL;
+ALTER TABLE matches ADD CONSTRAINT matches_away_team_id_fkey FOREIGN KEY (away_team_id) REFERENCES teams(id) ON DELETE SET NULL;
+ALTER TABLE player_match_stats ADD CONSTRAINT player_match_stats_team_id_fkey FOREIGN KEY (team_id) REFERENCES teams(id) ON DELETE SET NULL;
+ALTER TABLE rating_history ADD CONSTRAINT rating_history_opponent_id_fkey FOREIGN KEY (opponent_id) REFERENCES teams(id) ON DELETE SET NULL;
@@ -0,0 +1,3 @@
+ALTER TABLE morale_events DROP CONSTRAINT IF EXISTS morale_events_driver_check;
+ALTER TABLE morale_events ADD CONSTRAINT morale_events_driver_check
+    CHECK (driver IN ('playing_time', 'results', 'transfers', 'wages', 'recovery'));
@@ -0,0 +1,5 @@
+ALTER TABLE sessions ADD COLUMN max_expires_at TIMESTAMP;
+
+UPDATE sessions SET max_expires_at = GREATEST(expires_at, created_at + INTERVAL '90 days');
+
+ALTER TABLE sessions ALTER COLUMN max_expires_at SET NOT NULL;
@@ -0,0 +1,13 @@
+DROP INDEX IF EXISTS idx_transfer_listings_active_player;
+
+DELETE FROM transfer_listings tl
+USING transfer_listings newer
+WHERE tl.player_id = newer.player_id AND tl.listed_at < newer.listed_at;
+
+ALTER TABLE transfer_listings ADD CONSTRAINT transfer_listings_player_id_key UNIQUE (player_id);
+
+DELETE FROM audit_log WHERE user_id IS NULL;
+
+ALTER TABLE audit_log DROP CONSTRAINT IF EXISTS audit_log_user_id_fkey;
+ALTER TABLE audit_log ALTER COLUMN user_id SET NOT NULL;
+ALTER TABLE audit_log ADD CONSTRAINT audit_log_user_id_fkey FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
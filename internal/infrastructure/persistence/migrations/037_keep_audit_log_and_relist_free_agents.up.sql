@@ -0,0 +1,7 @@
+ALTER TABLE audit_log DROP CONSTRAINT IF EXISTS audit_log_user_id_fkey;
+ALTER TABLE audit_log ALTER COLUMN user_id DROP NOT NULL;
+ALTER TABLE audit_log ADD CONSTRAINT audit_log_user_id_fkey FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE SET NULL;
+
+ALTER TABLE transfer_listings DROP CONSTRAINT IF EXISTS transfer_listings_player_id_key;
+
+CREATE UNIQUE INDEX idx_transfer_listings_active_player ON transfer_listings(player_id) WHERE status = 'active';
@@ -0,0 +1,3 @@
+INSERT INTO users (id, email, role, password_hash, email_verified_at)
+VALUES ('00000000-0000-0000-0000-000000000001', 'admin-api-key@service.soccer-manager.local', 'admin', '', CURRENT_TIMESTAMP)
+ON CONFLICT (id) DO NOTHING;
@@ -0,0 +1,17 @@
+CREATE TABLE season_player_stats (
+    season_id UUID NOT NULL REFERENCES seasons(id) ON DELETE CASCADE,
+    player_id UUID NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    competition VARCHAR(50) NOT NULL CHECK (competition IN ('league', 'cup', 'friendly')),
+    appearances INT NOT NULL DEFAULT 0,
+    starts INT NOT NULL DEFAULT 0,
+    goals INT NOT NULL DEFAULT 0,
+    assists INT NOT NULL DEFAULT 0,
+    clean_sheets INT NOT NULL DEFAULT 0,
+    yellow_cards INT NOT NULL DEFAULT 0,
+    red_cards INT NOT NULL DEFAULT 0,
+    average_rating DECIMAL(4,2) NOT NULL DEFAULT 0,
+    archived_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (season_id, player_id, competition)
+);
+
+CREATE INDEX idx_season_player_stats_player_id ON season_player_stats(player_id);
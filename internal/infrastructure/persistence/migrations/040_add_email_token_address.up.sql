@@ -0,0 +1,5 @@
+ALTER TABLE email_tokens ADD COLUMN email VARCHAR(255) NOT NULL DEFAULT '';
+
+UPDATE email_tokens t SET email = u.email
+FROM users u
+WHERE u.id = t.user_id AND t.purpose = 'verify_email' AND t.used_at IS NULL;
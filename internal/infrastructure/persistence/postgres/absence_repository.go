@@ -0,0 +1,50 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"soccer-manager-api/internal/domain"
+	"soccer-manager-api/internal/ports/repository"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type absenceRepository struct {
+	db *sqlx.DB
+}
+
+
+func NewAbsenceRepository(db *sqlx.DB) repository.AbsenceRepository {
+	return &absenceRepository{db: db}
+}
+
+func (r *absenceRepository) Create(ctx context.Context, absence *domain.PlayerAbsence) error {
+	query := `
+		INSERT INTO player_absences (id, player_id, team_id, type, description, days, matches, started_at, expected_return, ended_at)
+		VALUES (:id, :player_id, :team_id, :type, :description, :days, :matches, :started_at, :expected_return, :ended_at)
+	`
+	_, err := conn(ctx, r.db).NamedExecContext(ctx, query, absence)
+	return err
+}
+
+func (r *absenceRepository) GetByPlayerID(ctx context.Context, playerID string) ([]*domain.PlayerAbsence, error) {
+	absences := make([]*domain.PlayerAbsence, 0)
+	query := `
+		SELECT id, player_id, team_id, type, description, days, matches, started_at, expected_return, ended_at 
+		FROM player_absences WHERE player_id = $1
+		ORDER BY started_at DESC
+	`
+	err := conn(ctx, r.db).SelectContext(ctx, &absences, query, playerID)
+	return absences, err
+}
+
+func (r *absenceRepository) CloseOpen(ctx context.Context, playerID string, absenceType domain.AbsenceType, endedAt time.Time) error {
+	query := `
+		UPDATE player_absences 
+		SET ended_at = $1
+		WHERE player_id = $2 AND type = $3 AND ended_at IS NULL
+	`
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, endedAt, playerID, absenceType)
+	return err
+}
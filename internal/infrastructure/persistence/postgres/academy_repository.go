@@ -0,0 +1,126 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"soccer-manager-api/internal/domain"
+	"soccer-manager-api/internal/ports/repository"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type academyRepository struct {
+	db *sqlx.DB
+}
+
+
+func NewAcademyRepository(db *sqlx.DB) repository.AcademyRepository {
+	return &academyRepository{db: db}
+}
+
+func (r *academyRepository) GetByTeamID(ctx context.Context, teamID string) (*domain.Academy, error) {
+	var academy domain.Academy
+	query := `SELECT team_id, level, last_intake_at, updated_at FROM academies WHERE team_id = $1`
+	err := conn(ctx, r.db).GetContext(ctx, &academy, query, teamID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrAcademyNotFound
+		}
+		return nil, err
+	}
+	return &academy, nil
+}
+
+func (r *academyRepository) Save(ctx context.Context, academy *domain.Academy) error {
+	query := `
+		INSERT INTO academies (team_id, level, last_intake_at, updated_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (team_id) DO UPDATE 
+		SET level = EXCLUDED.level, last_intake_at = EXCLUDED.last_intake_at, updated_at = EXCLUDED.updated_at
+	`
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, academy.TeamID, academy.Level, academy.LastIntakeAt, academy.UpdatedAt)
+	return err
+}
+
+func (r *academyRepository) UpdateLevel(ctx context.Context, academy *domain.Academy) error {
+	query := `UPDATE academies SET level = $1, updated_at = $2 WHERE team_id = $3`
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, academy.Level, academy.UpdatedAt, academy.TeamID)
+	return err
+}
+
+func (r *academyRepository) CreateProspects(ctx context.Context, prospects []*domain.YouthProspect) error {
+	if len(prospects) == 0 {
+		return nil
+	}
+
+	tx, err := begin(ctx, r.db)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO youth_prospects (id, team_id, first_name, last_name, country, age, position, potential, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+
+	stmt, err := tx.PreparexContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	for _, prospect := range prospects {
+		_, err := stmt.ExecContext(ctx,
+			prospect.ID,
+			prospect.TeamID,
+			prospect.FirstName,
+			prospect.LastName,
+			prospect.Country,
+			prospect.Age,
+			prospect.Position,
+			prospect.Potential,
+			prospect.CreatedAt,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *academyRepository) GetProspectByID(ctx context.Context, id string) (*domain.YouthProspect, error) {
+	var prospect domain.YouthProspect
+	query := `
+		SELECT id, team_id, first_name, last_name, country, age, position, potential, created_at 
+		FROM youth_prospects WHERE id = $1
+	`
+	err := conn(ctx, r.db).GetContext(ctx, &prospect, query, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrProspectNotFound
+		}
+		return nil, err
+	}
+	return &prospect, nil
+}
+
+func (r *academyRepository) GetProspectsByTeamID(ctx context.Context, teamID string) ([]*domain.YouthProspect, error) {
+	prospects := make([]*domain.YouthProspect, 0)
+	query := `
+		SELECT id, team_id, first_name, last_name, country, age, position, potential, created_at 
+		FROM youth_prospects WHERE team_id = $1
+		ORDER BY potential DESC, created_at
+	`
+	err := conn(ctx, r.db).SelectContext(ctx, &prospects, query, teamID)
+	return prospects, err
+}
+
+func (r *academyRepository) DeleteProspect(ctx context.Context, id string) error {
+	query := `DELETE FROM youth_prospects WHERE id = $1`
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, id)
+	return requireRows(result, err, domain.ErrProspectNotFound)
+}
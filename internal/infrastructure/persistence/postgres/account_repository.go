@@ -0,0 +1,153 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"soccer-manager-api/internal/domain"
+	"soccer-manager-api/internal/ports/repository"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+const userTeams = `SELECT id FROM teams WHERE user_id = $1`
+
+var exportSections = []struct {
+	name  string
+	query string
+}{
+	{"teams", `SELECT * FROM teams WHERE user_id = $1`},
+	{"players", `SELECT * FROM players WHERE team_id IN (` + userTeams + `)`},
+	{"contracts", `SELECT * FROM contracts WHERE team_id IN (` + userTeams + `)`},
+	{"payroll_payments", `SELECT * FROM payroll_payments WHERE team_id IN (` + userTeams + `)`},
+	{"finance_transactions", `SELECT * FROM finance_transactions WHERE team_id IN (` + userTeams + `)`},
+	{"sponsorship_offers", `SELECT * FROM sponsorship_offers WHERE team_id IN (` + userTeams + `)`},
+	{"sponsorship_deals", `SELECT * FROM sponsorship_deals WHERE team_id IN (` + userTeams + `)`},
+	{"facilities", `SELECT * FROM team_facilities WHERE team_id IN (` + userTeams + `)`},
+	{"construction_projects", `SELECT * FROM construction_projects WHERE team_id IN (` + userTeams + `)`},
+	{"academies", `SELECT * FROM academies WHERE team_id IN (` + userTeams + `)`},
+	{"youth_prospects", `SELECT * FROM youth_prospects WHERE team_id IN (` + userTeams + `)`},
+	{"training_assignments", `SELECT * FROM training_assignments WHERE team_id IN (` + userTeams + `)`},
+	{"training_sessions", `SELECT * FROM training_sessions WHERE team_id IN (` + userTeams + `)`},
+	{"lineups", `SELECT * FROM lineups WHERE team_id IN (` + userTeams + `)`},
+	{"lineup_players", `SELECT * FROM lineup_players WHERE team_id IN (` + userTeams + `)`},
+	{"player_absences", `SELECT * FROM player_absences WHERE team_id IN (` + userTeams + `)`},
+	{"morale_events", `SELECT * FROM morale_events WHERE team_id IN (` + userTeams + `)`},
+	{"rating_history", `SELECT * FROM rating_history WHERE team_id IN (` + userTeams + `)`},
+	{"draft_pool", `SELECT * FROM draft_pool WHERE team_id IN (` + userTeams + `)`},
+	{"scouts", `SELECT * FROM scouts WHERE team_id IN (` + userTeams + `)`},
+	{"scout_reports", `SELECT * FROM scout_reports WHERE team_id IN (` + userTeams + `)`},
+	{"player_match_stats", `SELECT * FROM player_match_stats WHERE team_id IN (` + userTeams + `)`},
+	{"matches", `SELECT * FROM matches WHERE home_team_id IN (` + userTeams + `) OR away_team_id IN (` + userTeams + `)`},
+	{"transfer_listings", `SELECT tl.* FROM transfer_listings tl JOIN players p ON p.id = tl.player_id WHERE p.team_id IN (` + userTeams + `)`},
+	{"transfers", `SELECT * FROM transfers WHERE seller_team_id IN (` + userTeams + `) OR buyer_team_id IN (` + userTeams + `)`},
+	{"league_memberships", `SELECT * FROM league_members WHERE team_id IN (` + userTeams + `)`},
+	{"leagues", `SELECT ` + leagueColumns + ` FROM leagues WHERE commissioner_id = $1`},
+	{"sessions", `SELECT id, user_agent, ip_address, created_at, last_used_at, expires_at, revoked_at FROM sessions WHERE user_id = $1`},
+	{"audit_log", `SELECT id, action, details, ip_address, user_agent, created_at FROM audit_log WHERE user_id = $1`},
+}
+
+type accountRepository struct {
+	db *sqlx.DB
+}
+
+
+func NewAccountRepository(db *sqlx.DB) repository.AccountRepository {
+	return &accountRepository{db: db}
+}
+
+func (r *accountRepository) Export(ctx context.Context, userID string) (*domain.AccountExport, error) {
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, domain.ErrUserNotFound
+	}
+
+	tx, err := r.db.BeginTxx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	export := domain.NewAccountExport(id)
+
+	var account json.RawMessage
+	query := `
+		SELECT row_to_json(u) FROM (
+			SELECT id, email, email_verified_at, banned_at, deletion_scheduled_at, totp_enabled_at, created_at, updated_at
+			FROM users WHERE id = $1
+		) u
+	`
+	if err := tx.GetContext(ctx, &account, query, userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrUserNotFound
+		}
+		return nil, err
+	}
+	export.Data["account"] = account
+
+	for _, section := range exportSections {
+		var rows json.RawMessage
+		query := `SELECT COALESCE(json_agg(s), '[]'::json) FROM (` + section.query + `) s`
+		if err := tx.GetContext(ctx, &rows, query, userID); err != nil {
+			return nil, err
+		}
+		export.Data[section.name] = rows
+	}
+
+	return export, nil
+}
+
+func (r *accountRepository) Purge(ctx context.Context, userID string) error {
+	tx, err := begin(ctx, r.db)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		UPDATE transfer_listings SET status = $2
+		WHERE status = $3 AND player_id IN (SELECT id FROM players WHERE team_id IN (` + userTeams + `))
+	`
+	if _, err := tx.ExecContext(ctx, query, userID, domain.TransferStatusCancelled, domain.TransferStatusActive); err != nil {
+		return err
+	}
+
+	query = `
+		DELETE FROM matches 
+		WHERE status = 'scheduled' AND (home_team_id IN (` + userTeams + `) OR away_team_id IN (` + userTeams + `))
+	`
+	if _, err := tx.ExecContext(ctx, query, userID); err != nil {
+		return err
+	}
+
+	query = `
+		INSERT INTO transfer_listings (player_id, asking_price, status, listed_at)
+		SELECT id, market_value, $2, $3 FROM players WHERE team_id IN (` + userTeams + `)
+	`
+	if _, err := tx.ExecContext(ctx, query, userID, domain.TransferStatusActive, time.Now()); err != nil {
+		return err
+	}
+
+	query = `UPDATE players SET team_id = NULL, updated_at = $2 WHERE team_id IN (` + userTeams + `)`
+	if _, err := tx.ExecContext(ctx, query, userID, time.Now()); err != nil {
+		return err
+	}
+
+	result, err := tx.ExecContext(ctx, `DELETE FROM users WHERE id = $1`, userID)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return domain.ErrUserNotFound
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,28 @@
+package postgres
+
+import (
+	"context"
+
+	"soccer-manager-api/internal/domain"
+	"soccer-manager-api/internal/ports/repository"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type auditRepository struct {
+	db *sqlx.DB
+}
+
+
+func NewAuditRepository(db *sqlx.DB) repository.AuditRepository {
+	return &auditRepository{db: db}
+}
+
+func (r *auditRepository) Create(ctx context.Context, entry *domain.AuditEntry) error {
+	query := `
+		INSERT INTO audit_log (id, user_id, actor_id, action, details, ip_address, user_agent, created_at)
+		VALUES (:id, :user_id, :actor_id, :action, :details, :ip_address, :user_agent, :created_at)
+	`
+	_, err := conn(ctx, r.db).NamedExecContext(ctx, query, entry)
+	return err
+}
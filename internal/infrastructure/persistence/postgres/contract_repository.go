@@ -0,0 +1,126 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"soccer-manager-api/internal/domain"
+	"soccer-manager-api/internal/ports/repository"
+
+	"github.com/jmoiron/sqlx"
+)
+
+const contractColumns = `id, player_id, team_id, weekly_wage, status, started_at, expires_at, ended_at, updated_at`
+
+const insertContractQuery = `
+	INSERT INTO contracts (` + contractColumns + `)
+	VALUES (:id, :player_id, :team_id, :weekly_wage, :status, :started_at, :expires_at, :ended_at, :updated_at)
+`
+
+type contractRepository struct {
+	db *sqlx.DB
+}
+
+
+func NewContractRepository(db *sqlx.DB) repository.ContractRepository {
+	return &contractRepository{db: db}
+}
+
+func (r *contractRepository) Create(ctx context.Context, contract *domain.Contract) error {
+	_, err := conn(ctx, r.db).NamedExecContext(ctx, insertContractQuery, contract)
+	return err
+}
+
+func (r *contractRepository) CreateBatch(ctx context.Context, contracts []*domain.Contract) error {
+	if len(contracts) == 0 {
+		return nil
+	}
+
+	tx, err := begin(ctx, r.db)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	stmt, err := tx.PrepareNamedContext(ctx, insertContractQuery)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	for _, contract := range contracts {
+		if _, err := stmt.ExecContext(ctx, contract); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *contractRepository) GetActiveByPlayerID(ctx context.Context, playerID string) (*domain.Contract, error) {
+	var contract domain.Contract
+	query := `SELECT ` + contractColumns + ` FROM contracts WHERE player_id = $1 AND status = 'active'`
+	err := conn(ctx, r.db).GetContext(ctx, &contract, query, playerID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrContractNotFound
+		}
+		return nil, err
+	}
+	return &contract, nil
+}
+
+func (r *contractRepository) GetActiveByTeamID(ctx context.Context, teamID string) ([]*domain.Contract, error) {
+	contracts := make([]*domain.Contract, 0)
+	query := `
+		SELECT ` + contractColumns + `
+		FROM contracts WHERE team_id = $1 AND status = 'active'
+		ORDER BY expires_at
+	`
+	err := conn(ctx, r.db).SelectContext(ctx, &contracts, query, teamID)
+	return contracts, err
+}
+
+func (r *contractRepository) GetExpiring(ctx context.Context, before time.Time) ([]*domain.Contract, error) {
+	contracts := make([]*domain.Contract, 0)
+	query := `
+		SELECT ` + contractColumns + `
+		FROM contracts WHERE status = 'active' AND expires_at <= $1
+		ORDER BY expires_at
+	`
+	err := conn(ctx, r.db).SelectContext(ctx, &contracts, query, before)
+	return contracts, err
+}
+
+func (r *contractRepository) Update(ctx context.Context, contract *domain.Contract) error {
+	query := `
+		UPDATE contracts 
+		SET weekly_wage = :weekly_wage, status = :status, expires_at = :expires_at, ended_at = :ended_at, updated_at = :updated_at
+		WHERE id = :id
+	`
+	_, err := conn(ctx, r.db).NamedExecContext(ctx, query, contract)
+	return err
+}
+
+func (r *contractRepository) CreatePayment(ctx context.Context, payment *domain.PayrollPayment) error {
+	query := `
+		INSERT INTO payroll_payments (id, team_id, amount, players, period, paid_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (team_id, period) DO NOTHING
+	`
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, payment.ID, payment.TeamID, payment.Amount, payment.Players, payment.Period, payment.PaidAt)
+	return requireRows(result, err, domain.ErrPayrollAlreadyPaid)
+}
+
+func (r *contractRepository) GetPaymentsByTeamID(ctx context.Context, teamID string) ([]*domain.PayrollPayment, error) {
+	payments := make([]*domain.PayrollPayment, 0)
+	query := `
+		SELECT id, team_id, amount, players, period, paid_at 
+		FROM payroll_payments WHERE team_id = $1
+		ORDER BY paid_at DESC
+	`
+	err := conn(ctx, r.db).SelectContext(ctx, &payments, query, teamID)
+	return payments, err
+}
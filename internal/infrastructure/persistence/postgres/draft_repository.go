@@ -0,0 +1,91 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+
+	"soccer-manager-api/internal/domain"
+	"soccer-manager-api/internal/ports/repository"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type draftRepository struct {
+	db *sqlx.DB
+}
+
+
+func NewDraftRepository(db *sqlx.DB) repository.DraftRepository {
+	return &draftRepository{db: db}
+}
+
+func (r *draftRepository) CreatePool(ctx context.Context, teamID string, players []*domain.Player) error {
+	tx, err := begin(ctx, r.db)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	stmt, err := tx.PrepareNamedContext(ctx, insertPlayerQuery)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	for _, player := range players {
+		if _, err := stmt.ExecContext(ctx, player); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, `INSERT INTO draft_pool (team_id, player_id) VALUES ($1, $2)`, teamID, player.ID); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *draftRepository) GetPool(ctx context.Context, teamID string) ([]*domain.Player, error) {
+	players := make([]*domain.Player, 0)
+	query := `
+		SELECT p.` + strings.Join(playerColumnNames, ", p.") + `
+		FROM draft_pool dp
+		JOIN players p ON p.id = dp.player_id
+		WHERE dp.team_id = $1
+		ORDER BY p.position, p.market_value DESC
+	`
+	err := conn(ctx, r.db).SelectContext(ctx, &players, query, teamID)
+	return players, err
+}
+
+func (r *draftRepository) ClaimPlayers(ctx context.Context, teamID string, players []*domain.Player) error {
+	tx, err := begin(ctx, r.db)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		UPDATE players 
+		SET team_id = $1, joined_at = $2, updated_at = $3
+		WHERE id = $4 AND team_id IS NULL
+	`
+	for _, player := range players {
+		result, err := tx.ExecContext(ctx, `DELETE FROM draft_pool WHERE team_id = $1 AND player_id = $2`, teamID, player.ID)
+		if err := requireRows(result, err, domain.ErrInvalidDraftPick); err != nil {
+			return err
+		}
+
+		result, err = tx.ExecContext(ctx, query, player.TeamID, player.JoinedAt, player.UpdatedAt, player.ID)
+		if err := requireRows(result, err, domain.ErrInvalidDraftPick); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *draftRepository) DeletePool(ctx context.Context, teamID string) error {
+	query := `DELETE FROM players WHERE id IN (SELECT player_id FROM draft_pool WHERE team_id = $1)`
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, teamID)
+	return err
+}
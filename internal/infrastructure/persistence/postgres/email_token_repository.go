@@ -0,0 +1,101 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"soccer-manager-api/internal/domain"
+	"soccer-manager-api/internal/ports/repository"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type emailTokenRepository struct {
+	db *sqlx.DB
+}
+
+
+func NewEmailTokenRepository(db *sqlx.DB) repository.EmailTokenRepository {
+	return &emailTokenRepository{db: db}
+}
+
+func (r *emailTokenRepository) Create(ctx context.Context, token *domain.EmailToken) error {
+	tx, err := begin(ctx, r.db)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	expire := `UPDATE email_tokens SET used_at = $1 WHERE user_id = $2 AND purpose = $3 AND used_at IS NULL`
+	if _, err := tx.ExecContext(ctx, expire, token.CreatedAt, token.UserID, token.Purpose); err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO email_tokens (id, user_id, email, purpose, token_hash, expires_at, used_at, created_at)
+		VALUES (:id, :user_id, :email, :purpose, :token_hash, :expires_at, :used_at, :created_at)
+	`
+	if _, err := tx.NamedExecContext(ctx, query, token); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *emailTokenRepository) GetByHash(ctx context.Context, tokenHash string, purpose domain.EmailTokenPurpose) (*domain.EmailToken, error) {
+	var token domain.EmailToken
+	query := `
+		SELECT id, user_id, email, purpose, token_hash, expires_at, used_at, created_at
+		FROM email_tokens
+		WHERE token_hash = $1 AND purpose = $2
+	`
+	err := conn(ctx, r.db).GetContext(ctx, &token, query, tokenHash, purpose)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrInvalidEmailToken
+		}
+		return nil, err
+	}
+	return &token, nil
+}
+
+func (r *emailTokenRepository) Consume(ctx context.Context, token *domain.EmailToken, user *domain.User) error {
+	tx, err := begin(ctx, r.db)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	now := time.Now()
+	result, err := tx.ExecContext(ctx, `UPDATE email_tokens SET used_at = $1 WHERE id = $2 AND used_at IS NULL`, now, token.ID)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return domain.ErrInvalidEmailToken
+	}
+	token.UsedAt = &now
+
+	query := `
+		UPDATE users
+		SET password_hash = $1, email_verified_at = $2, updated_at = $3
+		WHERE id = $4
+	`
+	if _, err := tx.ExecContext(ctx, query, user.PasswordHash, user.EmailVerifiedAt, user.UpdatedAt, user.ID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *emailTokenRepository) ExpireByUserID(ctx context.Context, userID string) error {
+	query := `UPDATE email_tokens SET used_at = $1 WHERE user_id = $2 AND used_at IS NULL`
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, time.Now(), userID)
+	return err
+}
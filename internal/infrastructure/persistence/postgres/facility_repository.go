@@ -0,0 +1,119 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"soccer-manager-api/internal/domain"
+	"soccer-manager-api/internal/ports/repository"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+var facilityLevelColumns = map[domain.FacilityType]string{
+	domain.FacilityStadium:        "stadium_level",
+	domain.FacilityTrainingGround: "training_ground_level",
+	domain.FacilityMedicalCentre:  "medical_centre_level",
+}
+
+const constructionProjectColumns = `id, team_id, facility, target_level, cost, status, started_at, completes_at, completed_at`
+
+type facilityRepository struct {
+	db *sqlx.DB
+}
+
+
+func NewFacilityRepository(db *sqlx.DB) repository.FacilityRepository {
+	return &facilityRepository{db: db}
+}
+
+func (r *facilityRepository) GetByTeamID(ctx context.Context, teamID string) (*domain.Facilities, error) {
+	var facilities domain.Facilities
+	query := `
+		SELECT team_id, stadium_level, ticket_price, training_ground_level, medical_centre_level, updated_at 
+		FROM team_facilities WHERE team_id = $1
+	`
+	err := conn(ctx, r.db).GetContext(ctx, &facilities, query, teamID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrFacilitiesNotFound
+		}
+		return nil, err
+	}
+	return &facilities, nil
+}
+
+func (r *facilityRepository) Create(ctx context.Context, facilities *domain.Facilities) error {
+	query := `
+		INSERT INTO team_facilities (team_id, stadium_level, ticket_price, training_ground_level, medical_centre_level, updated_at)
+		VALUES (:team_id, :stadium_level, :ticket_price, :training_ground_level, :medical_centre_level, :updated_at)
+		ON CONFLICT (team_id) DO NOTHING
+	`
+	_, err := conn(ctx, r.db).NamedExecContext(ctx, query, facilities)
+	return err
+}
+
+func (r *facilityRepository) UpdateLevel(ctx context.Context, facilities *domain.Facilities, facility domain.FacilityType) error {
+	column, ok := facilityLevelColumns[facility]
+	if !ok {
+		return domain.ErrInvalidFacility
+	}
+	query := `UPDATE team_facilities SET ` + column + ` = $1, updated_at = $2 WHERE team_id = $3`
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, facilities.Level(facility), facilities.UpdatedAt, facilities.TeamID)
+	return err
+}
+
+func (r *facilityRepository) UpdateTicketPrice(ctx context.Context, facilities *domain.Facilities) error {
+	query := `UPDATE team_facilities SET ticket_price = $1, updated_at = $2 WHERE team_id = $3`
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, facilities.TicketPrice, facilities.UpdatedAt, facilities.TeamID)
+	return err
+}
+
+func (r *facilityRepository) CreateProject(ctx context.Context, project *domain.ConstructionProject) error {
+	query := `
+		INSERT INTO construction_projects (` + constructionProjectColumns + `)
+		VALUES (:id, :team_id, :facility, :target_level, :cost, :status, :started_at, :completes_at, :completed_at)
+	`
+	_, err := conn(ctx, r.db).NamedExecContext(ctx, query, project)
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23505" && pqErr.Constraint == "idx_construction_projects_in_progress" {
+		return domain.ErrConstructionInProgress
+	}
+	return err
+}
+
+func (r *facilityRepository) UpdateProject(ctx context.Context, project *domain.ConstructionProject) error {
+	query := `
+		UPDATE construction_projects 
+		SET status = :status, completed_at = :completed_at
+		WHERE id = :id AND status = 'in_progress'
+	`
+	result, err := conn(ctx, r.db).NamedExecContext(ctx, query, project)
+	return requireRows(result, err, domain.ErrConstructionNotInProgress)
+}
+
+func (r *facilityRepository) GetProjectsByTeamID(ctx context.Context, teamID string) ([]*domain.ConstructionProject, error) {
+	projects := make([]*domain.ConstructionProject, 0)
+	query := `
+		SELECT ` + constructionProjectColumns + `
+		FROM construction_projects WHERE team_id = $1
+		ORDER BY started_at DESC
+	`
+	err := conn(ctx, r.db).SelectContext(ctx, &projects, query, teamID)
+	return projects, err
+}
+
+func (r *facilityRepository) GetDueProjects(ctx context.Context, now time.Time) ([]*domain.ConstructionProject, error) {
+	projects := make([]*domain.ConstructionProject, 0)
+	query := `
+		SELECT ` + constructionProjectColumns + `
+		FROM construction_projects 
+		WHERE status = 'in_progress' AND completes_at <= $1
+		ORDER BY completes_at
+	`
+	err := conn(ctx, r.db).SelectContext(ctx, &projects, query, now)
+	return projects, err
+}
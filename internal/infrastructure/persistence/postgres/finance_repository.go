@@ -0,0 +1,68 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"soccer-manager-api/internal/domain"
+	"soccer-manager-api/internal/ports/repository"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type financeRepository struct {
+	db *sqlx.DB
+}
+
+
+func NewFinanceRepository(db *sqlx.DB) repository.FinanceRepository {
+	return &financeRepository{db: db}
+}
+
+func (r *financeRepository) ApplyTransaction(ctx context.Context, transaction *domain.FinanceTransaction) error {
+	tx, err := begin(ctx, r.db)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+
+	query := `
+		UPDATE teams 
+		SET budget = budget + $1, updated_at = $2
+		WHERE id = $3 AND ($4 OR budget + $1 >= 0)
+		RETURNING budget
+	`
+	err = tx.GetContext(ctx, &transaction.BalanceAfter, query, transaction.Amount, transaction.CreatedAt, transaction.TeamID, transaction.Category.AllowsOverdraft())
+	if errors.Is(err, sql.ErrNoRows) {
+		if transaction.Amount < 0 {
+			return domain.ErrInsufficientBudget
+		}
+		return domain.ErrTeamNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	query = `
+		INSERT INTO finance_transactions (id, team_id, category, amount, description, reference_id, balance_after, created_at)
+		VALUES (:id, :team_id, :category, :amount, :description, :reference_id, :balance_after, :created_at)
+	`
+	if _, err := tx.NamedExecContext(ctx, query, transaction); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *financeRepository) GetTransactionsByTeamID(ctx context.Context, teamID string) ([]*domain.FinanceTransaction, error) {
+	transactions := make([]*domain.FinanceTransaction, 0)
+	query := `
+		SELECT id, team_id, category, amount, description, reference_id, balance_after, created_at 
+		FROM finance_transactions WHERE team_id = $1
+		ORDER BY created_at DESC
+	`
+	err := conn(ctx, r.db).SelectContext(ctx, &transactions, query, teamID)
+	return transactions, err
+}
@@ -0,0 +1,34 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"soccer-manager-api/internal/ports/repository"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type jobRunRepository struct {
+	db *sqlx.DB
+}
+
+
+func NewJobRunRepository(db *sqlx.DB) repository.JobRunRepository {
+	return &jobRunRepository{db: db}
+}
+
+func (r *jobRunRepository) Claim(ctx context.Context, name string, interval time.Duration) (bool, error) {
+	query := `
+		INSERT INTO job_runs (name, last_run_at)
+		VALUES ($1, CURRENT_TIMESTAMP)
+		ON CONFLICT (name) DO UPDATE SET last_run_at = EXCLUDED.last_run_at
+		WHERE job_runs.last_run_at <= CURRENT_TIMESTAMP - make_interval(secs => $2)
+	`
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, name, interval.Seconds())
+	if err != nil {
+		return false, err
+	}
+	rows, err := result.RowsAffected()
+	return rows == 1, err
+}
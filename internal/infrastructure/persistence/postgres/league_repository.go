@@ -0,0 +1,105 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+
+	"soccer-manager-api/internal/domain"
+	"soccer-manager-api/internal/ports/repository"
+
+	"github.com/jmoiron/sqlx"
+)
+
+const leagueColumns = `id, name, invite_code, commissioner_id, transfer_budget_cap, max_squad_value, league_only_transfers, created_at, updated_at`
+
+type leagueRepository struct {
+	db *sqlx.DB
+}
+
+
+func NewLeagueRepository(db *sqlx.DB) repository.LeagueRepository {
+	return &leagueRepository{db: db}
+}
+
+func (r *leagueRepository) Create(ctx context.Context, league *domain.League) error {
+	query := `
+		INSERT INTO leagues (` + leagueColumns + `)
+		VALUES (:id, :name, :invite_code, :commissioner_id, :transfer_budget_cap, :max_squad_value, :league_only_transfers, :created_at, :updated_at)
+	`
+	_, err := conn(ctx, r.db).NamedExecContext(ctx, query, league)
+	return err
+}
+
+func (r *leagueRepository) GetByID(ctx context.Context, id string) (*domain.League, error) {
+	query := `SELECT ` + leagueColumns + ` FROM leagues WHERE id = $1`
+	return r.get(ctx, query, id)
+}
+
+func (r *leagueRepository) GetByInviteCode(ctx context.Context, code string) (*domain.League, error) {
+	query := `SELECT ` + leagueColumns + ` FROM leagues WHERE invite_code = $1`
+	return r.get(ctx, query, strings.ToUpper(code))
+}
+
+func (r *leagueRepository) GetByTeamID(ctx context.Context, teamID string) (*domain.League, error) {
+	query := `
+		SELECT ` + leagueColumns + `
+		FROM leagues
+		WHERE id = (SELECT league_id FROM league_members WHERE team_id = $1)
+	`
+	return r.get(ctx, query, teamID)
+}
+
+func (r *leagueRepository) Update(ctx context.Context, league *domain.League) error {
+	query := `
+		UPDATE leagues
+		SET name = :name, invite_code = :invite_code, transfer_budget_cap = :transfer_budget_cap,
+			max_squad_value = :max_squad_value, league_only_transfers = :league_only_transfers, updated_at = :updated_at
+		WHERE id = :id
+	`
+	_, err := conn(ctx, r.db).NamedExecContext(ctx, query, league)
+	return err
+}
+
+func (r *leagueRepository) Delete(ctx context.Context, id string) error {
+	query := `DELETE FROM leagues WHERE id = $1`
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, id)
+	return err
+}
+
+func (r *leagueRepository) AddMember(ctx context.Context, leagueID, teamID string) error {
+	query := `INSERT INTO league_members (league_id, team_id) VALUES ($1, $2)`
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, leagueID, teamID)
+	return err
+}
+
+func (r *leagueRepository) RemoveMember(ctx context.Context, leagueID, teamID string) error {
+	query := `DELETE FROM league_members WHERE league_id = $1 AND team_id = $2`
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, leagueID, teamID)
+	return err
+}
+
+func (r *leagueRepository) GetMembers(ctx context.Context, leagueID string) ([]*domain.Team, error) {
+	teams := make([]*domain.Team, 0)
+	query := `
+		SELECT ` + teamColumns + `
+		FROM teams
+		WHERE id IN (SELECT team_id FROM league_members WHERE league_id = $1)
+		ORDER BY rating DESC, name
+	`
+	err := conn(ctx, r.db).SelectContext(ctx, &teams, query, leagueID)
+	return teams, err
+}
+
+func (r *leagueRepository) get(ctx context.Context, query string, arg string) (*domain.League, error) {
+	var league domain.League
+	err := conn(ctx, r.db).GetContext(ctx, &league, query, arg)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrLeagueNotFound
+		}
+		return nil, err
+	}
+	return &league, nil
+}
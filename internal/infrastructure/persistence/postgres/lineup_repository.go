@@ -0,0 +1,109 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"soccer-manager-api/internal/domain"
+	"soccer-manager-api/internal/ports/repository"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+type lineupRepository struct {
+	db *sqlx.DB
+}
+
+
+func NewLineupRepository(db *sqlx.DB) repository.LineupRepository {
+	return &lineupRepository{db: db}
+}
+
+func (r *lineupRepository) GetByTeamID(ctx context.Context, teamID string) (*domain.Lineup, error) {
+	var lineup domain.Lineup
+	query := `SELECT team_id, formation, is_valid, updated_at FROM lineups WHERE team_id = $1`
+	err := conn(ctx, r.db).GetContext(ctx, &lineup, query, teamID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrLineupNotFound
+		}
+		return nil, err
+	}
+
+	var rows []struct {
+		PlayerID uuid.UUID         `db:"player_id"`
+		Role     domain.LineupRole `db:"role"`
+	}
+	query = `SELECT player_id, role FROM lineup_players WHERE team_id = $1 ORDER BY role, slot`
+	if err := conn(ctx, r.db).SelectContext(ctx, &rows, query, teamID); err != nil {
+		return nil, err
+	}
+
+	lineup.Starters = make([]uuid.UUID, 0, domain.StartingPlayers)
+	lineup.Substitutes = make([]uuid.UUID, 0, domain.MaxSubstitutes)
+	for _, row := range rows {
+		if row.Role == domain.LineupRoleStarter {
+			lineup.Starters = append(lineup.Starters, row.PlayerID)
+		} else {
+			lineup.Substitutes = append(lineup.Substitutes, row.PlayerID)
+		}
+	}
+
+	return &lineup, nil
+}
+
+func (r *lineupRepository) Save(ctx context.Context, lineup *domain.Lineup) error {
+	tx, err := begin(ctx, r.db)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO lineups (team_id, formation, is_valid, updated_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (team_id) DO UPDATE SET formation = EXCLUDED.formation, is_valid = EXCLUDED.is_valid, updated_at = EXCLUDED.updated_at
+	`
+	if _, err := tx.ExecContext(ctx, query, lineup.TeamID, lineup.Formation, lineup.Valid, lineup.UpdatedAt); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM lineup_players WHERE team_id = $1`, lineup.TeamID); err != nil {
+		return err
+	}
+
+	query = `INSERT INTO lineup_players (team_id, player_id, role, slot) VALUES ($1, $2, $3, $4)`
+	stmt, err := tx.PreparexContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	for slot, playerID := range lineup.Starters {
+		if _, err := stmt.ExecContext(ctx, lineup.TeamID, playerID, domain.LineupRoleStarter, slot); err != nil {
+			return err
+		}
+	}
+	for slot, playerID := range lineup.Substitutes {
+		if _, err := stmt.ExecContext(ctx, lineup.TeamID, playerID, domain.LineupRoleSubstitute, slot); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *lineupRepository) RemovePlayer(ctx context.Context, playerID string) error {
+	query := `
+		WITH removed AS (
+			DELETE FROM lineup_players WHERE player_id = $1 RETURNING team_id, role
+		)
+		UPDATE lineups SET is_valid = FALSE, updated_at = $2
+		WHERE team_id IN (SELECT team_id FROM removed WHERE role = 'starter')
+	`
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, playerID, time.Now())
+	return err
+}
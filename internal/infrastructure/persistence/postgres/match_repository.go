@@ -0,0 +1,99 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"soccer-manager-api/internal/domain"
+	"soccer-manager-api/internal/ports/repository"
+
+	"github.com/jmoiron/sqlx"
+)
+
+const matchColumns = `id, home_team_id, away_team_id, competition, status, scheduled_at, home_goals, away_goals, attendance, gate_receipts, played_at, created_at`
+
+type matchRepository struct {
+	db *sqlx.DB
+}
+
+
+func NewMatchRepository(db *sqlx.DB) repository.MatchRepository {
+	return &matchRepository{db: db}
+}
+
+func (r *matchRepository) Create(ctx context.Context, match *domain.Match) error {
+	query := `
+		INSERT INTO matches (` + matchColumns + `)
+		VALUES (:id, :home_team_id, :away_team_id, :competition, :status, :scheduled_at, :home_goals, :away_goals, :attendance, :gate_receipts, :played_at, :created_at)
+	`
+	_, err := conn(ctx, r.db).NamedExecContext(ctx, query, match)
+	return err
+}
+
+func (r *matchRepository) GetByID(ctx context.Context, id string) (*domain.Match, error) {
+	var match domain.Match
+	query := `SELECT ` + matchColumns + ` FROM matches WHERE id = $1`
+	err := conn(ctx, r.db).GetContext(ctx, &match, query, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrMatchNotFound
+		}
+		return nil, err
+	}
+	return &match, nil
+}
+
+func (r *matchRepository) RecordResult(ctx context.Context, match *domain.Match) error {
+	query := `
+		UPDATE matches 
+		SET status = :status, home_goals = :home_goals, away_goals = :away_goals, 
+			attendance = :attendance, gate_receipts = :gate_receipts, played_at = :played_at
+		WHERE id = :id AND status = 'scheduled'
+	`
+	result, err := conn(ctx, r.db).NamedExecContext(ctx, query, match)
+	return requireRows(result, err, domain.ErrMatchAlreadyPlayed)
+}
+
+func (r *matchRepository) GetByTeamID(ctx context.Context, teamID string) ([]*domain.Match, error) {
+	matches := make([]*domain.Match, 0)
+	query := `
+		SELECT ` + matchColumns + `
+		FROM matches 
+		WHERE home_team_id = $1 OR away_team_id = $1
+		ORDER BY scheduled_at DESC
+	`
+	err := conn(ctx, r.db).SelectContext(ctx, &matches, query, teamID)
+	return matches, err
+}
+
+func (r *matchRepository) GetScheduledByTeamID(ctx context.Context, teamID string, until time.Time) ([]*domain.Match, error) {
+	matches := make([]*domain.Match, 0)
+	query := `
+		SELECT ` + matchColumns + `
+		FROM matches 
+		WHERE (home_team_id = $1 OR away_team_id = $1) AND status = 'scheduled' AND scheduled_at <= $2
+		ORDER BY scheduled_at
+	`
+	err := conn(ctx, r.db).SelectContext(ctx, &matches, query, teamID, until)
+	return matches, err
+}
+
+func (r *matchRepository) GetDue(ctx context.Context, now time.Time) ([]*domain.Match, error) {
+	matches := make([]*domain.Match, 0)
+	query := `
+		SELECT ` + matchColumns + `
+		FROM matches 
+		WHERE status = 'scheduled' AND scheduled_at <= $1
+		ORDER BY scheduled_at
+	`
+	err := conn(ctx, r.db).SelectContext(ctx, &matches, query, now)
+	return matches, err
+}
+
+func (r *matchRepository) DeleteScheduledByTeamID(ctx context.Context, teamID string) error {
+	query := `DELETE FROM matches WHERE (home_team_id = $1 OR away_team_id = $1) AND status = 'scheduled'`
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, teamID)
+	return err
+}
@@ -0,0 +1,61 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"soccer-manager-api/internal/domain"
+	"soccer-manager-api/internal/ports/repository"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type moraleRepository struct {
+	db *sqlx.DB
+}
+
+
+func NewMoraleRepository(db *sqlx.DB) repository.MoraleRepository {
+	return &moraleRepository{db: db}
+}
+
+func (r *moraleRepository) CreateBatch(ctx context.Context, events []*domain.MoraleEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	tx, err := begin(ctx, r.db)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	stmt, err := tx.PrepareNamedContext(ctx, `
+		INSERT INTO morale_events (id, player_id, team_id, driver, delta, morale_after, description, created_at)
+		VALUES (:id, :player_id, :team_id, :driver, :delta, :morale_after, :description, :created_at)
+	`)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	for _, event := range events {
+		if _, err := stmt.ExecContext(ctx, event); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *moraleRepository) GetDriverTotals(ctx context.Context, teamID string, since time.Time) ([]*domain.MoraleDriverTotal, error) {
+	totals := make([]*domain.MoraleDriverTotal, 0)
+	query := `
+		SELECT player_id, driver, SUM(delta) AS total
+		FROM morale_events
+		WHERE team_id = $1 AND created_at >= $2
+		GROUP BY player_id, driver
+	`
+	err := conn(ctx, r.db).SelectContext(ctx, &totals, query, teamID, since)
+	return totals, err
+}
@@ -4,13 +4,41 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
+	"strings"
+	"time"
 
 	"soccer-manager-api/internal/domain"
 	"soccer-manager-api/internal/ports/repository"
 
+	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
 )
 
+var playerColumnNames = []string{
+	"id", "team_id", "first_name", "last_name", "country", "age", "position", "potential",
+	"finishing", "passing", "defending", "goalkeeping", "fitness",
+	"market_value", "form_factor", "morale", "joined_at", "availability", "unavailable_until", "unavailable_matches",
+	"retired_at", "created_at", "updated_at",
+}
+
+var playerColumns = strings.Join(playerColumnNames, ", ")
+
+var insertPlayerQuery = fmt.Sprintf(
+	`INSERT INTO players (%s) VALUES (:%s)`,
+	playerColumns,
+	strings.Join(playerColumnNames, ", :"),
+)
+
+
+func playerColumnsAs(alias, prefix string) string {
+	columns := make([]string, 0, len(playerColumnNames))
+	for _, name := range playerColumnNames {
+		columns = append(columns, fmt.Sprintf(`%s.%s AS "%s.%s"`, alias, name, prefix, name))
+	}
+	return strings.Join(columns, ", ")
+}
+
 type playerRepository struct {
 	db *sqlx.DB
 }
@@ -21,14 +49,7 @@ func NewPlayerRepository(db *sqlx.DB) repository.PlayerRepository {
 }
 
 func (r *playerRepository) Create(ctx context.Context, player *domain.Player) error {
-	query := `
-		INSERT INTO players (id, team_id, first_name, last_name, country, age, position, market_value, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
-	`
-	_, err := r.db.ExecContext(ctx, query,
-		player.ID, player.TeamID, player.FirstName, player.LastName,
-		player.Country, player.Age, player.Position, player.MarketValue,
-		player.CreatedAt, player.UpdatedAt)
+	_, err := conn(ctx, r.db).NamedExecContext(ctx, insertPlayerQuery, player)
 	return err
 }
 
@@ -38,37 +59,20 @@ func (r *playerRepository) CreateBatch(ctx context.Context, players []*domain.Pl
 	}
 
 
-	tx, err := r.db.BeginTxx(ctx, nil)
+	tx, err := begin(ctx, r.db)
 	if err != nil {
 		return err
 	}
 	defer tx.Rollback()
 
-	query := `
-		INSERT INTO players (id, team_id, first_name, last_name, country, age, position, market_value, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
-	`
-
-	stmt, err := tx.PreparexContext(ctx, query)
+	stmt, err := tx.PrepareNamedContext(ctx, insertPlayerQuery)
 	if err != nil {
 		return err
 	}
 	defer stmt.Close()
 
 	for _, player := range players {
-		_, err := stmt.ExecContext(ctx,
-			player.ID,
-			player.TeamID,
-			player.FirstName,
-			player.LastName,
-			player.Country,
-			player.Age,
-			player.Position,
-			player.MarketValue,
-			player.CreatedAt,
-			player.UpdatedAt,
-		)
-		if err != nil {
+		if _, err := stmt.ExecContext(ctx, player); err != nil {
 			return err
 		}
 	}
@@ -78,11 +82,8 @@ func (r *playerRepository) CreateBatch(ctx context.Context, players []*domain.Pl
 
 func (r *playerRepository) GetByID(ctx context.Context, id string) (*domain.Player, error) {
 	var player domain.Player
-	query := `
-		SELECT id, team_id, first_name, last_name, country, age, position, market_value, created_at, updated_at 
-		FROM players WHERE id = $1
-	`
-	err := r.db.GetContext(ctx, &player, query, id)
+	query := `SELECT ` + playerColumns + ` FROM players WHERE id = $1`
+	err := conn(ctx, r.db).GetContext(ctx, &player, query, id)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, domain.ErrPlayerNotFound
@@ -95,40 +96,119 @@ func (r *playerRepository) GetByID(ctx context.Context, id string) (*domain.Play
 func (r *playerRepository) GetByTeamID(ctx context.Context, teamID string) ([]*domain.Player, error) {
 	var players []*domain.Player
 	query := `
-		SELECT id, team_id, first_name, last_name, country, age, position, market_value, created_at, updated_at 
+		SELECT ` + playerColumns + `
 		FROM players WHERE team_id = $1
 		ORDER BY position, last_name, first_name
 	`
-	err := r.db.SelectContext(ctx, &players, query, teamID)
+	err := conn(ctx, r.db).SelectContext(ctx, &players, query, teamID)
+	return players, err
+}
+
+func (r *playerRepository) GetActive(ctx context.Context) ([]*domain.Player, error) {
+	var players []*domain.Player
+	query := `
+		SELECT ` + playerColumns + `
+		FROM players WHERE retired_at IS NULL
+		ORDER BY id
+	`
+	err := conn(ctx, r.db).SelectContext(ctx, &players, query)
 	return players, err
 }
 
-func (r *playerRepository) Update(ctx context.Context, player *domain.Player) error {
+func (r *playerRepository) GetUnavailable(ctx context.Context) ([]*domain.Player, error) {
+	var players []*domain.Player
+	query := `
+		SELECT ` + playerColumns + `
+		FROM players WHERE availability <> 'available' AND retired_at IS NULL
+		ORDER BY unavailable_until NULLS LAST
+	`
+	err := conn(ctx, r.db).SelectContext(ctx, &players, query)
+	return players, err
+}
+
+func (r *playerRepository) UpdateProfile(ctx context.Context, player *domain.Player) error {
 	query := `
 		UPDATE players 
-		SET team_id = $1, first_name = $2, last_name = $3, country = $4, 
-		    age = $5, position = $6, market_value = $7, updated_at = $8
-		WHERE id = $9
+		SET first_name = :first_name, last_name = :last_name, country = :country, updated_at = :updated_at
+		WHERE id = :id
 	`
-	_, err := r.db.ExecContext(ctx, query,
-		player.TeamID, player.FirstName, player.LastName, player.Country,
-		player.Age, player.Position, player.MarketValue, player.UpdatedAt, player.ID)
+	_, err := conn(ctx, r.db).NamedExecContext(ctx, query, player)
 	return err
 }
 
+func (r *playerRepository) UpdateDevelopment(ctx context.Context, player *domain.Player) error {
+	query := `
+		UPDATE players 
+		SET age = :age, finishing = :finishing, passing = :passing, defending = :defending,
+			goalkeeping = :goalkeeping, fitness = :fitness, market_value = :market_value, updated_at = :updated_at
+		WHERE id = :id
+	`
+	_, err := conn(ctx, r.db).NamedExecContext(ctx, query, player)
+	return err
+}
+
+func (r *playerRepository) UpdateForm(ctx context.Context, player *domain.Player) error {
+	query := `
+		UPDATE players 
+		SET market_value = market_value / form_factor * $1, form_factor = $1, updated_at = $2
+		WHERE id = $3
+		RETURNING market_value
+	`
+	err := conn(ctx, r.db).GetContext(ctx, &player.MarketValue, query, player.FormFactor, player.UpdatedAt, player.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.ErrPlayerNotFound
+	}
+	return err
+}
+
+func (r *playerRepository) UpdateAvailability(ctx context.Context, player *domain.Player) error {
+	query := `
+		UPDATE players 
+		SET availability = :availability, unavailable_until = :unavailable_until,
+			unavailable_matches = :unavailable_matches, updated_at = :updated_at
+		WHERE id = :id
+	`
+	_, err := conn(ctx, r.db).NamedExecContext(ctx, query, player)
+	return err
+}
+
+func (r *playerRepository) AdjustMorale(ctx context.Context, player *domain.Player, delta int) error {
+	query := `
+		UPDATE players 
+		SET morale = LEAST(GREATEST(morale + $1, $2), $3), updated_at = $4
+		WHERE id = $5
+		RETURNING morale
+	`
+	err := conn(ctx, r.db).GetContext(ctx, &player.Morale, query, delta, domain.MinMorale, domain.MaxMorale, time.Now(), player.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.ErrPlayerNotFound
+	}
+	return err
+}
+
+func (r *playerRepository) ChangeTeam(ctx context.Context, player *domain.Player, fromTeamID *uuid.UUID) error {
+	query := `
+		UPDATE players 
+		SET team_id = $1, joined_at = $2, market_value = $3, retired_at = $4, updated_at = $5
+		WHERE id = $6 AND team_id IS NOT DISTINCT FROM $7
+	`
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, player.TeamID, player.JoinedAt, player.MarketValue, player.RetiredAt, player.UpdatedAt, player.ID, fromTeamID)
+	return requireRows(result, err, domain.ErrPlayerTeamChanged)
+}
+
 func (r *playerRepository) Delete(ctx context.Context, id string) error {
 	query := `DELETE FROM players WHERE id = $1`
-	_, err := r.db.ExecContext(ctx, query, id)
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, id)
 	return err
 }
 
 func (r *playerRepository) GetByTeamIDAndPosition(ctx context.Context, teamID string, position domain.Position) ([]*domain.Player, error) {
 	var players []*domain.Player
 	query := `
-		SELECT id, team_id, first_name, last_name, country, age, position, market_value, created_at, updated_at 
+		SELECT ` + playerColumns + `
 		FROM players WHERE team_id = $1 AND position = $2
 		ORDER BY last_name, first_name
 	`
-	err := r.db.SelectContext(ctx, &players, query, teamID, position)
+	err := conn(ctx, r.db).SelectContext(ctx, &players, query, teamID, position)
 	return players, err
 }
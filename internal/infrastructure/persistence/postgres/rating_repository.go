@@ -0,0 +1,43 @@
+package postgres
+
+import (
+	"context"
+
+	"soccer-manager-api/internal/domain"
+	"soccer-manager-api/internal/ports/repository"
+
+	"github.com/jmoiron/sqlx"
+)
+
+const ratingChangeColumns = `id, team_id, match_id, opponent_id, rating_before, rating_after, created_at`
+
+type ratingRepository struct {
+	db *sqlx.DB
+}
+
+
+func NewRatingRepository(db *sqlx.DB) repository.RatingRepository {
+	return &ratingRepository{db: db}
+}
+
+func (r *ratingRepository) Create(ctx context.Context, change *domain.RatingChange) error {
+	query := `
+		INSERT INTO rating_history (` + ratingChangeColumns + `)
+		VALUES (:id, :team_id, :match_id, :opponent_id, :rating_before, :rating_after, :created_at)
+	`
+	_, err := conn(ctx, r.db).NamedExecContext(ctx, query, change)
+	return err
+}
+
+func (r *ratingRepository) GetByTeamID(ctx context.Context, teamID string, limit int) ([]*domain.RatingChange, error) {
+	changes := make([]*domain.RatingChange, 0)
+	query := `
+		SELECT ` + ratingChangeColumns + `
+		FROM rating_history
+		WHERE team_id = $1
+		ORDER BY created_at DESC
+		LIMIT $2
+	`
+	err := conn(ctx, r.db).SelectContext(ctx, &changes, query, teamID, limit)
+	return changes, err
+}
@@ -0,0 +1,76 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"soccer-manager-api/internal/domain"
+	"soccer-manager-api/internal/ports/repository"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type recoveryCodeRepository struct {
+	db *sqlx.DB
+}
+
+
+func NewRecoveryCodeRepository(db *sqlx.DB) repository.RecoveryCodeRepository {
+	return &recoveryCodeRepository{db: db}
+}
+
+func (r *recoveryCodeRepository) Replace(ctx context.Context, userID string, codes []*domain.RecoveryCode) error {
+	tx, err := begin(ctx, r.db)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM recovery_codes WHERE user_id = $1`, userID); err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO recovery_codes (id, user_id, code_hash, used_at, created_at)
+		VALUES (:id, :user_id, :code_hash, :used_at, :created_at)
+	`
+	for _, code := range codes {
+		if _, err := tx.NamedExecContext(ctx, query, code); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *recoveryCodeRepository) ListUnused(ctx context.Context, userID string) ([]*domain.RecoveryCode, error) {
+	var codes []*domain.RecoveryCode
+	query := `
+		SELECT id, user_id, code_hash, used_at, created_at
+		FROM recovery_codes
+		WHERE user_id = $1 AND used_at IS NULL
+		ORDER BY created_at
+	`
+	err := conn(ctx, r.db).SelectContext(ctx, &codes, query, userID)
+	return codes, err
+}
+
+func (r *recoveryCodeRepository) MarkUsed(ctx context.Context, id string) error {
+	result, err := conn(ctx, r.db).ExecContext(ctx, `UPDATE recovery_codes SET used_at = $1 WHERE id = $2 AND used_at IS NULL`, time.Now(), id)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return domain.ErrInvalidTwoFactorCode
+	}
+	return nil
+}
+
+func (r *recoveryCodeRepository) DeleteByUserID(ctx context.Context, userID string) error {
+	_, err := conn(ctx, r.db).ExecContext(ctx, `DELETE FROM recovery_codes WHERE user_id = $1`, userID)
+	return err
+}
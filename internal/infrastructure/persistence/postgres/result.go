@@ -0,0 +1,17 @@
+package postgres
+
+import "database/sql"
+
+func requireRows(result sql.Result, err error, missing error) error {
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return missing
+	}
+	return nil
+}
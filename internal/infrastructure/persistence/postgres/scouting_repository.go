@@ -0,0 +1,142 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"soccer-manager-api/internal/domain"
+	"soccer-manager-api/internal/ports/repository"
+
+	"github.com/jmoiron/sqlx"
+)
+
+const scoutColumns = `id, team_id, name, skill, player_id, region, assigned_at, hired_at`
+
+type scoutingRepository struct {
+	db *sqlx.DB
+}
+
+
+func NewScoutingRepository(db *sqlx.DB) repository.ScoutingRepository {
+	return &scoutingRepository{db: db}
+}
+
+func (r *scoutingRepository) CreateScout(ctx context.Context, scout *domain.Scout) error {
+	query := `
+		INSERT INTO scouts (` + scoutColumns + `)
+		VALUES (:id, :team_id, :name, :skill, :player_id, :region, :assigned_at, :hired_at)
+	`
+	_, err := conn(ctx, r.db).NamedExecContext(ctx, query, scout)
+	return err
+}
+
+func (r *scoutingRepository) GetScoutByID(ctx context.Context, id string) (*domain.Scout, error) {
+	var scout domain.Scout
+	query := `SELECT ` + scoutColumns + ` FROM scouts WHERE id = $1`
+	err := conn(ctx, r.db).GetContext(ctx, &scout, query, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrScoutNotFound
+		}
+		return nil, err
+	}
+	return &scout, nil
+}
+
+func (r *scoutingRepository) GetScoutsByTeamID(ctx context.Context, teamID string) ([]*domain.Scout, error) {
+	scouts := make([]*domain.Scout, 0)
+	query := `SELECT ` + scoutColumns + ` FROM scouts WHERE team_id = $1 ORDER BY hired_at`
+	err := conn(ctx, r.db).SelectContext(ctx, &scouts, query, teamID)
+	return scouts, err
+}
+
+func (r *scoutingRepository) LockScoutCount(ctx context.Context, teamID string) (int, error) {
+	var count int
+	query := `
+		SELECT (SELECT COUNT(*) FROM scouts WHERE team_id = t.id)
+		FROM teams t WHERE t.id = $1
+		FOR UPDATE
+	`
+	err := conn(ctx, r.db).GetContext(ctx, &count, query, teamID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, domain.ErrTeamNotFound
+	}
+	return count, err
+}
+
+func (r *scoutingRepository) GetAssignedScouts(ctx context.Context) ([]*domain.Scout, error) {
+	scouts := make([]*domain.Scout, 0)
+	query := `SELECT ` + scoutColumns + ` FROM scouts WHERE player_id IS NOT NULL OR region IS NOT NULL ORDER BY hired_at`
+	err := conn(ctx, r.db).SelectContext(ctx, &scouts, query)
+	return scouts, err
+}
+
+func (r *scoutingRepository) UpdateScout(ctx context.Context, scout *domain.Scout) error {
+	query := `
+		UPDATE scouts
+		SET player_id = :player_id, region = :region, assigned_at = :assigned_at
+		WHERE id = :id
+	`
+	_, err := conn(ctx, r.db).NamedExecContext(ctx, query, scout)
+	return err
+}
+
+func (r *scoutingRepository) DeleteScout(ctx context.Context, id string) error {
+	query := `DELETE FROM scouts WHERE id = $1`
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, id)
+	return err
+}
+
+func (r *scoutingRepository) GetReport(ctx context.Context, teamID, playerID string) (*domain.ScoutReport, error) {
+	var report domain.ScoutReport
+	query := `SELECT team_id, player_id, accuracy, updated_at FROM scout_reports WHERE team_id = $1 AND player_id = $2`
+	err := conn(ctx, r.db).GetContext(ctx, &report, query, teamID, playerID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrScoutReportNotFound
+		}
+		return nil, err
+	}
+	return &report, nil
+}
+
+func (r *scoutingRepository) GetReportsByTeamID(ctx context.Context, teamID string) ([]*domain.ScoutReport, error) {
+	reports := make([]*domain.ScoutReport, 0)
+	query := `
+		SELECT team_id, player_id, accuracy, updated_at
+		FROM scout_reports
+		WHERE team_id = $1
+		ORDER BY accuracy DESC, updated_at DESC
+	`
+	err := conn(ctx, r.db).SelectContext(ctx, &reports, query, teamID)
+	return reports, err
+}
+
+func (r *scoutingRepository) GetRegionReports(ctx context.Context, teamID, region string, limit int) ([]*domain.ScoutReport, error) {
+	reports := make([]*domain.ScoutReport, 0)
+	query := `
+		SELECT $1::uuid AS team_id, p.id AS player_id, COALESCE(sr.accuracy, 0) AS accuracy, COALESCE(sr.updated_at, NOW()) AS updated_at
+		FROM players p
+		LEFT JOIN scout_reports sr ON sr.player_id = p.id AND sr.team_id = $1
+		WHERE LOWER(p.country) = LOWER($2)
+			AND p.retired_at IS NULL
+			AND (p.team_id IS NULL OR p.team_id != $1)
+			AND COALESCE(sr.accuracy, 0) < 100
+		ORDER BY COALESCE(sr.accuracy, 0), RANDOM()
+		LIMIT $3
+	`
+	err := conn(ctx, r.db).SelectContext(ctx, &reports, query, teamID, region, limit)
+	return reports, err
+}
+
+func (r *scoutingRepository) SaveReport(ctx context.Context, report *domain.ScoutReport) error {
+	query := `
+		INSERT INTO scout_reports (team_id, player_id, accuracy, updated_at)
+		VALUES (:team_id, :player_id, :accuracy, :updated_at)
+		ON CONFLICT (team_id, player_id) DO UPDATE
+		SET accuracy = EXCLUDED.accuracy, updated_at = EXCLUDED.updated_at
+	`
+	_, err := conn(ctx, r.db).NamedExecContext(ctx, query, report)
+	return err
+}
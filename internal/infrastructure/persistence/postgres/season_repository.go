@@ -0,0 +1,139 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"soccer-manager-api/internal/domain"
+	"soccer-manager-api/internal/ports/repository"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+type seasonRepository struct {
+	db *sqlx.DB
+}
+
+
+func NewSeasonRepository(db *sqlx.DB) repository.SeasonRepository {
+	return &seasonRepository{db: db}
+}
+
+func (r *seasonRepository) Create(ctx context.Context, season *domain.Season) error {
+	query := `
+		INSERT INTO seasons (id, number, status, started_at, ended_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, season.ID, season.Number, season.Status, season.StartedAt, season.EndedAt)
+	return err
+}
+
+func (r *seasonRepository) GetCurrent(ctx context.Context) (*domain.Season, error) {
+	var season domain.Season
+	query := `SELECT id, number, status, started_at, ended_at FROM seasons WHERE status = 'active'`
+	err := conn(ctx, r.db).GetContext(ctx, &season, query)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrSeasonNotFound
+		}
+		return nil, err
+	}
+	return &season, nil
+}
+
+func (r *seasonRepository) List(ctx context.Context) ([]*domain.Season, error) {
+	seasons := make([]*domain.Season, 0)
+	query := `SELECT id, number, status, started_at, ended_at FROM seasons ORDER BY number DESC`
+	err := conn(ctx, r.db).SelectContext(ctx, &seasons, query)
+	return seasons, err
+}
+
+func (r *seasonRepository) Complete(ctx context.Context, season *domain.Season) error {
+	query := `
+		UPDATE seasons 
+		SET status = $1, ended_at = $2
+		WHERE number = $3 AND status = 'active'
+	`
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, season.Status, season.EndedAt, season.Number)
+	return requireRows(result, err, domain.ErrSeasonAlreadyCompleted)
+}
+
+func (r *seasonRepository) ArchivePlayers(ctx context.Context, snapshots []*domain.SeasonPlayerSnapshot) error {
+	if len(snapshots) == 0 {
+		return nil
+	}
+
+	tx, err := begin(ctx, r.db)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO season_player_snapshots (season_id, player_id, team_id, age, position, market_value, archived_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (season_id, player_id) DO NOTHING
+	`
+
+	stmt, err := tx.PreparexContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	for _, snapshot := range snapshots {
+		_, err := stmt.ExecContext(ctx,
+			snapshot.SeasonID,
+			snapshot.PlayerID,
+			snapshot.TeamID,
+			snapshot.Age,
+			snapshot.Position,
+			snapshot.MarketValue,
+			snapshot.ArchivedAt,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *seasonRepository) ArchivePlayerStats(ctx context.Context, seasonID uuid.UUID) (int, error) {
+	claim := `UPDATE player_match_stats SET season_id = $1 WHERE season_id IS NULL`
+	if _, err := conn(ctx, r.db).ExecContext(ctx, claim, seasonID); err != nil {
+		return 0, err
+	}
+
+	query := `
+		INSERT INTO season_player_stats (season_id, player_id, competition, appearances, starts, goals, assists,
+			clean_sheets, yellow_cards, red_cards, average_rating, archived_at)
+		SELECT s.season_id, s.player_id, s.competition,` + statsTotalsColumns + `, $2
+		FROM player_match_stats s
+		WHERE s.season_id = $1
+		GROUP BY s.season_id, s.player_id, s.competition
+		ON CONFLICT (season_id, player_id, competition) DO NOTHING
+	`
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, seasonID, time.Now())
+	if err != nil {
+		return 0, err
+	}
+	archived, err := result.RowsAffected()
+	return int(archived), err
+}
+
+func (r *seasonRepository) GetPlayerHistory(ctx context.Context, playerID string) ([]*domain.SeasonPlayerSnapshot, error) {
+	snapshots := make([]*domain.SeasonPlayerSnapshot, 0)
+	query := `
+		SELECT sps.season_id, sps.player_id, sps.team_id, sps.age, sps.position, sps.market_value, sps.archived_at
+		FROM season_player_snapshots sps
+		INNER JOIN seasons s ON sps.season_id = s.id
+		WHERE sps.player_id = $1
+		ORDER BY s.number DESC
+	`
+	err := conn(ctx, r.db).SelectContext(ctx, &snapshots, query, playerID)
+	return snapshots, err
+}
@@ -0,0 +1,130 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"soccer-manager-api/internal/domain"
+	"soccer-manager-api/internal/ports/repository"
+
+	"github.com/jmoiron/sqlx"
+)
+
+const sessionColumns = `id, user_id, user_agent, ip_address, created_at, last_used_at, expires_at, max_expires_at, revoked_at`
+
+const insertRefreshTokenQuery = `
+	INSERT INTO refresh_tokens (id, session_id, token_hash, expires_at, rotated_at, created_at)
+	VALUES (:id, :session_id, :token_hash, :expires_at, :rotated_at, :created_at)
+`
+
+type sessionRepository struct {
+	db *sqlx.DB
+}
+
+
+func NewSessionRepository(db *sqlx.DB) repository.SessionRepository {
+	return &sessionRepository{db: db}
+}
+
+func (r *sessionRepository) Create(ctx context.Context, session *domain.Session, token *domain.RefreshToken) error {
+	tx, err := begin(ctx, r.db)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO sessions (` + sessionColumns + `)
+		VALUES (:id, :user_id, :user_agent, :ip_address, :created_at, :last_used_at, :expires_at, :max_expires_at, :revoked_at)
+	`
+	if _, err := tx.NamedExecContext(ctx, query, session); err != nil {
+		return err
+	}
+	if _, err := tx.NamedExecContext(ctx, insertRefreshTokenQuery, token); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *sessionRepository) GetByID(ctx context.Context, id string) (*domain.Session, error) {
+	var session domain.Session
+	query := `SELECT ` + sessionColumns + ` FROM sessions WHERE id = $1`
+	err := conn(ctx, r.db).GetContext(ctx, &session, query, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrSessionNotFound
+		}
+		return nil, err
+	}
+	return &session, nil
+}
+
+func (r *sessionRepository) GetActiveByUserID(ctx context.Context, userID string) ([]*domain.Session, error) {
+	sessions := make([]*domain.Session, 0)
+	query := `
+		SELECT ` + sessionColumns + `
+		FROM sessions
+		WHERE user_id = $1 AND revoked_at IS NULL AND expires_at > $2
+		ORDER BY last_used_at DESC
+	`
+	err := conn(ctx, r.db).SelectContext(ctx, &sessions, query, userID, time.Now())
+	return sessions, err
+}
+
+func (r *sessionRepository) GetRefreshToken(ctx context.Context, tokenHash string) (*domain.RefreshToken, error) {
+	var token domain.RefreshToken
+	query := `SELECT id, session_id, token_hash, expires_at, rotated_at, created_at FROM refresh_tokens WHERE token_hash = $1`
+	err := conn(ctx, r.db).GetContext(ctx, &token, query, tokenHash)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrInvalidRefreshToken
+		}
+		return nil, err
+	}
+	return &token, nil
+}
+
+func (r *sessionRepository) Rotate(ctx context.Context, session *domain.Session, used, next *domain.RefreshToken) error {
+	tx, err := begin(ctx, r.db)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, `UPDATE refresh_tokens SET rotated_at = $1 WHERE id = $2 AND rotated_at IS NULL`, time.Now(), used.ID)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return domain.ErrRefreshTokenReused
+	}
+
+	if _, err := tx.NamedExecContext(ctx, insertRefreshTokenQuery, next); err != nil {
+		return err
+	}
+	query := `UPDATE sessions SET last_used_at = $1, expires_at = $2 WHERE id = $3`
+	if _, err := tx.ExecContext(ctx, query, session.LastUsedAt, session.ExpiresAt, session.ID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *sessionRepository) Revoke(ctx context.Context, id string) error {
+	query := `UPDATE sessions SET revoked_at = $1 WHERE id = $2 AND revoked_at IS NULL`
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, time.Now(), id)
+	return err
+}
+
+func (r *sessionRepository) RevokeByUserID(ctx context.Context, userID string) error {
+	query := `UPDATE sessions SET revoked_at = $1 WHERE user_id = $2 AND revoked_at IS NULL`
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, time.Now(), userID)
+	return err
+}
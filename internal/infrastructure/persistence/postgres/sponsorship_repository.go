@@ -0,0 +1,112 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"soccer-manager-api/internal/domain"
+	"soccer-manager-api/internal/ports/repository"
+
+	"github.com/jmoiron/sqlx"
+)
+
+const sponsorshipOfferColumns = `id, team_id, sponsor_name, schedule, amount, payments, expires_at, created_at`
+
+const sponsorshipDealColumns = `id, team_id, sponsor_name, schedule, amount, payments_total, payments_made, status, next_payment_at, signed_at, updated_at`
+
+type sponsorshipRepository struct {
+	db *sqlx.DB
+}
+
+
+func NewSponsorshipRepository(db *sqlx.DB) repository.SponsorshipRepository {
+	return &sponsorshipRepository{db: db}
+}
+
+func (r *sponsorshipRepository) CreateOffers(ctx context.Context, offers []*domain.SponsorshipOffer) error {
+	if len(offers) == 0 {
+		return nil
+	}
+	query := `
+		INSERT INTO sponsorship_offers (` + sponsorshipOfferColumns + `)
+		VALUES (:id, :team_id, :sponsor_name, :schedule, :amount, :payments, :expires_at, :created_at)
+	`
+	_, err := conn(ctx, r.db).NamedExecContext(ctx, query, offers)
+	return err
+}
+
+func (r *sponsorshipRepository) GetOffersByTeamID(ctx context.Context, teamID string, now time.Time) ([]*domain.SponsorshipOffer, error) {
+	offers := make([]*domain.SponsorshipOffer, 0)
+	query := `
+		SELECT ` + sponsorshipOfferColumns + `
+		FROM sponsorship_offers WHERE team_id = $1 AND expires_at > $2
+		ORDER BY schedule
+	`
+	err := conn(ctx, r.db).SelectContext(ctx, &offers, query, teamID, now)
+	return offers, err
+}
+
+func (r *sponsorshipRepository) GetOfferByID(ctx context.Context, id string) (*domain.SponsorshipOffer, error) {
+	var offer domain.SponsorshipOffer
+	query := `SELECT ` + sponsorshipOfferColumns + ` FROM sponsorship_offers WHERE id = $1`
+	err := conn(ctx, r.db).GetContext(ctx, &offer, query, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrSponsorshipNotFound
+		}
+		return nil, err
+	}
+	return &offer, nil
+}
+
+func (r *sponsorshipRepository) DeleteOffersByTeamID(ctx context.Context, teamID string) error {
+	query := `DELETE FROM sponsorship_offers WHERE team_id = $1`
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, teamID)
+	return err
+}
+
+func (r *sponsorshipRepository) CreateDeal(ctx context.Context, deal *domain.SponsorshipDeal) error {
+	query := `
+		INSERT INTO sponsorship_deals (` + sponsorshipDealColumns + `)
+		VALUES (:id, :team_id, :sponsor_name, :schedule, :amount, :payments_total, :payments_made, :status, :next_payment_at, :signed_at, :updated_at)
+	`
+	_, err := conn(ctx, r.db).NamedExecContext(ctx, query, deal)
+	return err
+}
+
+func (r *sponsorshipRepository) UpdateDeal(ctx context.Context, deal *domain.SponsorshipDeal) error {
+	query := `
+		UPDATE sponsorship_deals 
+		SET payments_made = :payments_made, status = :status, next_payment_at = :next_payment_at, updated_at = :updated_at
+		WHERE id = :id AND payments_made = :payments_made - 1
+	`
+	result, err := conn(ctx, r.db).NamedExecContext(ctx, query, deal)
+	return requireRows(result, err, domain.ErrSponsorshipAlreadyPaid)
+}
+
+func (r *sponsorshipRepository) GetActiveDealByTeamID(ctx context.Context, teamID string) (*domain.SponsorshipDeal, error) {
+	var deal domain.SponsorshipDeal
+	query := `SELECT ` + sponsorshipDealColumns + ` FROM sponsorship_deals WHERE team_id = $1 AND status = 'active'`
+	err := conn(ctx, r.db).GetContext(ctx, &deal, query, teamID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrSponsorshipNotFound
+		}
+		return nil, err
+	}
+	return &deal, nil
+}
+
+func (r *sponsorshipRepository) GetDueDeals(ctx context.Context, now time.Time) ([]*domain.SponsorshipDeal, error) {
+	deals := make([]*domain.SponsorshipDeal, 0)
+	query := `
+		SELECT ` + sponsorshipDealColumns + `
+		FROM sponsorship_deals 
+		WHERE status = 'active' AND schedule = 'weekly' AND next_payment_at <= $1
+		ORDER BY next_payment_at
+	`
+	err := conn(ctx, r.db).SelectContext(ctx, &deals, query, now)
+	return deals, err
+}
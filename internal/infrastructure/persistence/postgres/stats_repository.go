@@ -0,0 +1,129 @@
+package postgres
+
+import (
+	"context"
+
+	"soccer-manager-api/internal/domain"
+	"soccer-manager-api/internal/ports/repository"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+const statsTotalsColumns = `
+	COUNT(*) AS appearances,
+	COUNT(*) FILTER (WHERE s.started) AS starts,
+	COALESCE(SUM(s.goals), 0) AS goals,
+	COALESCE(SUM(s.assists), 0) AS assists,
+	COUNT(*) FILTER (WHERE s.clean_sheet) AS clean_sheets,
+	COALESCE(SUM(s.yellow_cards), 0) AS yellow_cards,
+	COALESCE(SUM(s.red_cards), 0) AS red_cards,
+	ROUND(AVG(s.rating), 2) AS average_rating`
+
+var leaderboardOrder = map[domain.LeaderboardCategory]string{
+	domain.LeaderboardScorers: "goals",
+	domain.LeaderboardAssists: "assists",
+}
+
+type statsRepository struct {
+	db *sqlx.DB
+}
+
+
+func NewStatsRepository(db *sqlx.DB) repository.StatsRepository {
+	return &statsRepository{db: db}
+}
+
+func (r *statsRepository) CreateBatch(ctx context.Context, stats []*domain.PlayerMatchStats) error {
+	if len(stats) == 0 {
+		return nil
+	}
+
+	tx, err := begin(ctx, r.db)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	stmt, err := tx.PrepareNamedContext(ctx, `
+		INSERT INTO player_match_stats (id, match_id, player_id, team_id, season_id, competition, started, goals, assists, clean_sheet, yellow_cards, red_cards, rating, created_at)
+		VALUES (:id, :match_id, :player_id, :team_id, :season_id, :competition, :started, :goals, :assists, :clean_sheet, :yellow_cards, :red_cards, :rating, :created_at)
+	`)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	for _, line := range stats {
+		if _, err := stmt.ExecContext(ctx, line); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *statsRepository) GetPlayerTotals(ctx context.Context, playerID string) ([]*domain.PlayerStats, error) {
+	totals := make([]*domain.PlayerStats, 0)
+	query := `
+		SELECT s.player_id, se.number AS season_number, s.competition,` + statsTotalsColumns + `
+		FROM player_match_stats s
+		LEFT JOIN seasons se ON se.id = s.season_id
+		WHERE s.player_id = $1
+		GROUP BY s.player_id, se.number, s.competition
+		ORDER BY se.number DESC NULLS LAST, s.competition
+	`
+	err := conn(ctx, r.db).SelectContext(ctx, &totals, query, playerID)
+	return totals, err
+}
+
+func (r *statsRepository) GetTeamTotals(ctx context.Context, teamID string, seasonID *uuid.UUID) ([]*domain.PlayerStats, error) {
+	totals := make([]*domain.PlayerStats, 0)
+	query := `
+		SELECT s.player_id, p.first_name, p.last_name,` + statsTotalsColumns + `
+		FROM player_match_stats s
+		JOIN players p ON p.id = s.player_id
+		WHERE s.team_id = $1 AND ($2::uuid IS NULL OR s.season_id = $2)
+		GROUP BY s.player_id, p.first_name, p.last_name
+		ORDER BY goals DESC, appearances DESC, p.last_name
+	`
+	err := conn(ctx, r.db).SelectContext(ctx, &totals, query, teamID, seasonID)
+	return totals, err
+}
+
+func (r *statsRepository) GetLeaderboard(ctx context.Context, category domain.LeaderboardCategory, seasonID *uuid.UUID, competition string, limit int) ([]*domain.PlayerStats, error) {
+	order, ok := leaderboardOrder[category]
+	if !ok {
+		return nil, domain.ErrInvalidLeaderboard
+	}
+
+	totals := make([]*domain.PlayerStats, 0)
+	query := `
+		SELECT s.player_id, p.first_name, p.last_name, COALESCE(t.name, '') AS team_name,` + statsTotalsColumns + `
+		FROM player_match_stats s
+		JOIN players p ON p.id = s.player_id
+		LEFT JOIN teams t ON t.id = p.team_id
+		WHERE ($1::uuid IS NULL OR s.season_id = $1) AND ($2 = '' OR s.competition = $2)
+		GROUP BY s.player_id, p.first_name, p.last_name, t.name
+		HAVING SUM(s.` + order + `) > 0
+		ORDER BY ` + order + ` DESC, appearances ASC, p.last_name
+		LIMIT $3
+	`
+	err := conn(ctx, r.db).SelectContext(ctx, &totals, query, seasonID, competition, limit)
+	return totals, err
+}
+
+func (r *statsRepository) GetRecentForm(ctx context.Context, playerID string, matches int) (float64, error) {
+	var form float64
+	query := `
+		SELECT COALESCE(AVG(rating), 0)
+		FROM (
+			SELECT rating FROM player_match_stats
+			WHERE player_id = $1
+			ORDER BY created_at DESC
+			LIMIT $2
+		) recent
+	`
+	err := conn(ctx, r.db).GetContext(ctx, &form, query, playerID, matches)
+	return form, err
+}
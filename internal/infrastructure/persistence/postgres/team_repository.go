@@ -9,8 +9,11 @@ import (
 	"soccer-manager-api/internal/ports/repository"
 
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 )
 
+const teamColumns = `id, user_id, name, country, budget, rating, is_bot, is_default, crest_primary, crest_secondary, created_at, updated_at`
+
 type teamRepository struct {
 	db *sqlx.DB
 }
@@ -22,17 +25,30 @@ func NewTeamRepository(db *sqlx.DB) repository.TeamRepository {
 
 func (r *teamRepository) Create(ctx context.Context, team *domain.Team) error {
 	query := `
-		INSERT INTO teams (id, user_id, name, country, budget, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		INSERT INTO teams (` + teamColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
 	`
-	_, err := r.db.ExecContext(ctx, query, team.ID, team.UserID, team.Name, team.Country, team.Budget, team.CreatedAt, team.UpdatedAt)
-	return err
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, team.ID, team.UserID, team.Name, team.Country, team.Budget, team.Rating, team.IsBot, team.IsDefault, team.CrestPrimary, team.CrestSecondary, team.CreatedAt, team.UpdatedAt)
+	return mapTeamNameConflict(err)
 }
 
 func (r *teamRepository) GetByID(ctx context.Context, id string) (*domain.Team, error) {
 	var team domain.Team
-	query := `SELECT id, user_id, name, country, budget, created_at, updated_at FROM teams WHERE id = $1`
-	err := r.db.GetContext(ctx, &team, query, id)
+	query := `SELECT ` + teamColumns + ` FROM teams WHERE id = $1`
+	err := conn(ctx, r.db).GetContext(ctx, &team, query, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrTeamNotFound
+		}
+		return nil, err
+	}
+	return &team, nil
+}
+
+func (r *teamRepository) GetByName(ctx context.Context, name string) (*domain.Team, error) {
+	var team domain.Team
+	query := `SELECT ` + teamColumns + ` FROM teams WHERE LOWER(name) = LOWER($1)`
+	err := conn(ctx, r.db).GetContext(ctx, &team, query, name)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, domain.ErrTeamNotFound
@@ -42,10 +58,10 @@ func (r *teamRepository) GetByID(ctx context.Context, id string) (*domain.Team,
 	return &team, nil
 }
 
-func (r *teamRepository) GetByUserID(ctx context.Context, userID string) (*domain.Team, error) {
+func (r *teamRepository) GetDefaultByUserID(ctx context.Context, userID string) (*domain.Team, error) {
 	var team domain.Team
-	query := `SELECT id, user_id, name, country, budget, created_at, updated_at FROM teams WHERE user_id = $1`
-	err := r.db.GetContext(ctx, &team, query, userID)
+	query := `SELECT ` + teamColumns + ` FROM teams WHERE user_id = $1 AND is_default`
+	err := conn(ctx, r.db).GetContext(ctx, &team, query, userID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, domain.ErrTeamNotFound
@@ -55,20 +71,94 @@ func (r *teamRepository) GetByUserID(ctx context.Context, userID string) (*domai
 	return &team, nil
 }
 
+func (r *teamRepository) ListByUserID(ctx context.Context, userID string) ([]*domain.Team, error) {
+	teams := make([]*domain.Team, 0)
+	query := `SELECT ` + teamColumns + ` FROM teams WHERE user_id = $1 ORDER BY is_default DESC, created_at`
+	err := conn(ctx, r.db).SelectContext(ctx, &teams, query, userID)
+	return teams, err
+}
+
+func (r *teamRepository) SetDefault(ctx context.Context, userID, teamID string) error {
+	tx, err := begin(ctx, r.db)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `UPDATE teams SET is_default = FALSE WHERE user_id = $1 AND is_default`, userID); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `UPDATE teams SET is_default = TRUE WHERE id = $1 AND user_id = $2`, teamID, userID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *teamRepository) List(ctx context.Context) ([]*domain.Team, error) {
+	teams := make([]*domain.Team, 0)
+	query := `SELECT ` + teamColumns + ` FROM teams ORDER BY created_at`
+	err := conn(ctx, r.db).SelectContext(ctx, &teams, query)
+	return teams, err
+}
+
+func (r *teamRepository) ListBots(ctx context.Context) ([]*domain.Team, error) {
+	teams := make([]*domain.Team, 0)
+	query := `SELECT ` + teamColumns + ` FROM teams WHERE is_bot ORDER BY created_at`
+	err := conn(ctx, r.db).SelectContext(ctx, &teams, query)
+	return teams, err
+}
+
 func (r *teamRepository) Update(ctx context.Context, team *domain.Team) error {
 	query := `
 		UPDATE teams 
-		SET name = $1, country = $2, budget = $3, updated_at = $4
-		WHERE id = $5
+		SET name = $1, country = $2, crest_primary = $3, crest_secondary = $4, updated_at = $5
+		WHERE id = $6
 	`
-	_, err := r.db.ExecContext(ctx, query, team.Name, team.Country, team.Budget, team.UpdatedAt, team.ID)
-	return err
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, team.Name, team.Country, team.CrestPrimary, team.CrestSecondary, team.UpdatedAt, team.ID)
+	return mapTeamNameConflict(err)
+}
+
+func (r *teamRepository) AdjustRating(ctx context.Context, teamID string, delta int) (int, error) {
+	var rating int
+	query := `UPDATE teams SET rating = rating + $1 WHERE id = $2 RETURNING rating`
+	err := conn(ctx, r.db).GetContext(ctx, &rating, query, delta, teamID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, domain.ErrTeamNotFound
+	}
+	return rating, err
+}
+
+func (r *teamRepository) GetRankings(ctx context.Context, country string, limit int) ([]*domain.Team, error) {
+	teams := make([]*domain.Team, 0)
+	query := `
+		SELECT ` + teamColumns + `
+		FROM teams
+		WHERE $1 = '' OR LOWER(country) = LOWER($1)
+		ORDER BY rating DESC, name
+		LIMIT $2
+	`
+	err := conn(ctx, r.db).SelectContext(ctx, &teams, query, country, limit)
+	return teams, err
+}
+
+func (r *teamRepository) GetNearestByRating(ctx context.Context, teamID string, rating, limit int) ([]*domain.Team, error) {
+	teams := make([]*domain.Team, 0)
+	query := `
+		SELECT ` + teamColumns + `
+		FROM teams
+		WHERE id != $1
+		ORDER BY ABS(rating - $2), created_at
+		LIMIT $3
+	`
+	err := conn(ctx, r.db).SelectContext(ctx, &teams, query, teamID, rating, limit)
+	return teams, err
 }
 
 func (r *teamRepository) GetTotalValue(ctx context.Context, teamID string) (float64, error) {
 	var totalValue sql.NullFloat64
 	query := `SELECT COALESCE(SUM(market_value), 0) FROM players WHERE team_id = $1`
-	err := r.db.GetContext(ctx, &totalValue, query, teamID)
+	err := conn(ctx, r.db).GetContext(ctx, &totalValue, query, teamID)
 	if err != nil {
 		return 0, err
 	}
@@ -81,7 +171,28 @@ func (r *teamRepository) GetTotalValue(ctx context.Context, teamID string) (floa
 func (r *teamRepository) GetPlayerCount(ctx context.Context, teamID string) (int, error) {
 	var count int
 	query := `SELECT COUNT(*) FROM players WHERE team_id = $1`
-	err := r.db.GetContext(ctx, &count, query, teamID)
+	err := conn(ctx, r.db).GetContext(ctx, &count, query, teamID)
+	return count, err
+}
+
+func (r *teamRepository) LockSquadSize(ctx context.Context, teamID string) (int, error) {
+	var count int
+	query := `
+		SELECT (SELECT COUNT(*) FROM players WHERE team_id = t.id)
+		FROM teams t WHERE t.id = $1
+		FOR UPDATE
+	`
+	err := conn(ctx, r.db).GetContext(ctx, &count, query, teamID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, domain.ErrTeamNotFound
+	}
 	return count, err
 }
 
+func mapTeamNameConflict(err error) error {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23505" && pqErr.Constraint == "idx_teams_name_lower" {
+		return domain.ErrTeamNameTaken
+	}
+	return err
+}
@@ -0,0 +1,123 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"soccer-manager-api/internal/domain"
+	"soccer-manager-api/internal/ports/repository"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type trainingRepository struct {
+	db *sqlx.DB
+}
+
+
+func NewTrainingRepository(db *sqlx.DB) repository.TrainingRepository {
+	return &trainingRepository{db: db}
+}
+
+func (r *trainingRepository) SaveAssignment(ctx context.Context, assignment *domain.TrainingAssignment) error {
+	tx, err := begin(ctx, r.db)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+
+	query := `
+		DELETE FROM training_assignments 
+		WHERE team_id = $1 AND (player_id = $2 OR position = $3)
+	`
+	if _, err := tx.ExecContext(ctx, query, assignment.TeamID, assignment.PlayerID, assignment.Position); err != nil {
+		return err
+	}
+
+	query = `
+		INSERT INTO training_assignments (id, team_id, player_id, position, focus, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+	_, err = tx.ExecContext(ctx, query,
+		assignment.ID, assignment.TeamID, assignment.PlayerID,
+		assignment.Position, assignment.Focus, assignment.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *trainingRepository) GetAssignmentByID(ctx context.Context, id string) (*domain.TrainingAssignment, error) {
+	var assignment domain.TrainingAssignment
+	query := `SELECT id, team_id, player_id, position, focus, created_at FROM training_assignments WHERE id = $1`
+	err := conn(ctx, r.db).GetContext(ctx, &assignment, query, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrTrainingAssignmentNotFound
+		}
+		return nil, err
+	}
+	return &assignment, nil
+}
+
+func (r *trainingRepository) GetAssignmentsByTeamID(ctx context.Context, teamID string) ([]*domain.TrainingAssignment, error) {
+	assignments := make([]*domain.TrainingAssignment, 0)
+	query := `
+		SELECT id, team_id, player_id, position, focus, created_at 
+		FROM training_assignments WHERE team_id = $1
+		ORDER BY created_at
+	`
+	err := conn(ctx, r.db).SelectContext(ctx, &assignments, query, teamID)
+	return assignments, err
+}
+
+func (r *trainingRepository) DeleteAssignment(ctx context.Context, id string) error {
+	query := `DELETE FROM training_assignments WHERE id = $1`
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, id)
+	return err
+}
+
+func (r *trainingRepository) CreateSessions(ctx context.Context, sessions []*domain.TrainingSession) error {
+	if len(sessions) == 0 {
+		return nil
+	}
+
+	tx, err := begin(ctx, r.db)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO training_sessions (id, player_id, team_id, focus, previous_value, new_value, previous_rating, new_rating, market_value, trained_at)
+		VALUES (:id, :player_id, :team_id, :focus, :previous_value, :new_value, :previous_rating, :new_rating, :market_value, :trained_at)
+	`
+
+	stmt, err := tx.PrepareNamedContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	for _, session := range sessions {
+		if _, err := stmt.ExecContext(ctx, session); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *trainingRepository) GetSessionsByPlayerID(ctx context.Context, playerID string) ([]*domain.TrainingSession, error) {
+	sessions := make([]*domain.TrainingSession, 0)
+	query := `
+		SELECT id, player_id, team_id, focus, previous_value, new_value, previous_rating, new_rating, market_value, trained_at 
+		FROM training_sessions WHERE player_id = $1
+		ORDER BY trained_at DESC
+	`
+	err := conn(ctx, r.db).SelectContext(ctx, &sessions, query, playerID)
+	return sessions, err
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"database/sql"
 	"errors"
-	"time"
 
 	"soccer-manager-api/internal/domain"
 	"soccer-manager-api/internal/ports/repository"
@@ -27,14 +26,14 @@ func (r *transferRepository) CreateListing(ctx context.Context, listing *domain.
 		INSERT INTO transfer_listings (id, player_id, asking_price, status, listed_at)
 		VALUES ($1, $2, $3, $4, $5)
 	`
-	_, err := r.db.ExecContext(ctx, query, listing.ID, listing.PlayerID, listing.AskingPrice, listing.Status, listing.ListedAt)
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, listing.ID, listing.PlayerID, listing.AskingPrice, listing.Status, listing.ListedAt)
 	return err
 }
 
 func (r *transferRepository) GetListingByID(ctx context.Context, id string) (*domain.TransferListing, error) {
 	var listing domain.TransferListing
 	query := `SELECT id, player_id, asking_price, status, listed_at FROM transfer_listings WHERE id = $1`
-	err := r.db.GetContext(ctx, &listing, query, id)
+	err := conn(ctx, r.db).GetContext(ctx, &listing, query, id)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, domain.ErrTransferListingNotFound
@@ -47,7 +46,7 @@ func (r *transferRepository) GetListingByID(ctx context.Context, id string) (*do
 func (r *transferRepository) GetListingByPlayerID(ctx context.Context, playerID string) (*domain.TransferListing, error) {
 	var listing domain.TransferListing
 	query := `SELECT id, player_id, asking_price, status, listed_at FROM transfer_listings WHERE player_id = $1 AND status = 'active'`
-	err := r.db.GetContext(ctx, &listing, query, playerID)
+	err := conn(ctx, r.db).GetContext(ctx, &listing, query, playerID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, domain.ErrTransferListingNotFound
@@ -57,65 +56,28 @@ func (r *transferRepository) GetListingByPlayerID(ctx context.Context, playerID
 	return &listing, nil
 }
 
-func (r *transferRepository) GetActiveListings(ctx context.Context, excludeTeamID string) ([]*domain.TransferListingWithPlayer, error) {
-	type listingRow struct {
-		domain.TransferListing
-		PPlayerID     string  `db:"p_id"`
-		PlayerTeamID  *string `db:"player_team_id"`
-		PlayerFirstName string `db:"player_first_name"`
-		PlayerLastName  string `db:"player_last_name"`
-		PlayerCountry   string `db:"player_country"`
-		PlayerAge        int    `db:"player_age"`
-		PlayerPosition   string `db:"player_position"`
-		PlayerMarketValue float64 `db:"player_market_value"`
-		PlayerCreatedAt   time.Time `db:"player_created_at"`
-		PlayerUpdatedAt   time.Time `db:"player_updated_at"`
-	}
-
-	var rows []listingRow
+func (r *transferRepository) GetActiveListings(ctx context.Context, excludeTeamID string, leagueID *uuid.UUID) ([]*domain.TransferListingWithPlayer, error) {
+	listings := make([]*domain.TransferListingWithPlayer, 0)
 	query := `
 		SELECT 
 			tl.id, tl.player_id, tl.asking_price, tl.status, tl.listed_at,
-			p.id as p_id, p.team_id as player_team_id, p.first_name as player_first_name,
-			p.last_name as player_last_name, p.country as player_country, p.age as player_age,
-			p.position as player_position, p.market_value as player_market_value,
-			p.created_at as player_created_at, p.updated_at as player_updated_at
+			` + playerColumnsAs("p", "player") + `
 		FROM transfer_listings tl
 		INNER JOIN players p ON tl.player_id = p.id
 		WHERE tl.status = 'active' AND (p.team_id IS NULL OR p.team_id::text != $1)
+			AND ($2::uuid IS NULL OR p.team_id IN (SELECT team_id FROM league_members WHERE league_id = $2))
+			AND NOT EXISTS (
+				SELECT 1 FROM league_members lm
+				INNER JOIN leagues l ON l.id = lm.league_id
+				WHERE lm.team_id = p.team_id AND l.league_only_transfers AND l.id IS DISTINCT FROM $2::uuid
+			)
 		ORDER BY tl.listed_at DESC
 	`
-	err := r.db.SelectContext(ctx, &rows, query, excludeTeamID)
+	err := conn(ctx, r.db).SelectContext(ctx, &listings, query, excludeTeamID, leagueID)
 	if err != nil {
 		return nil, err
 	}
 
-	listings := make([]*domain.TransferListingWithPlayer, 0, len(rows))
-	for _, row := range rows {
-		var teamID *uuid.UUID
-		if row.PlayerTeamID != nil {
-			id, _ := uuid.Parse(*row.PlayerTeamID)
-			teamID = &id
-		}
-		
-		listing := &domain.TransferListingWithPlayer{
-			TransferListing: row.TransferListing,
-			Player: domain.Player{
-				ID:          uuid.MustParse(row.PPlayerID),
-				TeamID:      teamID,
-				FirstName:   row.PlayerFirstName,
-				LastName:    row.PlayerLastName,
-				Country:     row.PlayerCountry,
-				Age:         row.PlayerAge,
-				Position:    domain.Position(row.PlayerPosition),
-				MarketValue: row.PlayerMarketValue,
-				CreatedAt:   row.PlayerCreatedAt,
-				UpdatedAt:   row.PlayerUpdatedAt,
-			},
-		}
-		listings = append(listings, listing)
-	}
-
 	return listings, nil
 }
 
@@ -125,13 +87,13 @@ func (r *transferRepository) UpdateListing(ctx context.Context, listing *domain.
 		SET asking_price = $1, status = $2
 		WHERE id = $3
 	`
-	_, err := r.db.ExecContext(ctx, query, listing.AskingPrice, listing.Status, listing.ID)
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, listing.AskingPrice, listing.Status, listing.ID)
 	return err
 }
 
 func (r *transferRepository) DeleteListing(ctx context.Context, id string) error {
 	query := `DELETE FROM transfer_listings WHERE id = $1`
-	_, err := r.db.ExecContext(ctx, query, id)
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, id)
 	return err
 }
 
@@ -140,7 +102,7 @@ func (r *transferRepository) CreateTransfer(ctx context.Context, transfer *domai
 		INSERT INTO transfers (id, player_id, seller_team_id, buyer_team_id, transfer_price, transferred_at)
 		VALUES ($1, $2, $3, $4, $5, $6)
 	`
-	_, err := r.db.ExecContext(ctx, query,
+	_, err := conn(ctx, r.db).ExecContext(ctx, query,
 		transfer.ID, transfer.PlayerID, transfer.SellerTeamID,
 		transfer.BuyerTeamID, transfer.TransferPrice, transfer.TransferredAt)
 	return err
@@ -149,10 +111,10 @@ func (r *transferRepository) CreateTransfer(ctx context.Context, transfer *domai
 func (r *transferRepository) GetTransferByID(ctx context.Context, id string) (*domain.Transfer, error) {
 	var transfer domain.Transfer
 	query := `
-		SELECT id, player_id, seller_team_id, buyer_team_id, transfer_price, transferred_at 
+		SELECT id, player_id, seller_team_id, buyer_team_id, transfer_price, transferred_at, reversed_at
 		FROM transfers WHERE id = $1
 	`
-	err := r.db.GetContext(ctx, &transfer, query, id)
+	err := conn(ctx, r.db).GetContext(ctx, &transfer, query, id)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, domain.ErrTransferNotFound
@@ -165,12 +127,18 @@ func (r *transferRepository) GetTransferByID(ctx context.Context, id string) (*d
 func (r *transferRepository) GetTransfersByTeamID(ctx context.Context, teamID string) ([]*domain.Transfer, error) {
 	var transfers []*domain.Transfer
 	query := `
-		SELECT id, player_id, seller_team_id, buyer_team_id, transfer_price, transferred_at 
+		SELECT id, player_id, seller_team_id, buyer_team_id, transfer_price, transferred_at, reversed_at
 		FROM transfers 
 		WHERE seller_team_id = $1 OR buyer_team_id = $1
 		ORDER BY transferred_at DESC
 	`
-	err := r.db.SelectContext(ctx, &transfers, query, teamID)
+	err := conn(ctx, r.db).SelectContext(ctx, &transfers, query, teamID)
 	return transfers, err
 }
 
+
+func (r *transferRepository) UpdateTransfer(ctx context.Context, transfer *domain.Transfer) error {
+	query := `UPDATE transfers SET reversed_at = $1 WHERE id = $2 AND reversed_at IS NULL`
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, transfer.ReversedAt, transfer.ID)
+	return requireRows(result, err, domain.ErrTransferAlreadyReversed)
+}
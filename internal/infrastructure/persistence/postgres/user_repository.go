@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"time"
 
 	"soccer-manager-api/internal/domain"
 	"soccer-manager-api/internal/ports/repository"
@@ -13,6 +14,8 @@ import (
 	"go.uber.org/zap"
 )
 
+const userColumns = `id, email, role, password_hash, banned_at, email_verified_at, deletion_scheduled_at, totp_secret, totp_enabled_at, totp_last_step, created_at, updated_at`
+
 type userRepository struct {
 	db *sqlx.DB
 }
@@ -24,10 +27,10 @@ func NewUserRepository(db *sqlx.DB) repository.UserRepository {
 
 func (r *userRepository) Create(ctx context.Context, user *domain.User) error {
 	query := `
-		INSERT INTO users (id, email, password_hash, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5)
+		INSERT INTO users (id, email, role, password_hash, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
 	`
-	_, err := r.db.ExecContext(ctx, query, user.ID, user.Email, user.PasswordHash, user.CreatedAt, user.UpdatedAt)
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, user.ID, user.Email, user.Role, user.PasswordHash, user.CreatedAt, user.UpdatedAt)
 	if err != nil {
 		logger.Logger.Error("Failed to create user", zap.String("user_id", user.ID.String()), zap.String("email", user.Email), zap.Error(err))
 		return err
@@ -37,8 +40,8 @@ func (r *userRepository) Create(ctx context.Context, user *domain.User) error {
 
 func (r *userRepository) GetByID(ctx context.Context, id string) (*domain.User, error) {
 	var user domain.User
-	query := `SELECT id, email, password_hash, created_at, updated_at FROM users WHERE id = $1`
-	err := r.db.GetContext(ctx, &user, query, id)
+	query := `SELECT ` + userColumns + ` FROM users WHERE id = $1`
+	err := conn(ctx, r.db).GetContext(ctx, &user, query, id)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, domain.ErrUserNotFound
@@ -50,8 +53,8 @@ func (r *userRepository) GetByID(ctx context.Context, id string) (*domain.User,
 
 func (r *userRepository) GetByEmail(ctx context.Context, email string) (*domain.User, error) {
 	var user domain.User
-	query := `SELECT id, email, password_hash, created_at, updated_at FROM users WHERE email = $1`
-	err := r.db.GetContext(ctx, &user, query, email)
+	query := `SELECT ` + userColumns + ` FROM users WHERE email = $1`
+	err := conn(ctx, r.db).GetContext(ctx, &user, query, email)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, domain.ErrUserNotFound
@@ -64,16 +67,46 @@ func (r *userRepository) GetByEmail(ctx context.Context, email string) (*domain.
 func (r *userRepository) Update(ctx context.Context, user *domain.User) error {
 	query := `
 		UPDATE users 
-		SET email = $1, password_hash = $2, updated_at = $3
-		WHERE id = $4
+		SET email = :email, role = :role, password_hash = :password_hash, banned_at = :banned_at, email_verified_at = :email_verified_at,
+			deletion_scheduled_at = :deletion_scheduled_at, totp_secret = :totp_secret, totp_enabled_at = :totp_enabled_at,
+			totp_last_step = :totp_last_step, updated_at = :updated_at
+		WHERE id = :id
 	`
-	_, err := r.db.ExecContext(ctx, query, user.Email, user.PasswordHash, user.UpdatedAt, user.ID)
+	_, err := conn(ctx, r.db).NamedExecContext(ctx, query, user)
 	return err
 }
 
+func (r *userRepository) AdvanceTOTPStep(ctx context.Context, id string, step int64) error {
+	query := `UPDATE users SET totp_last_step = $1, updated_at = $2 WHERE id = $3 AND totp_last_step < $1`
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, step, time.Now(), id)
+	return requireRows(result, err, domain.ErrInvalidTwoFactorCode)
+}
+
 func (r *userRepository) Delete(ctx context.Context, id string) error {
 	query := `DELETE FROM users WHERE id = $1`
-	_, err := r.db.ExecContext(ctx, query, id)
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, id)
 	return err
 }
 
+
+func (r *userRepository) ListDueForDeletion(ctx context.Context, before time.Time) ([]*domain.User, error) {
+	var users []*domain.User
+	query := `SELECT ` + userColumns + ` FROM users WHERE deletion_scheduled_at <= $1 ORDER BY deletion_scheduled_at`
+	err := conn(ctx, r.db).SelectContext(ctx, &users, query, before)
+	return users, err
+}
+
+
+func (r *userRepository) Search(ctx context.Context, filter domain.UserFilter) ([]*domain.User, error) {
+	users := make([]*domain.User, 0)
+	query := `
+		SELECT ` + userColumns + ` FROM users
+		WHERE ($1 = '' OR email ILIKE '%' || $1 || '%' OR id IN (SELECT user_id FROM teams WHERE name ILIKE '%' || $1 || '%'))
+			AND ($2 = '' OR role = $2)
+			AND ($3 = '' OR ($3 = 'suspended') = (banned_at IS NOT NULL))
+		ORDER BY created_at DESC
+		LIMIT $4 OFFSET $5
+	`
+	err := conn(ctx, r.db).SelectContext(ctx, &users, query, filter.Query, filter.Role, filter.Status, filter.Limit, filter.Offset)
+	return users, err
+}
@@ -0,0 +1,92 @@
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"soccer-manager-api/internal/ports/repository"
+	"soccer-manager-api/pkg/logger"
+
+	"go.uber.org/zap"
+)
+
+
+type Job func(ctx context.Context) error
+
+type scheduledJob struct {
+	name     string
+	interval time.Duration
+	run      Job
+}
+
+
+type Scheduler struct {
+	jobs    []scheduledJob
+	jobRuns repository.JobRunRepository
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+}
+
+
+func New(jobRuns repository.JobRunRepository) *Scheduler {
+	return &Scheduler{jobRuns: jobRuns}
+}
+
+
+func (s *Scheduler) Every(name string, interval time.Duration, job Job) {
+	if interval <= 0 {
+		logger.Logger.Info("Scheduled job disabled", zap.String("job", name))
+		return
+	}
+	s.jobs = append(s.jobs, scheduledJob{name: name, interval: interval, run: job})
+}
+
+
+func (s *Scheduler) Start(ctx context.Context) {
+	ctx, s.cancel = context.WithCancel(ctx)
+
+	for _, job := range s.jobs {
+		s.wg.Add(1)
+		go func(job scheduledJob) {
+			defer s.wg.Done()
+
+			ticker := time.NewTicker(job.interval)
+			defer ticker.Stop()
+
+			logger.Logger.Info("Scheduled job started", zap.String("job", job.name), zap.Duration("interval", job.interval))
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					s.runOnce(ctx, job)
+				}
+			}
+		}(job)
+	}
+}
+
+func (s *Scheduler) runOnce(ctx context.Context, job scheduledJob) {
+	claimed, err := s.jobRuns.Claim(ctx, job.name, job.interval-job.interval/10)
+	if err != nil {
+		logger.Logger.Error("Scheduled job claim failed", zap.String("job", job.name), zap.Error(err))
+		return
+	}
+	if !claimed {
+		logger.Logger.Debug("Scheduled job already ran on another instance", zap.String("job", job.name))
+		return
+	}
+
+	if err := job.run(ctx); err != nil {
+		logger.Logger.Error("Scheduled job failed", zap.String("job", job.name), zap.Error(err))
+	}
+}
+
+
+func (s *Scheduler) Stop() {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.wg.Wait()
+}
@@ -0,0 +1,133 @@
+package handlers
+
+import (
+	"net/http"
+
+	"soccer-manager-api/internal/app/academy"
+	"soccer-manager-api/internal/domain"
+	"soccer-manager-api/pkg/localization"
+	"soccer-manager-api/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type AcademyHandler struct {
+	academyUseCase *academy.AcademyUseCase
+}
+
+func NewAcademyHandler(academyUseCase *academy.AcademyUseCase) *AcademyHandler {
+	return &AcademyHandler{academyUseCase: academyUseCase}
+}
+
+func (h *AcademyHandler) GetAcademy(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+	teamID := c.GetString("team_id")
+
+	result, err := h.academyUseCase.GetAcademy(c.Request.Context(), teamID)
+	if err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+func (h *AcademyHandler) PromoteProspect(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+	teamID := c.GetString("team_id")
+	prospectID := c.Param("prospect_id")
+
+	if _, err := uuid.Parse(prospectID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": localization.GetMessage(lang, "error.validation"),
+			"errors":  []string{"invalid prospect ID format"},
+		})
+		return
+	}
+
+	player, err := h.academyUseCase.PromoteProspect(c.Request.Context(), teamID, prospectID)
+	if err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"data":    player,
+		"message": localization.GetMessage(lang, "academy.prospect_promoted"),
+	})
+}
+
+func (h *AcademyHandler) ReleaseProspect(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+	teamID := c.GetString("team_id")
+	prospectID := c.Param("prospect_id")
+
+	if _, err := uuid.Parse(prospectID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": localization.GetMessage(lang, "error.validation"),
+			"errors":  []string{"invalid prospect ID format"},
+		})
+		return
+	}
+
+	if err := h.academyUseCase.ReleaseProspect(c.Request.Context(), teamID, prospectID); err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": localization.GetMessage(lang, "academy.prospect_released"),
+	})
+}
+
+func (h *AcademyHandler) RunIntake(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+
+	generated, err := h.academyUseCase.RunIntake(c.Request.Context())
+	if err != nil {
+		logger.Logger.Error("Academy intake failed", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"message": localization.GetMessage(lang, "error.internal"),
+			"errors":  []string{err.Error()},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    gin.H{"prospects_generated": generated},
+		"message": localization.GetMessage(lang, "academy.intake_completed"),
+	})
+}
+
+func (h *AcademyHandler) respondError(c *gin.Context, lang string, err error) {
+	statusCode := http.StatusInternalServerError
+	message := localization.GetMessage(lang, "error.internal")
+
+	if err == domain.ErrTeamNotFound {
+		statusCode = http.StatusNotFound
+		message = localization.GetMessage(lang, "team.not_found")
+	} else if err == domain.ErrProspectNotFound {
+		statusCode = http.StatusNotFound
+		message = localization.GetMessage(lang, "academy.prospect_not_found")
+	} else if err == domain.ErrTeamFull {
+		statusCode = http.StatusBadRequest
+		message = localization.GetMessage(lang, "transfer.team_full")
+	}
+
+	c.JSON(statusCode, gin.H{
+		"success": false,
+		"message": message,
+		"errors":  []string{err.Error()},
+	})
+}
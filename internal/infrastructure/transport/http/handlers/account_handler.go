@@ -0,0 +1,366 @@
+package handlers
+
+import (
+	"net/http"
+
+	"soccer-manager-api/internal/app/account"
+	"soccer-manager-api/internal/domain"
+	"soccer-manager-api/pkg/localization"
+	"soccer-manager-api/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type AccountHandler struct {
+	accountUseCase *account.AccountUseCase
+}
+
+func NewAccountHandler(accountUseCase *account.AccountUseCase) *AccountHandler {
+	return &AccountHandler{accountUseCase: accountUseCase}
+}
+
+func (h *AccountHandler) ForgotPassword(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+
+	var req account.ForgotPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": localization.GetMessage(lang, "error.validation"),
+			"errors":  []string{err.Error()},
+		})
+		return
+	}
+
+	if err := h.accountUseCase.ForgotPassword(c.Request.Context(), req, lang); err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": localization.GetMessage(lang, "auth.password_reset_sent"),
+	})
+}
+
+func (h *AccountHandler) ResetPassword(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+
+	var req account.ResetPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": localization.GetMessage(lang, "error.validation"),
+			"errors":  []string{err.Error()},
+		})
+		return
+	}
+
+	if err := h.accountUseCase.ResetPassword(c.Request.Context(), req, sessionClient(c)); err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": localization.GetMessage(lang, "auth.password_reset"),
+	})
+}
+
+func (h *AccountHandler) VerifyEmail(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+
+	user, err := h.accountUseCase.VerifyEmail(c.Request.Context(), c.Query("token"))
+	if err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    user,
+		"message": localization.GetMessage(lang, "auth.email_verified"),
+	})
+}
+
+func (h *AccountHandler) ResendVerification(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+	userID := c.GetString("user_id")
+
+	if err := h.accountUseCase.ResendVerification(c.Request.Context(), userID, lang); err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": localization.GetMessage(lang, "auth.verification_sent"),
+	})
+}
+
+func (h *AccountHandler) ChangePassword(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+	userID := c.GetString("user_id")
+
+	var req account.ChangePasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": localization.GetMessage(lang, "error.validation"),
+			"errors":  []string{err.Error()},
+		})
+		return
+	}
+
+	response, err := h.accountUseCase.ChangePassword(c.Request.Context(), userID, req, sessionClient(c))
+	if err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    response,
+		"message": localization.GetMessage(lang, "user.password_changed"),
+	})
+}
+
+func (h *AccountHandler) ChangeEmail(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+	userID := c.GetString("user_id")
+
+	var req account.ChangeEmailRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": localization.GetMessage(lang, "error.validation"),
+			"errors":  []string{err.Error()},
+		})
+		return
+	}
+
+	response, err := h.accountUseCase.ChangeEmail(c.Request.Context(), userID, req, sessionClient(c), lang)
+	if err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    response,
+		"message": localization.GetMessage(lang, "user.email_changed"),
+	})
+}
+
+func (h *AccountHandler) DeleteAccount(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+	userID := c.GetString("user_id")
+
+	var req account.DeleteAccountRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": localization.GetMessage(lang, "error.validation"),
+			"errors":  []string{err.Error()},
+		})
+		return
+	}
+
+	user, err := h.accountUseCase.ScheduleDeletion(c.Request.Context(), userID, req, sessionClient(c), lang)
+	if err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{
+		"success": true,
+		"data":    gin.H{"deletion_scheduled_at": user.DeletionScheduledAt},
+		"message": localization.GetMessage(lang, "user.deletion_scheduled"),
+	})
+}
+
+func (h *AccountHandler) ExportData(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+	userID := c.GetString("user_id")
+
+	export, err := h.accountUseCase.Export(c.Request.Context(), userID, sessionClient(c))
+	if err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.Header("Content-Disposition", `attachment; filename="soccer-manager-export-`+export.GeneratedAt.Format("20060102")+`.json"`)
+	c.JSON(http.StatusOK, export)
+}
+
+func (h *AccountHandler) RunDeletions(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+
+	deleted, err := h.accountUseCase.RunDeletions(c.Request.Context())
+	if err != nil {
+		logger.Logger.Error("Account deletion run failed", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"message": localization.GetMessage(lang, "error.internal"),
+			"errors":  []string{err.Error()},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    gin.H{"accounts_deleted": deleted},
+		"message": localization.GetMessage(lang, "user.deletions_completed"),
+	})
+}
+
+func (h *AccountHandler) SetupTwoFactor(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+	userID := c.GetString("user_id")
+
+	var req account.SetupTwoFactorRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": localization.GetMessage(lang, "error.validation"),
+			"errors":  []string{err.Error()},
+		})
+		return
+	}
+
+	setup, err := h.accountUseCase.SetupTwoFactor(c.Request.Context(), userID, req)
+	if err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    setup,
+		"message": localization.GetMessage(lang, "auth.two_factor_setup"),
+	})
+}
+
+func (h *AccountHandler) ConfirmTwoFactor(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+	userID := c.GetString("user_id")
+
+	var req account.TwoFactorCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": localization.GetMessage(lang, "error.validation"),
+			"errors":  []string{err.Error()},
+		})
+		return
+	}
+
+	user, err := h.accountUseCase.ConfirmTwoFactor(c.Request.Context(), userID, req, sessionClient(c))
+	if err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    user,
+		"message": localization.GetMessage(lang, "auth.two_factor_enabled"),
+	})
+}
+
+func (h *AccountHandler) DisableTwoFactor(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+	userID := c.GetString("user_id")
+
+	var req account.DisableTwoFactorRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": localization.GetMessage(lang, "error.validation"),
+			"errors":  []string{err.Error()},
+		})
+		return
+	}
+
+	user, err := h.accountUseCase.DisableTwoFactor(c.Request.Context(), userID, req, sessionClient(c))
+	if err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    user,
+		"message": localization.GetMessage(lang, "auth.two_factor_disabled"),
+	})
+}
+
+func (h *AccountHandler) RegenerateRecoveryCodes(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+	userID := c.GetString("user_id")
+
+	var req account.TwoFactorCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": localization.GetMessage(lang, "error.validation"),
+			"errors":  []string{err.Error()},
+		})
+		return
+	}
+
+	codes, err := h.accountUseCase.RegenerateRecoveryCodes(c.Request.Context(), userID, req, sessionClient(c))
+	if err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    gin.H{"recovery_codes": codes},
+		"message": localization.GetMessage(lang, "auth.recovery_codes_reset"),
+	})
+}
+
+func (h *AccountHandler) respondError(c *gin.Context, lang string, err error) {
+	statusCode := http.StatusInternalServerError
+	message := localization.GetMessage(lang, "error.internal")
+
+	if err == domain.ErrInvalidEmailToken {
+		statusCode = http.StatusBadRequest
+		message = localization.GetMessage(lang, "auth.invalid_email_token")
+	} else if err == domain.ErrEmailAlreadyVerified {
+		statusCode = http.StatusConflict
+		message = localization.GetMessage(lang, "auth.already_verified")
+	} else if err == domain.ErrInvalidCredentials {
+		statusCode = http.StatusForbidden
+		message = localization.GetMessage(lang, "user.invalid_password")
+	} else if err == domain.ErrUserAlreadyExists {
+		statusCode = http.StatusConflict
+		message = localization.GetMessage(lang, "user.already_exists")
+	} else if err == domain.ErrUserNotFound {
+		statusCode = http.StatusNotFound
+		message = localization.GetMessage(lang, "user.not_found")
+	} else if err == domain.ErrDeletionScheduled {
+		statusCode = http.StatusConflict
+		message = localization.GetMessage(lang, "user.deletion_pending")
+	} else if err == domain.ErrTwoFactorAlreadyEnabled {
+		statusCode = http.StatusConflict
+		message = localization.GetMessage(lang, "auth.two_factor_active")
+	} else if err == domain.ErrTwoFactorNotEnabled || err == domain.ErrTwoFactorNotPending {
+		statusCode = http.StatusConflict
+		message = localization.GetMessage(lang, "auth.two_factor_inactive")
+	} else if err == domain.ErrInvalidTwoFactorCode {
+		statusCode = http.StatusForbidden
+		message = localization.GetMessage(lang, "auth.invalid_two_factor_code")
+	} else {
+		logger.Logger.Error("Account request failed", zap.String("path", c.Request.URL.Path), zap.Error(err))
+	}
+
+	c.JSON(statusCode, gin.H{
+		"success": false,
+		"message": message,
+		"errors":  []string{err.Error()},
+	})
+}
@@ -0,0 +1,283 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"soccer-manager-api/internal/app/admin"
+	"soccer-manager-api/internal/domain"
+	"soccer-manager-api/pkg/localization"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AdminHandler struct {
+	adminUseCase *admin.AdminUseCase
+}
+
+func NewAdminHandler(adminUseCase *admin.AdminUseCase) *AdminHandler {
+	return &AdminHandler{adminUseCase: adminUseCase}
+}
+
+func (h *AdminHandler) ListUsers(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+
+	filter := domain.UserFilter{
+		Query:  c.Query("q"),
+		Role:   domain.Role(c.Query("role")),
+		Status: c.Query("status"),
+	}
+	if value := c.Query("limit"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"success": false,
+				"message": localization.GetMessage(lang, "error.validation"),
+				"errors":  []string{"invalid limit value"},
+			})
+			return
+		}
+		filter.Limit = parsed
+	}
+	if value := c.Query("offset"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"success": false,
+				"message": localization.GetMessage(lang, "error.validation"),
+				"errors":  []string{"invalid offset value"},
+			})
+			return
+		}
+		filter.Offset = parsed
+	}
+
+	users, err := h.adminUseCase.ListUsers(c.Request.Context(), filter)
+	if err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    users,
+	})
+}
+
+func (h *AdminHandler) GetUser(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+
+	details, err := h.adminUseCase.GetUser(c.Request.Context(), c.Param("user_id"))
+	if err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    details,
+	})
+}
+
+func (h *AdminHandler) SuspendUser(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+
+	var req admin.ReasonRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": localization.GetMessage(lang, "error.validation"),
+			"errors":  []string{err.Error()},
+		})
+		return
+	}
+
+	user, err := h.adminUseCase.SuspendUser(c.Request.Context(), staffActor(c), c.Param("user_id"), req)
+	if err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    user,
+		"message": localization.GetMessage(lang, "user.suspended"),
+	})
+}
+
+func (h *AdminHandler) UnsuspendUser(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+
+	user, err := h.adminUseCase.UnsuspendUser(c.Request.Context(), staffActor(c), c.Param("user_id"))
+	if err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    user,
+		"message": localization.GetMessage(lang, "user.unsuspended"),
+	})
+}
+
+func (h *AdminHandler) SetRole(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+
+	var req admin.SetRoleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": localization.GetMessage(lang, "error.validation"),
+			"errors":  []string{err.Error()},
+		})
+		return
+	}
+
+	user, err := h.adminUseCase.SetRole(c.Request.Context(), staffActor(c), c.Param("user_id"), req)
+	if err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    user,
+		"message": localization.GetMessage(lang, "user.role_changed"),
+	})
+}
+
+func (h *AdminHandler) AdjustBudget(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+
+	var req admin.AdjustBudgetRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": localization.GetMessage(lang, "error.validation"),
+			"errors":  []string{err.Error()},
+		})
+		return
+	}
+
+	transaction, err := h.adminUseCase.AdjustBudget(c.Request.Context(), staffActor(c), c.Param("team_id"), req)
+	if err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    transaction,
+		"message": localization.GetMessage(lang, "finance.budget_adjusted"),
+	})
+}
+
+func (h *AdminHandler) CancelListing(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+
+	var req admin.ReasonRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": localization.GetMessage(lang, "error.validation"),
+			"errors":  []string{err.Error()},
+		})
+		return
+	}
+
+	listing, err := h.adminUseCase.CancelListing(c.Request.Context(), staffActor(c), c.Param("listing_id"), req)
+	if err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    listing,
+		"message": localization.GetMessage(lang, "transfer.listing_cancelled"),
+	})
+}
+
+func (h *AdminHandler) ReverseTransfer(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+
+	var req admin.ReasonRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": localization.GetMessage(lang, "error.validation"),
+			"errors":  []string{err.Error()},
+		})
+		return
+	}
+
+	transfer, err := h.adminUseCase.ReverseTransfer(c.Request.Context(), staffActor(c), c.Param("transfer_id"), req)
+	if err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    transfer,
+		"message": localization.GetMessage(lang, "transfer.reversed"),
+	})
+}
+
+func (h *AdminHandler) respondError(c *gin.Context, lang string, err error) {
+	statusCode := http.StatusInternalServerError
+	message := localization.GetMessage(lang, "error.internal")
+
+	if err == domain.ErrUserNotFound {
+		statusCode = http.StatusNotFound
+		message = localization.GetMessage(lang, "user.not_found")
+	} else if err == domain.ErrInvalidRole {
+		statusCode = http.StatusBadRequest
+		message = localization.GetMessage(lang, "user.invalid_role")
+	} else if err == domain.ErrInvalidUserStatus {
+		statusCode = http.StatusBadRequest
+		message = localization.GetMessage(lang, "user.invalid_status")
+	} else if err == domain.ErrInsufficientRole {
+		statusCode = http.StatusForbidden
+		message = localization.GetMessage(lang, "user.insufficient_role")
+	} else if err == domain.ErrTeamNotFound {
+		statusCode = http.StatusNotFound
+		message = localization.GetMessage(lang, "team.not_found")
+	} else if err == domain.ErrTeamFull {
+		statusCode = http.StatusConflict
+		message = localization.GetMessage(lang, "transfer.team_full")
+	} else if err == domain.ErrPlayerNotFound {
+		statusCode = http.StatusNotFound
+		message = localization.GetMessage(lang, "player.not_found")
+	} else if err == domain.ErrTransferListingNotFound {
+		statusCode = http.StatusNotFound
+		message = localization.GetMessage(lang, "transfer.listing_not_found")
+	} else if err == domain.ErrTransferNotFound {
+		statusCode = http.StatusNotFound
+		message = localization.GetMessage(lang, "transfer.not_found")
+	} else if err == domain.ErrTransferAlreadyReversed {
+		statusCode = http.StatusConflict
+		message = localization.GetMessage(lang, "transfer.already_reversed")
+	} else if err == domain.ErrTransferNotReversible {
+		statusCode = http.StatusConflict
+		message = localization.GetMessage(lang, "transfer.not_reversible")
+	} else if err == domain.ErrPlayerTeamChanged {
+		statusCode = http.StatusConflict
+		message = localization.GetMessage(lang, "player.team_changed")
+	}
+
+	c.JSON(statusCode, gin.H{
+		"success": false,
+		"message": message,
+		"errors":  []string{err.Error()},
+	})
+}
+
+func staffActor(c *gin.Context) admin.Actor {
+	return admin.Actor{
+		UserID: c.GetString("user_id"),
+		Role:   domain.Role(c.GetString("role")),
+		Client: sessionClient(c),
+	}
+}
@@ -1,8 +1,12 @@
 package handlers
 
 import (
+	"errors"
 	"net/http"
+	"strconv"
+	"time"
 
+	"soccer-manager-api/internal/app/account"
 	"soccer-manager-api/internal/app/auth"
 	"soccer-manager-api/internal/domain"
 	"soccer-manager-api/pkg/localization"
@@ -13,11 +17,12 @@ import (
 )
 
 type AuthHandler struct {
-	authUseCase *auth.AuthUseCase
+	authUseCase    *auth.AuthUseCase
+	accountUseCase *account.AccountUseCase
 }
 
-func NewAuthHandler(authUseCase *auth.AuthUseCase) *AuthHandler {
-	return &AuthHandler{authUseCase: authUseCase}
+func NewAuthHandler(authUseCase *auth.AuthUseCase, accountUseCase *account.AccountUseCase) *AuthHandler {
+	return &AuthHandler{authUseCase: authUseCase, accountUseCase: accountUseCase}
 }
 
 func (h *AuthHandler) Register(c *gin.Context) {
@@ -33,7 +38,7 @@ func (h *AuthHandler) Register(c *gin.Context) {
 		return
 	}
 
-	response, err := h.authUseCase.Register(c.Request.Context(), req)
+	response, err := h.authUseCase.Register(c.Request.Context(), req, sessionClient(c))
 	if err != nil {
 		statusCode := http.StatusInternalServerError
 		message := localization.GetMessage(lang, "error.internal")
@@ -42,6 +47,15 @@ func (h *AuthHandler) Register(c *gin.Context) {
 			statusCode = http.StatusConflict
 			message = localization.GetMessage(lang, "user.already_exists")
 			logger.Logger.Warn("Registration failed: user already exists", zap.String("email", req.Email))
+		} else if err == domain.ErrTeamNameTaken {
+			statusCode = http.StatusConflict
+			message = localization.GetMessage(lang, "team.name_taken")
+		} else if err == domain.ErrInvalidCountry {
+			statusCode = http.StatusBadRequest
+			message = localization.GetMessage(lang, "team.invalid_country")
+		} else if err == domain.ErrInvalidCrestColour {
+			statusCode = http.StatusBadRequest
+			message = localization.GetMessage(lang, "team.invalid_crest")
 		} else {
 			logger.Logger.Error("Registration failed", zap.String("email", req.Email), zap.Error(err))
 		}
@@ -55,6 +69,9 @@ func (h *AuthHandler) Register(c *gin.Context) {
 	}
 
 	logger.Logger.Info("User registered successfully", zap.String("user_id", response.User.ID.String()), zap.String("email", req.Email))
+	if err := h.accountUseCase.SendVerification(c.Request.Context(), response.User, lang); err != nil {
+		logger.Logger.Error("Failed to send verification email", zap.String("user_id", response.User.ID.String()), zap.Error(err))
+	}
 
 	c.JSON(http.StatusCreated, gin.H{
 		"success": true,
@@ -76,11 +93,18 @@ func (h *AuthHandler) Login(c *gin.Context) {
 		return
 	}
 
-	response, err := h.authUseCase.Login(c.Request.Context(), req)
+	response, challenge, err := h.authUseCase.Login(c.Request.Context(), req, sessionClient(c))
 	if err != nil {
 		logger.Logger.Warn("Login failed", zap.String("email", req.Email), zap.Error(err))
 		statusCode := http.StatusUnauthorized
 		message := localization.GetMessage(lang, "user.invalid_credentials")
+		var throttled *domain.LoginThrottleError
+		if err == domain.ErrUserBanned {
+			statusCode = http.StatusForbidden
+			message = localization.GetMessage(lang, "user.banned")
+		} else if errors.As(err, &throttled) {
+			statusCode, message = throttledResponse(c, lang, throttled)
+		}
 
 		c.JSON(statusCode, gin.H{
 			"success": false,
@@ -90,6 +114,15 @@ func (h *AuthHandler) Login(c *gin.Context) {
 		return
 	}
 
+	if challenge != nil {
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    gin.H{"two_factor_required": true, "challenge": challenge},
+			"message": localization.GetMessage(lang, "auth.two_factor_required"),
+		})
+		return
+	}
+
 	logger.Logger.Info("User logged in successfully", zap.String("user_id", response.User.ID.String()), zap.String("email", req.Email))
 
 	c.JSON(http.StatusOK, gin.H{
@@ -99,3 +132,152 @@ func (h *AuthHandler) Login(c *gin.Context) {
 	})
 }
 
+func (h *AuthHandler) VerifyTwoFactor(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+
+	var req auth.VerifyTwoFactorRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": localization.GetMessage(lang, "error.validation"),
+			"errors":  []string{err.Error()},
+		})
+		return
+	}
+
+	response, err := h.authUseCase.VerifyTwoFactor(c.Request.Context(), req, sessionClient(c))
+	if err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	logger.Logger.Info("User logged in with two-factor authentication", zap.String("user_id", response.User.ID.String()))
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    response,
+		"message": localization.GetMessage(lang, "user.login.success"),
+	})
+}
+
+func (h *AuthHandler) Refresh(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+
+	var req auth.RefreshRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": localization.GetMessage(lang, "error.validation"),
+			"errors":  []string{err.Error()},
+		})
+		return
+	}
+
+	response, err := h.authUseCase.Refresh(c.Request.Context(), req)
+	if err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    response,
+		"message": localization.GetMessage(lang, "auth.refreshed"),
+	})
+}
+
+func (h *AuthHandler) Logout(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+	userID := c.GetString("user_id")
+
+	if err := h.authUseCase.Logout(c.Request.Context(), userID, c.GetString("session_id"), c.GetString("token_id")); err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": localization.GetMessage(lang, "auth.logged_out"),
+	})
+}
+
+func (h *AuthHandler) ListSessions(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+	userID := c.GetString("user_id")
+
+	sessions, err := h.authUseCase.ListSessions(c.Request.Context(), userID, c.GetString("session_id"))
+	if err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    sessions,
+	})
+}
+
+func (h *AuthHandler) RevokeSession(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+	userID := c.GetString("user_id")
+
+	if err := h.authUseCase.RevokeSession(c.Request.Context(), userID, c.Param("session_id")); err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": localization.GetMessage(lang, "auth.session_revoked"),
+	})
+}
+
+func (h *AuthHandler) respondError(c *gin.Context, lang string, err error) {
+	statusCode := http.StatusInternalServerError
+	message := localization.GetMessage(lang, "error.internal")
+
+	var throttled *domain.LoginThrottleError
+	if errors.As(err, &throttled) {
+		statusCode, message = throttledResponse(c, lang, throttled)
+	} else if err == domain.ErrInvalidRefreshToken || err == domain.ErrRefreshTokenReused {
+		statusCode = http.StatusUnauthorized
+		message = localization.GetMessage(lang, "auth.invalid_refresh")
+	} else if err == domain.ErrSessionNotFound {
+		statusCode = http.StatusNotFound
+		message = localization.GetMessage(lang, "auth.session_not_found")
+	} else if err == domain.ErrUserBanned {
+		statusCode = http.StatusForbidden
+		message = localization.GetMessage(lang, "user.banned")
+	} else if err == domain.ErrUserNotFound {
+		statusCode = http.StatusNotFound
+		message = localization.GetMessage(lang, "user.not_found")
+	} else if err == domain.ErrInvalidChallenge {
+		statusCode = http.StatusUnauthorized
+		message = localization.GetMessage(lang, "auth.invalid_challenge")
+	} else if err == domain.ErrInvalidTwoFactorCode {
+		statusCode = http.StatusUnauthorized
+		message = localization.GetMessage(lang, "auth.invalid_two_factor_code")
+	}
+
+	c.JSON(statusCode, gin.H{
+		"success": false,
+		"message": message,
+		"errors":  []string{err.Error()},
+	})
+}
+
+func throttledResponse(c *gin.Context, lang string, throttled *domain.LoginThrottleError) (int, string) {
+	seconds := strconv.Itoa(int((throttled.RetryAfter + time.Second - 1) / time.Second))
+	c.Header("Retry-After", seconds)
+	if throttled.Err == domain.ErrAccountLocked {
+		return http.StatusLocked, localization.FormatMessage(lang, "auth.account_locked", map[string]string{"seconds": seconds})
+	}
+	return http.StatusTooManyRequests, localization.FormatMessage(lang, "auth.too_many_attempts", map[string]string{"seconds": seconds})
+}
+
+func sessionClient(c *gin.Context) domain.SessionClient {
+	return domain.SessionClient{
+		UserAgent: c.Request.UserAgent(),
+		IPAddress: c.ClientIP(),
+	}
+}
@@ -0,0 +1,159 @@
+package handlers
+
+import (
+	"net/http"
+
+	"soccer-manager-api/internal/app/availability"
+	"soccer-manager-api/internal/domain"
+	"soccer-manager-api/pkg/localization"
+	"soccer-manager-api/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type AvailabilityHandler struct {
+	availabilityUseCase *availability.AvailabilityUseCase
+}
+
+func NewAvailabilityHandler(availabilityUseCase *availability.AvailabilityUseCase) *AvailabilityHandler {
+	return &AvailabilityHandler{availabilityUseCase: availabilityUseCase}
+}
+
+func (h *AvailabilityHandler) GetInjuryHistory(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+	playerID := c.Param("id")
+
+	if _, err := uuid.Parse(playerID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": localization.GetMessage(lang, "error.validation"),
+			"errors":  []string{"invalid player ID format"},
+		})
+		return
+	}
+
+	absences, err := h.availabilityUseCase.GetInjuryHistory(c.Request.Context(), playerID)
+	if err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    absences,
+	})
+}
+
+func (h *AvailabilityHandler) RecordInjury(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+	playerID := c.Param("id")
+
+	if _, err := uuid.Parse(playerID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": localization.GetMessage(lang, "error.validation"),
+			"errors":  []string{"invalid player ID format"},
+		})
+		return
+	}
+
+	var req availability.RecordInjuryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": localization.GetMessage(lang, "error.validation"),
+			"errors":  []string{err.Error()},
+		})
+		return
+	}
+
+	player, err := h.availabilityUseCase.RecordInjury(c.Request.Context(), playerID, req)
+	if err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    player,
+		"message": localization.GetMessage(lang, "availability.injured"),
+	})
+}
+
+func (h *AvailabilityHandler) RecordSuspension(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+	playerID := c.Param("id")
+
+	if _, err := uuid.Parse(playerID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": localization.GetMessage(lang, "error.validation"),
+			"errors":  []string{"invalid player ID format"},
+		})
+		return
+	}
+
+	var req availability.RecordSuspensionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": localization.GetMessage(lang, "error.validation"),
+			"errors":  []string{err.Error()},
+		})
+		return
+	}
+
+	player, err := h.availabilityUseCase.RecordSuspension(c.Request.Context(), playerID, req)
+	if err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    player,
+		"message": localization.GetMessage(lang, "availability.suspended"),
+	})
+}
+
+func (h *AvailabilityHandler) RunRecovery(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+
+	recovered, err := h.availabilityUseCase.RunRecovery(c.Request.Context())
+	if err != nil {
+		logger.Logger.Error("Recovery run failed", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"message": localization.GetMessage(lang, "error.internal"),
+			"errors":  []string{err.Error()},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    gin.H{"players_recovered": recovered},
+		"message": localization.GetMessage(lang, "availability.recovered"),
+	})
+}
+
+func (h *AvailabilityHandler) respondError(c *gin.Context, lang string, err error) {
+	statusCode := http.StatusInternalServerError
+	message := localization.GetMessage(lang, "error.internal")
+
+	if err == domain.ErrPlayerNotFound {
+		statusCode = http.StatusNotFound
+		message = localization.GetMessage(lang, "player.not_found")
+	} else if err == domain.ErrInvalidAbsence {
+		statusCode = http.StatusBadRequest
+		message = localization.GetMessage(lang, "availability.invalid")
+	}
+
+	c.JSON(statusCode, gin.H{
+		"success": false,
+		"message": message,
+		"errors":  []string{err.Error()},
+	})
+}
@@ -0,0 +1,131 @@
+package handlers
+
+import (
+	"net/http"
+
+	"soccer-manager-api/internal/app/bot"
+	"soccer-manager-api/internal/domain"
+	"soccer-manager-api/pkg/localization"
+	"soccer-manager-api/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type BotHandler struct {
+	botUseCase *bot.BotUseCase
+}
+
+func NewBotHandler(botUseCase *bot.BotUseCase) *BotHandler {
+	return &BotHandler{botUseCase: botUseCase}
+}
+
+func (h *BotHandler) ListBots(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+
+	teams, err := h.botUseCase.ListBots(c.Request.Context())
+	if err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    teams,
+	})
+}
+
+func (h *BotHandler) CreateBot(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+
+	var req bot.CreateBotRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"success": false,
+				"message": localization.GetMessage(lang, "error.validation"),
+				"errors":  []string{err.Error()},
+			})
+			return
+		}
+	}
+
+	team, err := h.botUseCase.CreateBot(c.Request.Context(), req)
+	if err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"data":    team,
+		"message": localization.GetMessage(lang, "bot.created"),
+	})
+}
+
+func (h *BotHandler) DeleteBot(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+	teamID := c.Param("team_id")
+
+	if _, err := uuid.Parse(teamID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": localization.GetMessage(lang, "error.validation"),
+			"errors":  []string{"invalid team ID format"},
+		})
+		return
+	}
+
+	if err := h.botUseCase.DeleteBot(c.Request.Context(), teamID); err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": localization.GetMessage(lang, "bot.deleted"),
+	})
+}
+
+func (h *BotHandler) RunBots(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+
+	activity, err := h.botUseCase.RunBots(c.Request.Context())
+	if err != nil {
+		logger.Logger.Error("Bot run failed", zap.Error(err))
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    activity,
+		"message": localization.GetMessage(lang, "bot.run_completed"),
+	})
+}
+
+func (h *BotHandler) respondError(c *gin.Context, lang string, err error) {
+	statusCode := http.StatusInternalServerError
+	message := localization.GetMessage(lang, "error.internal")
+
+	if err == domain.ErrTeamNotFound {
+		statusCode = http.StatusNotFound
+		message = localization.GetMessage(lang, "team.not_found")
+	} else if err == domain.ErrNotBotTeam {
+		statusCode = http.StatusBadRequest
+		message = localization.GetMessage(lang, "bot.not_bot")
+	} else if err == domain.ErrTeamNameTaken {
+		statusCode = http.StatusConflict
+		message = localization.GetMessage(lang, "team.name_taken")
+	} else if err == domain.ErrInvalidCountry {
+		statusCode = http.StatusBadRequest
+		message = localization.GetMessage(lang, "team.invalid_country")
+	}
+
+	c.JSON(statusCode, gin.H{
+		"success": false,
+		"message": message,
+		"errors":  []string{err.Error()},
+	})
+}
@@ -0,0 +1,173 @@
+package handlers
+
+import (
+	"net/http"
+
+	"soccer-manager-api/internal/app/contract"
+	"soccer-manager-api/internal/domain"
+	"soccer-manager-api/pkg/localization"
+	"soccer-manager-api/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type ContractHandler struct {
+	contractUseCase *contract.ContractUseCase
+}
+
+func NewContractHandler(contractUseCase *contract.ContractUseCase) *ContractHandler {
+	return &ContractHandler{contractUseCase: contractUseCase}
+}
+
+func (h *ContractHandler) GetContract(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+	playerID := c.Param("id")
+
+	if _, err := uuid.Parse(playerID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": localization.GetMessage(lang, "error.validation"),
+			"errors":  []string{"invalid player ID format"},
+		})
+		return
+	}
+
+	result, err := h.contractUseCase.GetContract(c.Request.Context(), c.GetString("team_id"), playerID)
+	if err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+func (h *ContractHandler) GetTeamPayroll(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+	teamID := c.GetString("team_id")
+
+	payroll, err := h.contractUseCase.GetTeamPayroll(c.Request.Context(), teamID)
+	if err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    payroll,
+	})
+}
+
+func (h *ContractHandler) RenegotiateContract(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+	teamID := c.GetString("team_id")
+	playerID := c.Param("id")
+
+	if _, err := uuid.Parse(playerID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": localization.GetMessage(lang, "error.validation"),
+			"errors":  []string{"invalid player ID format"},
+		})
+		return
+	}
+
+	var req contract.RenegotiateContractRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": localization.GetMessage(lang, "error.validation"),
+			"errors":  []string{err.Error()},
+		})
+		return
+	}
+
+	result, err := h.contractUseCase.RenegotiateContract(c.Request.Context(), teamID, playerID, req)
+	if err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+		"message": localization.GetMessage(lang, "contract.renegotiated"),
+	})
+}
+
+func (h *ContractHandler) RunPayroll(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+
+	total, err := h.contractUseCase.RunPayroll(c.Request.Context())
+	if err != nil {
+		logger.Logger.Error("Payroll run failed", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"message": localization.GetMessage(lang, "error.internal"),
+			"errors":  []string{err.Error()},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    gin.H{"total_wages": total},
+		"message": localization.GetMessage(lang, "contract.payroll_completed"),
+	})
+}
+
+func (h *ContractHandler) RunExpiry(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+
+	expired, err := h.contractUseCase.RunExpiry(c.Request.Context())
+	if err != nil {
+		logger.Logger.Error("Contract expiry run failed", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"message": localization.GetMessage(lang, "error.internal"),
+			"errors":  []string{err.Error()},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    gin.H{"contracts_expired": expired},
+		"message": localization.GetMessage(lang, "contract.expiry_completed"),
+	})
+}
+
+func (h *ContractHandler) respondError(c *gin.Context, lang string, err error) {
+	statusCode := http.StatusInternalServerError
+	message := localization.GetMessage(lang, "error.internal")
+
+	if err == domain.ErrTeamNotFound {
+		statusCode = http.StatusNotFound
+		message = localization.GetMessage(lang, "team.not_found")
+	} else if err == domain.ErrPlayerNotFound {
+		statusCode = http.StatusNotFound
+		message = localization.GetMessage(lang, "player.not_found")
+	} else if err == domain.ErrPlayerNotOwned {
+		statusCode = http.StatusForbidden
+		message = localization.GetMessage(lang, "player.not_owned")
+	} else if err == domain.ErrPlayerNotScouted {
+		statusCode = http.StatusForbidden
+		message = localization.GetMessage(lang, "player.not_scouted")
+	} else if err == domain.ErrContractNotFound {
+		statusCode = http.StatusNotFound
+		message = localization.GetMessage(lang, "contract.not_found")
+	} else if err == domain.ErrInvalidContractLength || err == domain.ErrWageTooLow {
+		statusCode = http.StatusBadRequest
+		message = localization.GetMessage(lang, "contract.invalid")
+	}
+
+	c.JSON(statusCode, gin.H{
+		"success": false,
+		"message": message,
+		"errors":  []string{err.Error()},
+	})
+}
@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"net/http"
+
+	"soccer-manager-api/internal/app/draft"
+	"soccer-manager-api/internal/domain"
+	"soccer-manager-api/pkg/localization"
+
+	"github.com/gin-gonic/gin"
+)
+
+type DraftHandler struct {
+	draftUseCase *draft.DraftUseCase
+}
+
+func NewDraftHandler(draftUseCase *draft.DraftUseCase) *DraftHandler {
+	return &DraftHandler{draftUseCase: draftUseCase}
+}
+
+func (h *DraftHandler) GetDraft(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+	teamID := c.GetString("team_id")
+
+	board, err := h.draftUseCase.GetDraft(c.Request.Context(), teamID)
+	if err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    board,
+	})
+}
+
+func (h *DraftHandler) Pick(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+	teamID := c.GetString("team_id")
+
+	var req draft.PickRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": localization.GetMessage(lang, "error.validation"),
+			"errors":  []string{err.Error()},
+		})
+		return
+	}
+
+	board, err := h.draftUseCase.Pick(c.Request.Context(), teamID, req)
+	if err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	message := localization.GetMessage(lang, "draft.picked")
+	if board.Complete {
+		message = localization.GetMessage(lang, "draft.complete")
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    board,
+		"message": message,
+	})
+}
+
+func (h *DraftHandler) respondError(c *gin.Context, lang string, err error) {
+	statusCode := http.StatusInternalServerError
+	message := localization.GetMessage(lang, "error.internal")
+
+	if err == domain.ErrTeamNotFound {
+		statusCode = http.StatusNotFound
+		message = localization.GetMessage(lang, "team.not_found")
+	} else if err == domain.ErrDraftNotFound {
+		statusCode = http.StatusNotFound
+		message = localization.GetMessage(lang, "draft.not_found")
+	} else if err == domain.ErrInvalidDraftPick {
+		statusCode = http.StatusBadRequest
+		message = localization.GetMessage(lang, "draft.invalid_pick")
+	}
+
+	c.JSON(statusCode, gin.H{
+		"success": false,
+		"message": message,
+		"errors":  []string{err.Error()},
+	})
+}
@@ -0,0 +1,141 @@
+package handlers
+
+import (
+	"net/http"
+
+	"soccer-manager-api/internal/app/facility"
+	"soccer-manager-api/internal/domain"
+	"soccer-manager-api/pkg/localization"
+	"soccer-manager-api/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type FacilityHandler struct {
+	facilityUseCase *facility.FacilityUseCase
+}
+
+func NewFacilityHandler(facilityUseCase *facility.FacilityUseCase) *FacilityHandler {
+	return &FacilityHandler{facilityUseCase: facilityUseCase}
+}
+
+func (h *FacilityHandler) GetFacilities(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+	teamID := c.GetString("team_id")
+
+	overview, err := h.facilityUseCase.GetFacilities(c.Request.Context(), teamID)
+	if err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    overview,
+	})
+}
+
+func (h *FacilityHandler) StartUpgrade(c *gin.Context) {
+	h.startUpgrade(c, c.Param("facility"))
+}
+
+func (h *FacilityHandler) UpgradeAcademy(c *gin.Context) {
+	h.startUpgrade(c, string(domain.FacilityAcademy))
+}
+
+func (h *FacilityHandler) startUpgrade(c *gin.Context, facilityType string) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+	teamID := c.GetString("team_id")
+
+	project, err := h.facilityUseCase.StartUpgrade(c.Request.Context(), teamID, facilityType)
+	if err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{
+		"success": true,
+		"data":    project,
+		"message": localization.GetMessage(lang, "facility.upgrade_started"),
+	})
+}
+
+func (h *FacilityHandler) UpdateTicketPrice(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+	teamID := c.GetString("team_id")
+
+	var req facility.UpdateTicketPriceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": localization.GetMessage(lang, "error.validation"),
+			"errors":  []string{err.Error()},
+		})
+		return
+	}
+
+	facilities, err := h.facilityUseCase.UpdateTicketPrice(c.Request.Context(), teamID, req)
+	if err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    facilities,
+		"message": localization.GetMessage(lang, "facility.price_updated"),
+	})
+}
+
+func (h *FacilityHandler) RunConstruction(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+
+	completed, err := h.facilityUseCase.RunConstruction(c.Request.Context())
+	if err != nil {
+		logger.Logger.Error("Construction run failed", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"message": localization.GetMessage(lang, "error.internal"),
+			"errors":  []string{err.Error()},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    gin.H{"projects_completed": completed},
+		"message": localization.GetMessage(lang, "facility.construction_run"),
+	})
+}
+
+func (h *FacilityHandler) respondError(c *gin.Context, lang string, err error) {
+	statusCode := http.StatusInternalServerError
+	message := localization.GetMessage(lang, "error.internal")
+
+	if err == domain.ErrTeamNotFound {
+		statusCode = http.StatusNotFound
+		message = localization.GetMessage(lang, "team.not_found")
+	} else if err == domain.ErrInvalidFacility {
+		statusCode = http.StatusBadRequest
+		message = localization.GetMessage(lang, "facility.invalid")
+	} else if err == domain.ErrFacilityMaxLevel {
+		statusCode = http.StatusBadRequest
+		message = localization.GetMessage(lang, "facility.max_level")
+	} else if err == domain.ErrConstructionInProgress {
+		statusCode = http.StatusConflict
+		message = localization.GetMessage(lang, "facility.in_progress")
+	} else if err == domain.ErrInvalidTicketPrice {
+		statusCode = http.StatusBadRequest
+		message = localization.GetMessage(lang, "facility.invalid_price")
+	} else if err == domain.ErrInsufficientBudget {
+		statusCode = http.StatusBadRequest
+		message = localization.GetMessage(lang, "transfer.insufficient_budget")
+	}
+
+	c.JSON(statusCode, gin.H{
+		"success": false,
+		"message": message,
+		"errors":  []string{err.Error()},
+	})
+}
@@ -0,0 +1,193 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"soccer-manager-api/internal/app/finance"
+	"soccer-manager-api/internal/domain"
+	"soccer-manager-api/pkg/localization"
+	"soccer-manager-api/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type FinanceHandler struct {
+	financeUseCase *finance.FinanceUseCase
+}
+
+func NewFinanceHandler(financeUseCase *finance.FinanceUseCase) *FinanceHandler {
+	return &FinanceHandler{financeUseCase: financeUseCase}
+}
+
+func (h *FinanceHandler) GetTransactions(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+	teamID := c.GetString("team_id")
+
+	transactions, err := h.financeUseCase.GetTransactions(c.Request.Context(), teamID)
+	if err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    transactions,
+	})
+}
+
+func (h *FinanceHandler) GetForecast(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+	teamID := c.GetString("team_id")
+
+	weeks := 0
+	if value := c.Query("weeks"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"success": false,
+				"message": localization.GetMessage(lang, "error.validation"),
+				"errors":  []string{"invalid weeks value"},
+			})
+			return
+		}
+		weeks = parsed
+	}
+
+	forecast, err := h.financeUseCase.GetForecast(c.Request.Context(), teamID, weeks)
+	if err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    forecast,
+	})
+}
+
+func (h *FinanceHandler) GetSponsorships(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+	teamID := c.GetString("team_id")
+
+	sponsorships, err := h.financeUseCase.GetSponsorships(c.Request.Context(), teamID)
+	if err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    sponsorships,
+	})
+}
+
+func (h *FinanceHandler) AcceptSponsorship(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+	teamID := c.GetString("team_id")
+	offerID := c.Param("offer_id")
+
+	if _, err := uuid.Parse(offerID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": localization.GetMessage(lang, "error.validation"),
+			"errors":  []string{"invalid offer ID format"},
+		})
+		return
+	}
+
+	deal, err := h.financeUseCase.AcceptSponsorship(c.Request.Context(), teamID, offerID)
+	if err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    deal,
+		"message": localization.GetMessage(lang, "sponsorship.accepted"),
+	})
+}
+
+func (h *FinanceHandler) AwardPrize(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+
+	var req finance.AwardPrizeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": localization.GetMessage(lang, "error.validation"),
+			"errors":  []string{err.Error()},
+		})
+		return
+	}
+
+	if _, err := uuid.Parse(req.TeamID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": localization.GetMessage(lang, "error.validation"),
+			"errors":  []string{"invalid team ID format"},
+		})
+		return
+	}
+
+	transaction, err := h.financeUseCase.AwardPrize(c.Request.Context(), req)
+	if err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    transaction,
+		"message": localization.GetMessage(lang, "finance.prize_awarded"),
+	})
+}
+
+func (h *FinanceHandler) RunSponsorshipPayments(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+
+	paid, err := h.financeUseCase.RunSponsorshipPayments(c.Request.Context())
+	if err != nil {
+		logger.Logger.Error("Sponsorship payments failed", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"message": localization.GetMessage(lang, "error.internal"),
+			"errors":  []string{err.Error()},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    gin.H{"payments": paid},
+		"message": localization.GetMessage(lang, "sponsorship.payments_completed"),
+	})
+}
+
+func (h *FinanceHandler) respondError(c *gin.Context, lang string, err error) {
+	statusCode := http.StatusInternalServerError
+	message := localization.GetMessage(lang, "error.internal")
+
+	if err == domain.ErrTeamNotFound {
+		statusCode = http.StatusNotFound
+		message = localization.GetMessage(lang, "team.not_found")
+	} else if err == domain.ErrSponsorshipNotFound {
+		statusCode = http.StatusNotFound
+		message = localization.GetMessage(lang, "sponsorship.not_found")
+	} else if err == domain.ErrSponsorshipActive {
+		statusCode = http.StatusConflict
+		message = localization.GetMessage(lang, "sponsorship.active")
+	} else if err == domain.ErrInvalidPrize {
+		statusCode = http.StatusBadRequest
+		message = localization.GetMessage(lang, "finance.invalid_prize")
+	}
+
+	c.JSON(statusCode, gin.H{
+		"success": false,
+		"message": message,
+		"errors":  []string{err.Error()},
+	})
+}
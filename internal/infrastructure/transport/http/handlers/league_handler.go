@@ -0,0 +1,223 @@
+package handlers
+
+import (
+	"net/http"
+
+	"soccer-manager-api/internal/app/league"
+	"soccer-manager-api/internal/domain"
+	"soccer-manager-api/pkg/localization"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type LeagueHandler struct {
+	leagueUseCase *league.LeagueUseCase
+}
+
+func NewLeagueHandler(leagueUseCase *league.LeagueUseCase) *LeagueHandler {
+	return &LeagueHandler{leagueUseCase: leagueUseCase}
+}
+
+func (h *LeagueHandler) CreateLeague(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+	teamID := c.GetString("team_id")
+
+	var req league.CreateLeagueRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": localization.GetMessage(lang, "error.validation"),
+			"errors":  []string{err.Error()},
+		})
+		return
+	}
+
+	result, err := h.leagueUseCase.CreateLeague(c.Request.Context(), teamID, req)
+	if err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"data":    result,
+		"message": localization.GetMessage(lang, "league.created"),
+	})
+}
+
+func (h *LeagueHandler) JoinLeague(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+	teamID := c.GetString("team_id")
+
+	var req league.JoinLeagueRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": localization.GetMessage(lang, "error.validation"),
+			"errors":  []string{err.Error()},
+		})
+		return
+	}
+
+	result, err := h.leagueUseCase.JoinLeague(c.Request.Context(), teamID, req)
+	if err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+		"message": localization.GetMessage(lang, "league.joined"),
+	})
+}
+
+func (h *LeagueHandler) GetLeague(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+	teamID := c.GetString("team_id")
+
+	result, err := h.leagueUseCase.GetLeague(c.Request.Context(), teamID)
+	if err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+func (h *LeagueHandler) UpdateSettings(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+	teamID := c.GetString("team_id")
+
+	var req league.LeagueSettingsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": localization.GetMessage(lang, "error.validation"),
+			"errors":  []string{err.Error()},
+		})
+		return
+	}
+
+	result, err := h.leagueUseCase.UpdateSettings(c.Request.Context(), teamID, req)
+	if err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+		"message": localization.GetMessage(lang, "league.updated"),
+	})
+}
+
+func (h *LeagueHandler) RegenerateInviteCode(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+	teamID := c.GetString("team_id")
+
+	result, err := h.leagueUseCase.RegenerateInviteCode(c.Request.Context(), teamID)
+	if err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+		"message": localization.GetMessage(lang, "league.updated"),
+	})
+}
+
+func (h *LeagueHandler) LeaveLeague(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+	teamID := c.GetString("team_id")
+
+	if err := h.leagueUseCase.LeaveLeague(c.Request.Context(), teamID); err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": localization.GetMessage(lang, "league.left"),
+	})
+}
+
+func (h *LeagueHandler) RemoveMember(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+	teamID := c.GetString("team_id")
+	memberID := c.Param("member_id")
+
+	if _, err := uuid.Parse(memberID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": localization.GetMessage(lang, "error.validation"),
+			"errors":  []string{"invalid team ID format"},
+		})
+		return
+	}
+
+	if err := h.leagueUseCase.RemoveMember(c.Request.Context(), teamID, memberID); err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": localization.GetMessage(lang, "league.member_removed"),
+	})
+}
+
+func (h *LeagueHandler) DisbandLeague(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+	teamID := c.GetString("team_id")
+
+	if err := h.leagueUseCase.DisbandLeague(c.Request.Context(), teamID); err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": localization.GetMessage(lang, "league.disbanded"),
+	})
+}
+
+func (h *LeagueHandler) respondError(c *gin.Context, lang string, err error) {
+	statusCode := http.StatusInternalServerError
+	message := localization.GetMessage(lang, "error.internal")
+
+	if err == domain.ErrTeamNotFound {
+		statusCode = http.StatusNotFound
+		message = localization.GetMessage(lang, "team.not_found")
+	} else if err == domain.ErrLeagueNotFound {
+		statusCode = http.StatusNotFound
+		message = localization.GetMessage(lang, "league.not_found")
+	} else if err == domain.ErrAlreadyInLeague {
+		statusCode = http.StatusConflict
+		message = localization.GetMessage(lang, "league.already_member")
+	} else if err == domain.ErrLeagueFull {
+		statusCode = http.StatusConflict
+		message = localization.GetMessage(lang, "league.full")
+	} else if err == domain.ErrNotCommissioner {
+		statusCode = http.StatusForbidden
+		message = localization.GetMessage(lang, "league.not_commissioner")
+	} else if err == domain.ErrCommissionerCannotLeave {
+		statusCode = http.StatusBadRequest
+		message = localization.GetMessage(lang, "league.commissioner_stays")
+	} else if err == domain.ErrInvalidLeagueSettings {
+		statusCode = http.StatusBadRequest
+		message = localization.GetMessage(lang, "league.invalid_settings")
+	}
+
+	c.JSON(statusCode, gin.H{
+		"success": false,
+		"message": message,
+		"errors":  []string{err.Error()},
+	})
+}
@@ -0,0 +1,98 @@
+package handlers
+
+import (
+	"net/http"
+
+	"soccer-manager-api/internal/app/lineup"
+	"soccer-manager-api/internal/domain"
+	"soccer-manager-api/pkg/localization"
+
+	"github.com/gin-gonic/gin"
+)
+
+type LineupHandler struct {
+	lineupUseCase *lineup.LineupUseCase
+}
+
+func NewLineupHandler(lineupUseCase *lineup.LineupUseCase) *LineupHandler {
+	return &LineupHandler{lineupUseCase: lineupUseCase}
+}
+
+func (h *LineupHandler) GetLineup(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+	teamID := c.GetString("team_id")
+
+	result, err := h.lineupUseCase.GetLineup(c.Request.Context(), teamID)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		message := localization.GetMessage(lang, "error.internal")
+
+		if err == domain.ErrTeamNotFound {
+			statusCode = http.StatusNotFound
+			message = localization.GetMessage(lang, "team.not_found")
+		} else if err == domain.ErrLineupNotFound {
+			statusCode = http.StatusNotFound
+			message = localization.GetMessage(lang, "lineup.not_found")
+		}
+
+		c.JSON(statusCode, gin.H{
+			"success": false,
+			"message": message,
+			"errors":  []string{err.Error()},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+func (h *LineupHandler) UpdateLineup(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+	teamID := c.GetString("team_id")
+
+	var req lineup.UpdateLineupRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": localization.GetMessage(lang, "error.validation"),
+			"errors":  []string{err.Error()},
+		})
+		return
+	}
+
+	result, err := h.lineupUseCase.UpdateLineup(c.Request.Context(), teamID, req)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		message := localization.GetMessage(lang, "error.internal")
+
+		if err == domain.ErrTeamNotFound {
+			statusCode = http.StatusNotFound
+			message = localization.GetMessage(lang, "team.not_found")
+		} else if err == domain.ErrPlayerNotOwned {
+			statusCode = http.StatusForbidden
+			message = localization.GetMessage(lang, "player.not_owned")
+		} else if err == domain.ErrInvalidFormation || err == domain.ErrInvalidLineup || err == domain.ErrLineupPositionMismatch {
+			statusCode = http.StatusBadRequest
+			message = localization.GetMessage(lang, "lineup.invalid")
+		} else if err == domain.ErrPlayerUnavailable {
+			statusCode = http.StatusBadRequest
+			message = localization.GetMessage(lang, "player.unavailable")
+		}
+
+		c.JSON(statusCode, gin.H{
+			"success": false,
+			"message": message,
+			"errors":  []string{err.Error()},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+		"message": localization.GetMessage(lang, "lineup.updated"),
+	})
+}
@@ -0,0 +1,160 @@
+package handlers
+
+import (
+	"net/http"
+
+	"soccer-manager-api/internal/app/match"
+	"soccer-manager-api/internal/domain"
+	"soccer-manager-api/pkg/localization"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type MatchHandler struct {
+	matchUseCase *match.MatchUseCase
+}
+
+func NewMatchHandler(matchUseCase *match.MatchUseCase) *MatchHandler {
+	return &MatchHandler{matchUseCase: matchUseCase}
+}
+
+func (h *MatchHandler) GetTeamMatches(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+	teamID := c.GetString("team_id")
+
+	matches, err := h.matchUseCase.GetTeamMatches(c.Request.Context(), teamID)
+	if err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    matches,
+	})
+}
+
+func (h *MatchHandler) ScheduleMatch(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+
+	var req match.ScheduleMatchRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": localization.GetMessage(lang, "error.validation"),
+			"errors":  []string{err.Error()},
+		})
+		return
+	}
+
+	for _, id := range []string{req.HomeTeamID, req.AwayTeamID} {
+		if _, err := uuid.Parse(id); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"success": false,
+				"message": localization.GetMessage(lang, "error.validation"),
+				"errors":  []string{"invalid team ID format"},
+			})
+			return
+		}
+	}
+
+	result, err := h.matchUseCase.ScheduleMatch(c.Request.Context(), req)
+	if err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"data":    result,
+		"message": localization.GetMessage(lang, "match.scheduled"),
+	})
+}
+
+func (h *MatchHandler) RecordResult(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+	matchID := c.Param("match_id")
+
+	if _, err := uuid.Parse(matchID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": localization.GetMessage(lang, "error.validation"),
+			"errors":  []string{"invalid match ID format"},
+		})
+		return
+	}
+
+	var req match.RecordResultRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": localization.GetMessage(lang, "error.validation"),
+			"errors":  []string{err.Error()},
+		})
+		return
+	}
+
+	result, err := h.matchUseCase.RecordResult(c.Request.Context(), matchID, req)
+	if err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+		"message": localization.GetMessage(lang, "match.result_recorded"),
+	})
+}
+
+func (h *MatchHandler) SimulateResult(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+	matchID := c.Param("match_id")
+
+	if _, err := uuid.Parse(matchID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": localization.GetMessage(lang, "error.validation"),
+			"errors":  []string{"invalid match ID format"},
+		})
+		return
+	}
+
+	result, err := h.matchUseCase.SimulateResult(c.Request.Context(), matchID)
+	if err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+		"message": localization.GetMessage(lang, "match.simulated"),
+	})
+}
+
+func (h *MatchHandler) respondError(c *gin.Context, lang string, err error) {
+	statusCode := http.StatusInternalServerError
+	message := localization.GetMessage(lang, "error.internal")
+
+	if err == domain.ErrTeamNotFound {
+		statusCode = http.StatusNotFound
+		message = localization.GetMessage(lang, "team.not_found")
+	} else if err == domain.ErrMatchNotFound {
+		statusCode = http.StatusNotFound
+		message = localization.GetMessage(lang, "match.not_found")
+	} else if err == domain.ErrInvalidMatch {
+		statusCode = http.StatusBadRequest
+		message = localization.GetMessage(lang, "match.invalid")
+	} else if err == domain.ErrMatchAlreadyPlayed {
+		statusCode = http.StatusConflict
+		message = localization.GetMessage(lang, "match.already_played")
+	}
+
+	c.JSON(statusCode, gin.H{
+		"success": false,
+		"message": message,
+		"errors":  []string{err.Error()},
+	})
+}
@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"net/http"
+
+	"soccer-manager-api/internal/app/morale"
+	"soccer-manager-api/internal/domain"
+	"soccer-manager-api/pkg/localization"
+
+	"github.com/gin-gonic/gin"
+)
+
+type MoraleHandler struct {
+	moraleUseCase *morale.MoraleUseCase
+}
+
+func NewMoraleHandler(moraleUseCase *morale.MoraleUseCase) *MoraleHandler {
+	return &MoraleHandler{moraleUseCase: moraleUseCase}
+}
+
+func (h *MoraleHandler) GetTeamMorale(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+	teamID := c.GetString("team_id")
+
+	teamMorale, err := h.moraleUseCase.GetTeamMorale(c.Request.Context(), teamID)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		message := localization.GetMessage(lang, "error.internal")
+
+		if err == domain.ErrTeamNotFound {
+			statusCode = http.StatusNotFound
+			message = localization.GetMessage(lang, "team.not_found")
+		}
+
+		c.JSON(statusCode, gin.H{
+			"success": false,
+			"message": message,
+			"errors":  []string{err.Error()},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    teamMorale,
+	})
+}
@@ -21,6 +21,7 @@ func NewPlayerHandler(playerUseCase *player.PlayerUseCase) *PlayerHandler {
 
 func (h *PlayerHandler) GetPlayer(c *gin.Context) {
 	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+	teamID := c.GetString("team_id")
 	playerID := c.Param("id")
 
 	if _, err := uuid.Parse(playerID); err != nil {
@@ -32,7 +33,7 @@ func (h *PlayerHandler) GetPlayer(c *gin.Context) {
 		return
 	}
 
-	player, err := h.playerUseCase.GetPlayer(c.Request.Context(), playerID)
+	player, err := h.playerUseCase.GetPlayer(c.Request.Context(), teamID, playerID)
 	if err != nil {
 		statusCode := http.StatusInternalServerError
 		message := localization.GetMessage(lang, "error.internal")
@@ -40,6 +41,9 @@ func (h *PlayerHandler) GetPlayer(c *gin.Context) {
 		if err == domain.ErrPlayerNotFound {
 			statusCode = http.StatusNotFound
 			message = localization.GetMessage(lang, "player.not_found")
+		} else if err == domain.ErrTeamNotFound {
+			statusCode = http.StatusNotFound
+			message = localization.GetMessage(lang, "team.not_found")
 		}
 
 		c.JSON(statusCode, gin.H{
@@ -58,7 +62,7 @@ func (h *PlayerHandler) GetPlayer(c *gin.Context) {
 
 func (h *PlayerHandler) UpdatePlayer(c *gin.Context) {
 	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
-	userID := c.GetString("user_id")
+	teamID := c.GetString("team_id")
 	playerID := c.Param("id")
 
 	if _, err := uuid.Parse(playerID); err != nil {
@@ -80,7 +84,7 @@ func (h *PlayerHandler) UpdatePlayer(c *gin.Context) {
 		return
 	}
 
-	updatedPlayer, err := h.playerUseCase.UpdatePlayer(c.Request.Context(), userID, playerID, req)
+	updatedPlayer, err := h.playerUseCase.UpdatePlayer(c.Request.Context(), teamID, playerID, req)
 	if err != nil {
 		statusCode := http.StatusInternalServerError
 		message := localization.GetMessage(lang, "error.internal")
@@ -0,0 +1,114 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"soccer-manager-api/internal/app/ranking"
+	"soccer-manager-api/internal/domain"
+	"soccer-manager-api/pkg/localization"
+
+	"github.com/gin-gonic/gin"
+)
+
+type RankingHandler struct {
+	rankingUseCase *ranking.RankingUseCase
+}
+
+func NewRankingHandler(rankingUseCase *ranking.RankingUseCase) *RankingHandler {
+	return &RankingHandler{rankingUseCase: rankingUseCase}
+}
+
+func (h *RankingHandler) GetRankings(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+
+	limit := 0
+	if value := c.Query("limit"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"success": false,
+				"message": localization.GetMessage(lang, "error.validation"),
+				"errors":  []string{"invalid limit value"},
+			})
+			return
+		}
+		limit = parsed
+	}
+
+	rankings, err := h.rankingUseCase.GetRankings(c.Request.Context(), c.Query("country"), limit)
+	if err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    rankings,
+	})
+}
+
+func (h *RankingHandler) GetRatingHistory(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+	teamID := c.GetString("team_id")
+
+	history, err := h.rankingUseCase.GetRatingHistory(c.Request.Context(), teamID)
+	if err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    history,
+	})
+}
+
+func (h *RankingHandler) ArrangeFriendly(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+	teamID := c.GetString("team_id")
+
+	var req ranking.ArrangeFriendlyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": localization.GetMessage(lang, "error.validation"),
+			"errors":  []string{err.Error()},
+		})
+		return
+	}
+
+	result, err := h.rankingUseCase.ArrangeFriendly(c.Request.Context(), teamID, req)
+	if err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"data":    result,
+		"message": localization.GetMessage(lang, "ranking.friendly_arranged"),
+	})
+}
+
+func (h *RankingHandler) respondError(c *gin.Context, lang string, err error) {
+	statusCode := http.StatusInternalServerError
+	message := localization.GetMessage(lang, "error.internal")
+
+	if err == domain.ErrTeamNotFound {
+		statusCode = http.StatusNotFound
+		message = localization.GetMessage(lang, "team.not_found")
+	} else if err == domain.ErrInvalidMatch {
+		statusCode = http.StatusBadRequest
+		message = localization.GetMessage(lang, "match.invalid")
+	} else if err == domain.ErrNoFriendlyOpponent {
+		statusCode = http.StatusNotFound
+		message = localization.GetMessage(lang, "ranking.no_opponent")
+	}
+
+	c.JSON(statusCode, gin.H{
+		"success": false,
+		"message": message,
+		"errors":  []string{err.Error()},
+	})
+}
@@ -0,0 +1,191 @@
+package handlers
+
+import (
+	"net/http"
+
+	"soccer-manager-api/internal/app/scouting"
+	"soccer-manager-api/internal/domain"
+	"soccer-manager-api/pkg/localization"
+	"soccer-manager-api/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type ScoutingHandler struct {
+	scoutingUseCase *scouting.ScoutingUseCase
+}
+
+func NewScoutingHandler(scoutingUseCase *scouting.ScoutingUseCase) *ScoutingHandler {
+	return &ScoutingHandler{scoutingUseCase: scoutingUseCase}
+}
+
+func (h *ScoutingHandler) GetScouts(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+	teamID := c.GetString("team_id")
+
+	scouts, err := h.scoutingUseCase.GetScouts(c.Request.Context(), teamID)
+	if err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    scouts,
+	})
+}
+
+func (h *ScoutingHandler) HireScout(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+	teamID := c.GetString("team_id")
+
+	var req scouting.HireScoutRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": localization.GetMessage(lang, "error.validation"),
+			"errors":  []string{err.Error()},
+		})
+		return
+	}
+
+	scout, err := h.scoutingUseCase.HireScout(c.Request.Context(), teamID, req)
+	if err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"data":    scout,
+		"message": localization.GetMessage(lang, "scout.hired"),
+	})
+}
+
+func (h *ScoutingHandler) AssignScout(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+	teamID := c.GetString("team_id")
+
+	var req scouting.AssignScoutRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": localization.GetMessage(lang, "error.validation"),
+			"errors":  []string{err.Error()},
+		})
+		return
+	}
+
+	scout, err := h.scoutingUseCase.AssignScout(c.Request.Context(), teamID, c.Param("scout_id"), req)
+	if err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    scout,
+		"message": localization.GetMessage(lang, "scout.assigned"),
+	})
+}
+
+func (h *ScoutingHandler) UnassignScout(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+	teamID := c.GetString("team_id")
+
+	scout, err := h.scoutingUseCase.UnassignScout(c.Request.Context(), teamID, c.Param("scout_id"))
+	if err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    scout,
+		"message": localization.GetMessage(lang, "scout.unassigned"),
+	})
+}
+
+func (h *ScoutingHandler) FireScout(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+	teamID := c.GetString("team_id")
+
+	if err := h.scoutingUseCase.FireScout(c.Request.Context(), teamID, c.Param("scout_id")); err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": localization.GetMessage(lang, "scout.fired"),
+	})
+}
+
+func (h *ScoutingHandler) GetReports(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+	teamID := c.GetString("team_id")
+
+	reports, err := h.scoutingUseCase.GetReports(c.Request.Context(), teamID)
+	if err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    reports,
+	})
+}
+
+func (h *ScoutingHandler) RunScouting(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+
+	updated, err := h.scoutingUseCase.RunScouting(c.Request.Context())
+	if err != nil {
+		logger.Logger.Error("Scouting run failed", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"message": localization.GetMessage(lang, "error.internal"),
+			"errors":  []string{err.Error()},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    gin.H{"reports_updated": updated},
+		"message": localization.GetMessage(lang, "scout.run_completed"),
+	})
+}
+
+func (h *ScoutingHandler) respondError(c *gin.Context, lang string, err error) {
+	statusCode := http.StatusInternalServerError
+	message := localization.GetMessage(lang, "error.internal")
+
+	if err == domain.ErrTeamNotFound {
+		statusCode = http.StatusNotFound
+		message = localization.GetMessage(lang, "team.not_found")
+	} else if err == domain.ErrPlayerNotFound {
+		statusCode = http.StatusNotFound
+		message = localization.GetMessage(lang, "player.not_found")
+	} else if err == domain.ErrScoutNotFound {
+		statusCode = http.StatusNotFound
+		message = localization.GetMessage(lang, "scout.not_found")
+	} else if err == domain.ErrTooManyScouts {
+		statusCode = http.StatusConflict
+		message = localization.GetMessage(lang, "scout.too_many")
+	} else if err == domain.ErrInvalidScoutSkill || err == domain.ErrInvalidScoutAssignment {
+		statusCode = http.StatusBadRequest
+		message = localization.GetMessage(lang, "scout.invalid")
+	} else if err == domain.ErrInsufficientBudget {
+		statusCode = http.StatusBadRequest
+		message = localization.GetMessage(lang, "transfer.insufficient_budget")
+	}
+
+	c.JSON(statusCode, gin.H{
+		"success": false,
+		"message": message,
+		"errors":  []string{err.Error()},
+	})
+}
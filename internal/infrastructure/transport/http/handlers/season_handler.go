@@ -0,0 +1,140 @@
+package handlers
+
+import (
+	"net/http"
+
+	"soccer-manager-api/internal/app/season"
+	"soccer-manager-api/internal/domain"
+	"soccer-manager-api/pkg/localization"
+	"soccer-manager-api/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type SeasonHandler struct {
+	seasonUseCase *season.SeasonUseCase
+}
+
+func NewSeasonHandler(seasonUseCase *season.SeasonUseCase) *SeasonHandler {
+	return &SeasonHandler{seasonUseCase: seasonUseCase}
+}
+
+func (h *SeasonHandler) GetCurrentSeason(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+
+	current, err := h.seasonUseCase.GetCurrentSeason(c.Request.Context())
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		message := localization.GetMessage(lang, "error.internal")
+
+		if err == domain.ErrSeasonNotFound {
+			statusCode = http.StatusNotFound
+			message = localization.GetMessage(lang, "season.not_found")
+		}
+
+		c.JSON(statusCode, gin.H{
+			"success": false,
+			"message": message,
+			"errors":  []string{err.Error()},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    current,
+	})
+}
+
+func (h *SeasonHandler) ListSeasons(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+
+	seasons, err := h.seasonUseCase.ListSeasons(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"message": localization.GetMessage(lang, "error.internal"),
+			"errors":  []string{err.Error()},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    seasons,
+	})
+}
+
+func (h *SeasonHandler) GetPlayerHistory(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+	playerID := c.Param("id")
+
+	if _, err := uuid.Parse(playerID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": localization.GetMessage(lang, "error.validation"),
+			"errors":  []string{"invalid player ID format"},
+		})
+		return
+	}
+
+	history, err := h.seasonUseCase.GetPlayerHistory(c.Request.Context(), c.GetString("team_id"), playerID)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		message := localization.GetMessage(lang, "error.internal")
+
+		if err == domain.ErrPlayerNotFound {
+			statusCode = http.StatusNotFound
+			message = localization.GetMessage(lang, "player.not_found")
+		} else if err == domain.ErrTeamNotFound {
+			statusCode = http.StatusNotFound
+			message = localization.GetMessage(lang, "team.not_found")
+		} else if err == domain.ErrPlayerNotScouted {
+			statusCode = http.StatusForbidden
+			message = localization.GetMessage(lang, "player.not_scouted")
+		}
+
+		c.JSON(statusCode, gin.H{
+			"success": false,
+			"message": message,
+			"errors":  []string{err.Error()},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    history,
+	})
+}
+
+func (h *SeasonHandler) Rollover(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+
+	result, err := h.seasonUseCase.Rollover(c.Request.Context())
+	if err == domain.ErrSeasonAlreadyCompleted {
+		c.JSON(http.StatusConflict, gin.H{
+			"success": false,
+			"message": localization.GetMessage(lang, "season.already_completed"),
+			"errors":  []string{err.Error()},
+		})
+		return
+	}
+	if err != nil {
+		logger.Logger.Error("Season rollover failed", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"message": localization.GetMessage(lang, "error.internal"),
+			"errors":  []string{err.Error()},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+		"message": localization.GetMessage(lang, "season.rolled_over"),
+	})
+}
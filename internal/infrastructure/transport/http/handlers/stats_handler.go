@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"soccer-manager-api/internal/app/stats"
+	"soccer-manager-api/internal/domain"
+	"soccer-manager-api/pkg/localization"
+
+	"github.com/gin-gonic/gin"
+)
+
+type StatsHandler struct {
+	statsUseCase *stats.StatsUseCase
+}
+
+func NewStatsHandler(statsUseCase *stats.StatsUseCase) *StatsHandler {
+	return &StatsHandler{statsUseCase: statsUseCase}
+}
+
+func (h *StatsHandler) GetTeamStats(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+	teamID := c.GetString("team_id")
+
+	teamStats, err := h.statsUseCase.GetTeamStats(c.Request.Context(), teamID)
+	if err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    teamStats,
+	})
+}
+
+func (h *StatsHandler) GetLeaderboard(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+
+	limit := 0
+	if value := c.Query("limit"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"success": false,
+				"message": localization.GetMessage(lang, "error.validation"),
+				"errors":  []string{"invalid limit value"},
+			})
+			return
+		}
+		limit = parsed
+	}
+
+	leaderboard, err := h.statsUseCase.GetLeaderboard(c.Request.Context(), c.Param("category"), c.Query("competition"), limit)
+	if err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    leaderboard,
+	})
+}
+
+func (h *StatsHandler) respondError(c *gin.Context, lang string, err error) {
+	statusCode := http.StatusInternalServerError
+	message := localization.GetMessage(lang, "error.internal")
+
+	if err == domain.ErrTeamNotFound {
+		statusCode = http.StatusNotFound
+		message = localization.GetMessage(lang, "team.not_found")
+	} else if err == domain.ErrInvalidLeaderboard {
+		statusCode = http.StatusBadRequest
+		message = localization.GetMessage(lang, "stats.invalid_leaderboard")
+	}
+
+	c.JSON(statusCode, gin.H{
+		"success": false,
+		"message": message,
+		"errors":  []string{err.Error()},
+	})
+}
@@ -20,9 +20,9 @@ func NewTeamHandler(teamUseCase *team.TeamUseCase) *TeamHandler {
 
 func (h *TeamHandler) GetTeam(c *gin.Context) {
 	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
-	userID := c.GetString("user_id")
+	teamID := c.GetString("team_id")
 
-	team, err := h.teamUseCase.GetTeam(c.Request.Context(), userID)
+	team, err := h.teamUseCase.GetTeam(c.Request.Context(), teamID)
 	if err != nil {
 		statusCode := http.StatusInternalServerError
 		message := localization.GetMessage(lang, "error.internal")
@@ -48,7 +48,7 @@ func (h *TeamHandler) GetTeam(c *gin.Context) {
 
 func (h *TeamHandler) UpdateTeam(c *gin.Context) {
 	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
-	userID := c.GetString("user_id")
+	teamID := c.GetString("team_id")
 
 	var req team.UpdateTeamRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -60,7 +60,7 @@ func (h *TeamHandler) UpdateTeam(c *gin.Context) {
 		return
 	}
 
-	updatedTeam, err := h.teamUseCase.UpdateTeam(c.Request.Context(), userID, req)
+	updatedTeam, err := h.teamUseCase.UpdateTeam(c.Request.Context(), teamID, req)
 	if err != nil {
 		statusCode := http.StatusInternalServerError
 		message := localization.GetMessage(lang, "error.internal")
@@ -68,6 +68,15 @@ func (h *TeamHandler) UpdateTeam(c *gin.Context) {
 		if err == domain.ErrTeamNotFound {
 			statusCode = http.StatusNotFound
 			message = localization.GetMessage(lang, "team.not_found")
+		} else if err == domain.ErrTeamNameTaken {
+			statusCode = http.StatusConflict
+			message = localization.GetMessage(lang, "team.name_taken")
+		} else if err == domain.ErrInvalidCountry {
+			statusCode = http.StatusBadRequest
+			message = localization.GetMessage(lang, "team.invalid_country")
+		} else if err == domain.ErrInvalidCrestColour {
+			statusCode = http.StatusBadRequest
+			message = localization.GetMessage(lang, "team.invalid_crest")
 		}
 
 		c.JSON(statusCode, gin.H{
@@ -87,9 +96,9 @@ func (h *TeamHandler) UpdateTeam(c *gin.Context) {
 
 func (h *TeamHandler) GetTeamPlayers(c *gin.Context) {
 	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
-	userID := c.GetString("user_id")
+	teamID := c.GetString("team_id")
 
-	players, err := h.teamUseCase.GetTeamPlayers(c.Request.Context(), userID)
+	players, err := h.teamUseCase.GetTeamPlayers(c.Request.Context(), teamID)
 	if err != nil {
 		statusCode := http.StatusInternalServerError
 		message := localization.GetMessage(lang, "error.internal")
@@ -113,3 +122,105 @@ func (h *TeamHandler) GetTeamPlayers(c *gin.Context) {
 	})
 }
 
+func (h *TeamHandler) ListTeams(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+	userID := c.GetString("user_id")
+
+	teams, err := h.teamUseCase.ListTeams(c.Request.Context(), userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"message": localization.GetMessage(lang, "error.internal"),
+			"errors":  []string{err.Error()},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    teams,
+	})
+}
+
+func (h *TeamHandler) CreateTeam(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+	userID := c.GetString("user_id")
+
+	var req team.CreateTeamRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"success": false,
+				"message": localization.GetMessage(lang, "error.validation"),
+				"errors":  []string{err.Error()},
+			})
+			return
+		}
+	}
+
+	createdTeam, err := h.teamUseCase.CreateTeam(c.Request.Context(), userID, req)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		message := localization.GetMessage(lang, "error.internal")
+
+		if err == domain.ErrTooManyTeams {
+			statusCode = http.StatusConflict
+			message = localization.GetMessage(lang, "team.too_many")
+		} else if err == domain.ErrTeamNameTaken {
+			statusCode = http.StatusConflict
+			message = localization.GetMessage(lang, "team.name_taken")
+		} else if err == domain.ErrInvalidCountry {
+			statusCode = http.StatusBadRequest
+			message = localization.GetMessage(lang, "team.invalid_country")
+		} else if err == domain.ErrInvalidCrestColour {
+			statusCode = http.StatusBadRequest
+			message = localization.GetMessage(lang, "team.invalid_crest")
+		}
+
+		c.JSON(statusCode, gin.H{
+			"success": false,
+			"message": message,
+			"errors":  []string{err.Error()},
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"data":    createdTeam,
+		"message": localization.GetMessage(lang, "team.created"),
+	})
+}
+
+func (h *TeamHandler) SetDefaultTeam(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+	userID := c.GetString("user_id")
+	teamID := c.GetString("team_id")
+
+	defaultTeam, err := h.teamUseCase.SetDefaultTeam(c.Request.Context(), userID, teamID)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		message := localization.GetMessage(lang, "error.internal")
+
+		if err == domain.ErrTeamNotFound {
+			statusCode = http.StatusNotFound
+			message = localization.GetMessage(lang, "team.not_found")
+		} else if err == domain.ErrTeamNotOwned {
+			statusCode = http.StatusForbidden
+			message = localization.GetMessage(lang, "team.not_owned")
+		}
+
+		c.JSON(statusCode, gin.H{
+			"success": false,
+			"message": message,
+			"errors":  []string{err.Error()},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    defaultTeam,
+		"message": localization.GetMessage(lang, "team.default_updated"),
+	})
+}
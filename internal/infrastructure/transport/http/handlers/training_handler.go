@@ -0,0 +1,178 @@
+package handlers
+
+import (
+	"net/http"
+
+	"soccer-manager-api/internal/app/training"
+	"soccer-manager-api/internal/domain"
+	"soccer-manager-api/pkg/localization"
+	"soccer-manager-api/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type TrainingHandler struct {
+	trainingUseCase *training.TrainingUseCase
+}
+
+func NewTrainingHandler(trainingUseCase *training.TrainingUseCase) *TrainingHandler {
+	return &TrainingHandler{trainingUseCase: trainingUseCase}
+}
+
+func (h *TrainingHandler) GetAssignments(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+	teamID := c.GetString("team_id")
+
+	assignments, err := h.trainingUseCase.GetAssignments(c.Request.Context(), teamID)
+	if err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    assignments,
+	})
+}
+
+func (h *TrainingHandler) AssignTraining(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+	teamID := c.GetString("team_id")
+
+	var req training.AssignTrainingRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": localization.GetMessage(lang, "error.validation"),
+			"errors":  []string{err.Error()},
+		})
+		return
+	}
+
+	if req.PlayerID != "" {
+		if _, err := uuid.Parse(req.PlayerID); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"success": false,
+				"message": localization.GetMessage(lang, "error.validation"),
+				"errors":  []string{"invalid player ID format"},
+			})
+			return
+		}
+	}
+
+	assignment, err := h.trainingUseCase.AssignTraining(c.Request.Context(), teamID, req)
+	if err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    assignment,
+		"message": localization.GetMessage(lang, "training.assigned"),
+	})
+}
+
+func (h *TrainingHandler) RemoveAssignment(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+	teamID := c.GetString("team_id")
+	assignmentID := c.Param("assignment_id")
+
+	if _, err := uuid.Parse(assignmentID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": localization.GetMessage(lang, "error.validation"),
+			"errors":  []string{"invalid assignment ID format"},
+		})
+		return
+	}
+
+	if err := h.trainingUseCase.RemoveAssignment(c.Request.Context(), teamID, assignmentID); err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": localization.GetMessage(lang, "training.removed"),
+	})
+}
+
+func (h *TrainingHandler) GetTrainingHistory(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+	playerID := c.Param("id")
+
+	if _, err := uuid.Parse(playerID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": localization.GetMessage(lang, "error.validation"),
+			"errors":  []string{"invalid player ID format"},
+		})
+		return
+	}
+
+	sessions, err := h.trainingUseCase.GetTrainingHistory(c.Request.Context(), c.GetString("team_id"), playerID)
+	if err != nil {
+		h.respondError(c, lang, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    sessions,
+	})
+}
+
+func (h *TrainingHandler) RunTraining(c *gin.Context) {
+	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+
+	trained, err := h.trainingUseCase.RunTraining(c.Request.Context())
+	if err != nil {
+		logger.Logger.Error("Training run failed", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"message": localization.GetMessage(lang, "error.internal"),
+			"errors":  []string{err.Error()},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    gin.H{"players_trained": trained},
+		"message": localization.GetMessage(lang, "training.completed"),
+	})
+}
+
+func (h *TrainingHandler) respondError(c *gin.Context, lang string, err error) {
+	statusCode := http.StatusInternalServerError
+	message := localization.GetMessage(lang, "error.internal")
+
+	if err == domain.ErrTeamNotFound {
+		statusCode = http.StatusNotFound
+		message = localization.GetMessage(lang, "team.not_found")
+	} else if err == domain.ErrPlayerNotFound {
+		statusCode = http.StatusNotFound
+		message = localization.GetMessage(lang, "player.not_found")
+	} else if err == domain.ErrPlayerNotOwned {
+		statusCode = http.StatusForbidden
+		message = localization.GetMessage(lang, "player.not_owned")
+	} else if err == domain.ErrPlayerNotScouted {
+		statusCode = http.StatusForbidden
+		message = localization.GetMessage(lang, "player.not_scouted")
+	} else if err == domain.ErrTrainingAssignmentNotFound {
+		statusCode = http.StatusNotFound
+		message = localization.GetMessage(lang, "training.not_found")
+	} else if err == domain.ErrInvalidTrainingFocus || err == domain.ErrInvalidTrainingTarget {
+		statusCode = http.StatusBadRequest
+		message = localization.GetMessage(lang, "training.invalid")
+	}
+
+	c.JSON(statusCode, gin.H{
+		"success": false,
+		"message": message,
+		"errors":  []string{err.Error()},
+	})
+}
@@ -23,7 +23,7 @@ func NewTransferHandler(transferUseCase *transfer.TransferUseCase) *TransferHand
 
 func (h *TransferHandler) ListPlayer(c *gin.Context) {
 	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
-	userID := c.GetString("user_id")
+	teamID := c.GetString("team_id")
 	playerID := c.Param("id")
 
 	if _, err := uuid.Parse(playerID); err != nil {
@@ -45,7 +45,7 @@ func (h *TransferHandler) ListPlayer(c *gin.Context) {
 		return
 	}
 
-	listing, err := h.transferUseCase.ListPlayer(c.Request.Context(), userID, playerID, req)
+	listing, err := h.transferUseCase.ListPlayer(c.Request.Context(), teamID, playerID, req)
 	if err != nil {
 		statusCode := http.StatusInternalServerError
 		message := localization.GetMessage(lang, "error.internal")
@@ -81,7 +81,7 @@ func (h *TransferHandler) ListPlayer(c *gin.Context) {
 
 func (h *TransferHandler) RemoveFromTransferList(c *gin.Context) {
 	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
-	userID := c.GetString("user_id")
+	teamID := c.GetString("team_id")
 	playerID := c.Param("id")
 
 	if _, err := uuid.Parse(playerID); err != nil {
@@ -93,7 +93,7 @@ func (h *TransferHandler) RemoveFromTransferList(c *gin.Context) {
 		return
 	}
 
-	err := h.transferUseCase.RemoveFromTransferList(c.Request.Context(), userID, playerID)
+	err := h.transferUseCase.RemoveFromTransferList(c.Request.Context(), teamID, playerID)
 	if err != nil {
 		statusCode := http.StatusInternalServerError
 		message := localization.GetMessage(lang, "error.internal")
@@ -125,9 +125,9 @@ func (h *TransferHandler) RemoveFromTransferList(c *gin.Context) {
 
 func (h *TransferHandler) GetTransferList(c *gin.Context) {
 	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
-	userID := c.GetString("user_id")
+	teamID := c.GetString("team_id")
 
-	listings, err := h.transferUseCase.GetTransferList(c.Request.Context(), userID)
+	listings, err := h.transferUseCase.GetTransferList(c.Request.Context(), teamID)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"success": false,
@@ -145,7 +145,7 @@ func (h *TransferHandler) GetTransferList(c *gin.Context) {
 
 func (h *TransferHandler) BuyPlayer(c *gin.Context) {
 	lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
-	userID := c.GetString("user_id")
+	teamID := c.GetString("team_id")
 	listingID := c.Param("listing_id")
 
 	if _, err := uuid.Parse(listingID); err != nil {
@@ -157,7 +157,7 @@ func (h *TransferHandler) BuyPlayer(c *gin.Context) {
 		return
 	}
 
-	transfer, err := h.transferUseCase.BuyPlayer(c.Request.Context(), userID, listingID)
+	transfer, err := h.transferUseCase.BuyPlayer(c.Request.Context(), teamID, listingID)
 	if err != nil {
 		statusCode := http.StatusInternalServerError
 		message := localization.GetMessage(lang, "error.internal")
@@ -168,15 +168,24 @@ func (h *TransferHandler) BuyPlayer(c *gin.Context) {
 		} else if err == domain.ErrInsufficientBudget {
 			statusCode = http.StatusBadRequest
 			message = localization.GetMessage(lang, "transfer.insufficient_budget")
-			logger.Logger.Warn("Transfer failed: insufficient budget", zap.String("user_id", userID), zap.String("listing_id", listingID))
+			logger.Logger.Warn("Transfer failed: insufficient budget", zap.String("team_id", teamID), zap.String("listing_id", listingID))
 		} else if err == domain.ErrTeamFull {
 			statusCode = http.StatusBadRequest
 			message = localization.GetMessage(lang, "transfer.team_full")
 		} else if err == domain.ErrCannotBuyOwnPlayer {
 			statusCode = http.StatusBadRequest
 			message = localization.GetMessage(lang, "transfer.cannot_buy_own")
+		} else if err == domain.ErrTransferOutsideLeague {
+			statusCode = http.StatusForbidden
+			message = localization.GetMessage(lang, "transfer.outside_league")
+		} else if err == domain.ErrTransferBudgetCap || err == domain.ErrSquadValueCap {
+			statusCode = http.StatusBadRequest
+			message = localization.GetMessage(lang, "transfer.league_cap")
+		} else if err == domain.ErrPlayerTeamChanged {
+			statusCode = http.StatusConflict
+			message = localization.GetMessage(lang, "player.team_changed")
 		} else {
-			logger.Logger.Error("Transfer failed", zap.String("user_id", userID), zap.String("listing_id", listingID), zap.Error(err))
+			logger.Logger.Error("Transfer failed", zap.String("team_id", teamID), zap.String("listing_id", listingID), zap.Error(err))
 		}
 
 		c.JSON(statusCode, gin.H{
@@ -190,7 +199,7 @@ func (h *TransferHandler) BuyPlayer(c *gin.Context) {
 	logger.Logger.Info("Player transfer completed",
 		zap.String("player_id", transfer.PlayerID.String()),
 		zap.String("buyer_team_id", transfer.BuyerTeamID.String()),
-		zap.String("seller_team_id", transfer.SellerTeamID.String()),
+		zap.Any("seller_team_id", transfer.SellerTeamID),
 		zap.Float64("transfer_price", transfer.TransferPrice),
 	)
 
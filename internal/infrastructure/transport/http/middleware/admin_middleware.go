@@ -0,0 +1,42 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"net/http"
+
+	"soccer-manager-api/internal/domain"
+	"soccer-manager-api/pkg/localization"
+	"soccer-manager-api/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+
+func AdminMiddleware(apiKey string, authMiddleware gin.HandlerFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+
+		providedKey := c.GetHeader("X-Admin-Key")
+		if providedKey == "" {
+			authMiddleware(c)
+			return
+		}
+
+		if apiKey == "" || subtle.ConstantTimeCompare([]byte(providedKey), []byte(apiKey)) != 1 {
+			logger.Logger.Warn("Admin access denied", zap.String("path", c.Request.URL.Path))
+			c.JSON(http.StatusForbidden, gin.H{
+				"success": false,
+				"message": localization.GetMessage(lang, "error.forbidden"),
+				"errors":  []string{"valid X-Admin-Key header is required"},
+			})
+			c.Abort()
+			return
+		}
+
+		logger.Logger.Info("Admin API key used", zap.String("path", c.Request.URL.Path), zap.String("ip_address", c.ClientIP()))
+		c.Set("user_id", domain.ServiceAccountID.String())
+		c.Set("role", string(domain.RoleAdmin))
+		c.Next()
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"net/http"
 	"strings"
 
+	"soccer-manager-api/internal/domain"
+	infraCache "soccer-manager-api/internal/infrastructure/cache"
 	"soccer-manager-api/pkg/jwt"
 	"soccer-manager-api/pkg/localization"
 	"soccer-manager-api/pkg/logger"
@@ -13,7 +15,7 @@ import (
 )
 
 
-func AuthMiddleware(jwtSecret string) gin.HandlerFunc {
+func AuthMiddleware(jwtKeys *jwt.KeySet, revocations *infraCache.RevocationStore) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
 
@@ -41,7 +43,7 @@ func AuthMiddleware(jwtSecret string) gin.HandlerFunc {
 		}
 
 		token := parts[1]
-		claims, err := jwt.ValidateToken(token, jwtSecret)
+		claims, err := jwt.ValidateToken(token, jwtKeys)
 		if err != nil {
 			logger.Logger.Warn("Authentication failed", zap.Error(err), zap.String("path", c.Request.URL.Path))
 			c.JSON(http.StatusUnauthorized, gin.H{
@@ -54,8 +56,33 @@ func AuthMiddleware(jwtSecret string) gin.HandlerFunc {
 		}
 
 
+		revoked, err := revocations.IsRevoked(c.Request.Context(), claims)
+		if err != nil {
+			logger.Logger.Error("Failed to check token revocation", zap.Error(err), zap.String("user_id", claims.UserID.String()))
+			c.JSON(http.StatusServiceUnavailable, gin.H{
+				"success": false,
+				"message": localization.GetMessage(lang, "error.internal"),
+				"errors":  []string{"unable to verify token"},
+			})
+			c.Abort()
+			return
+		}
+		if revoked {
+			c.JSON(http.StatusUnauthorized, gin.H{
+				"success": false,
+				"message": localization.GetMessage(lang, "error.unauthorized"),
+				"errors":  []string{"token has been revoked"},
+			})
+			c.Abort()
+			return
+		}
+
+
 		c.Set("user_id", claims.UserID.String())
 		c.Set("email", claims.Email)
+		c.Set("role", string(domain.ParseRole(claims.Role)))
+		c.Set("session_id", claims.SessionID.String())
+		c.Set("token_id", claims.ID)
 
 		c.Next()
 	}
@@ -0,0 +1,37 @@
+package middleware
+
+import (
+	"net/http"
+
+	"soccer-manager-api/internal/domain"
+	"soccer-manager-api/pkg/localization"
+	"soccer-manager-api/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+
+func RequireRole(required domain.Role) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+
+		role := domain.Role(c.GetString("role"))
+		if !role.Includes(required) {
+			logger.Logger.Warn("Role check failed",
+				zap.String("user_id", c.GetString("user_id")),
+				zap.String("role", string(role)),
+				zap.String("required", string(required)),
+				zap.String("path", c.Request.URL.Path))
+			c.JSON(http.StatusForbidden, gin.H{
+				"success": false,
+				"message": localization.GetMessage(lang, "error.forbidden"),
+				"errors":  []string{"requires the " + string(required) + " role"},
+			})
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,50 @@
+package middleware
+
+import (
+	"net/http"
+
+	"soccer-manager-api/internal/app/team"
+	"soccer-manager-api/internal/domain"
+	"soccer-manager-api/pkg/localization"
+
+	"github.com/gin-gonic/gin"
+)
+
+
+func TeamMiddleware(teamUseCase *team.TeamUseCase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+
+		teamRef := c.Param("team_id")
+		if teamRef == "" {
+			teamRef = c.GetHeader("X-Team-ID")
+		}
+
+		resolved, err := teamUseCase.ResolveTeam(c.Request.Context(), c.GetString("user_id"), teamRef)
+		if err != nil {
+			statusCode := http.StatusInternalServerError
+			message := localization.GetMessage(lang, "error.internal")
+
+			if err == domain.ErrTeamNotFound {
+				statusCode = http.StatusNotFound
+				message = localization.GetMessage(lang, "team.not_found")
+			} else if err == domain.ErrTeamNotOwned {
+				statusCode = http.StatusForbidden
+				message = localization.GetMessage(lang, "team.not_owned")
+			}
+
+			c.JSON(statusCode, gin.H{
+				"success": false,
+				"message": message,
+				"errors":  []string{err.Error()},
+			})
+			c.Abort()
+			return
+		}
+
+
+		c.Set("team_id", resolved.ID.String())
+
+		c.Next()
+	}
+}
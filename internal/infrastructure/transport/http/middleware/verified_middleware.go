@@ -0,0 +1,41 @@
+package middleware
+
+import (
+	"net/http"
+
+	"soccer-manager-api/internal/app/account"
+	"soccer-manager-api/internal/domain"
+	"soccer-manager-api/pkg/localization"
+
+	"github.com/gin-gonic/gin"
+)
+
+
+func VerifiedMiddleware(accountUseCase *account.AccountUseCase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		lang := localization.GetLanguageFromHeader(c.GetHeader("Accept-Language"))
+
+		if err := accountUseCase.RequireVerified(c.Request.Context(), c.GetString("user_id")); err != nil {
+			statusCode := http.StatusInternalServerError
+			message := localization.GetMessage(lang, "error.internal")
+
+			if err == domain.ErrEmailNotVerified {
+				statusCode = http.StatusForbidden
+				message = localization.GetMessage(lang, "auth.email_not_verified")
+			} else if err == domain.ErrUserNotFound {
+				statusCode = http.StatusUnauthorized
+				message = localization.GetMessage(lang, "error.unauthorized")
+			}
+
+			c.JSON(statusCode, gin.H{
+				"success": false,
+				"message": message,
+				"errors":  []string{err.Error()},
+			})
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -1,13 +1,34 @@
 package http
 
 import (
+	"soccer-manager-api/internal/app/academy"
+	"soccer-manager-api/internal/app/account"
+	"soccer-manager-api/internal/app/admin"
 	"soccer-manager-api/internal/app/auth"
+	"soccer-manager-api/internal/app/availability"
+	"soccer-manager-api/internal/app/bot"
+	"soccer-manager-api/internal/app/contract"
+	"soccer-manager-api/internal/app/draft"
+	"soccer-manager-api/internal/app/facility"
+	"soccer-manager-api/internal/app/finance"
+	"soccer-manager-api/internal/app/league"
+	"soccer-manager-api/internal/app/lineup"
+	"soccer-manager-api/internal/app/match"
+	"soccer-manager-api/internal/app/morale"
 	"soccer-manager-api/internal/app/player"
+	"soccer-manager-api/internal/app/ranking"
+	"soccer-manager-api/internal/app/scouting"
+	"soccer-manager-api/internal/app/season"
+	"soccer-manager-api/internal/app/stats"
 	"soccer-manager-api/internal/app/team"
+	"soccer-manager-api/internal/app/training"
 	"soccer-manager-api/internal/app/transfer"
+	"soccer-manager-api/internal/domain"
+	infraCache "soccer-manager-api/internal/infrastructure/cache"
 	"soccer-manager-api/internal/infrastructure/config"
 	"soccer-manager-api/internal/infrastructure/transport/http/handlers"
 	"soccer-manager-api/internal/infrastructure/transport/http/middleware"
+	"soccer-manager-api/pkg/jwt"
 
 	"github.com/gin-gonic/gin"
 )
@@ -18,52 +39,252 @@ func SetupRouter(
 	teamUseCase *team.TeamUseCase,
 	playerUseCase *player.PlayerUseCase,
 	transferUseCase *transfer.TransferUseCase,
+	lineupUseCase *lineup.LineupUseCase,
+	seasonUseCase *season.SeasonUseCase,
+	academyUseCase *academy.AcademyUseCase,
+	trainingUseCase *training.TrainingUseCase,
+	availabilityUseCase *availability.AvailabilityUseCase,
+	contractUseCase *contract.ContractUseCase,
+	financeUseCase *finance.FinanceUseCase,
+	matchUseCase *match.MatchUseCase,
+	facilityUseCase *facility.FacilityUseCase,
+	statsUseCase *stats.StatsUseCase,
+	botUseCase *bot.BotUseCase,
+	moraleUseCase *morale.MoraleUseCase,
+	rankingUseCase *ranking.RankingUseCase,
+	leagueUseCase *league.LeagueUseCase,
+	draftUseCase *draft.DraftUseCase,
+	scoutingUseCase *scouting.ScoutingUseCase,
+	revocations *infraCache.RevocationStore,
+	jwtKeys *jwt.KeySet,
+	accountUseCase *account.AccountUseCase,
+	adminUseCase *admin.AdminUseCase,
 ) *gin.Engine {
 	if cfg.App.Environment == "production" {
 		gin.SetMode(gin.ReleaseMode)
 	}
 
 	router := gin.Default()
+	if err := router.SetTrustedProxies(cfg.Server.TrustedProxies); err != nil {
+		panic(err)
+	}
 
 	router.GET("/health", func(c *gin.Context) {
 		c.JSON(200, gin.H{"status": "ok"})
 	})
 
+	router.GET("/.well-known/jwks.json", func(c *gin.Context) {
+		c.Header("Cache-Control", "public, max-age=300")
+		c.JSON(200, jwtKeys.JWKS())
+	})
+
 	v1 := router.Group("/api/v1")
 	{
-		authHandler := handlers.NewAuthHandler(authUseCase)
+		authHandler := handlers.NewAuthHandler(authUseCase, accountUseCase)
+		accountHandler := handlers.NewAccountHandler(accountUseCase)
 		auth := v1.Group("/auth")
 		{
 			auth.POST("/register", authHandler.Register)
 			auth.POST("/login", authHandler.Login)
+			auth.POST("/refresh", authHandler.Refresh)
+			auth.POST("/2fa/verify", authHandler.VerifyTwoFactor)
+			auth.POST("/forgot-password", accountHandler.ForgotPassword)
+			auth.POST("/reset-password", accountHandler.ResetPassword)
+			auth.GET("/verify-email", accountHandler.VerifyEmail)
+
+			session := auth.Group("")
+			session.Use(middleware.AuthMiddleware(jwtKeys, revocations))
+			{
+				session.POST("/logout", authHandler.Logout)
+				session.GET("/sessions", authHandler.ListSessions)
+				session.DELETE("/sessions/:session_id", authHandler.RevokeSession)
+				session.POST("/verify-email/resend", accountHandler.ResendVerification)
+			}
 		}
 
 		protected := v1.Group("")
-		protected.Use(middleware.AuthMiddleware(cfg.JWT.Secret))
+		protected.Use(middleware.AuthMiddleware(jwtKeys, revocations))
 		{
 			teamHandler := handlers.NewTeamHandler(teamUseCase)
+			lineupHandler := handlers.NewLineupHandler(lineupUseCase)
+			academyHandler := handlers.NewAcademyHandler(academyUseCase)
+			trainingHandler := handlers.NewTrainingHandler(trainingUseCase)
+			contractHandler := handlers.NewContractHandler(contractUseCase)
+			financeHandler := handlers.NewFinanceHandler(financeUseCase)
+			matchHandler := handlers.NewMatchHandler(matchUseCase)
+			facilityHandler := handlers.NewFacilityHandler(facilityUseCase)
+			statsHandler := handlers.NewStatsHandler(statsUseCase)
+			moraleHandler := handlers.NewMoraleHandler(moraleUseCase)
+			rankingHandler := handlers.NewRankingHandler(rankingUseCase)
+			draftHandler := handlers.NewDraftHandler(draftUseCase)
+			scoutingHandler := handlers.NewScoutingHandler(scoutingUseCase)
+			users := protected.Group("/users/me")
+			{
+				users.PUT("/password", accountHandler.ChangePassword)
+				users.PUT("/email", accountHandler.ChangeEmail)
+				users.DELETE("", accountHandler.DeleteAccount)
+				users.GET("/export", accountHandler.ExportData)
+				users.POST("/2fa", accountHandler.SetupTwoFactor)
+				users.POST("/2fa/confirm", accountHandler.ConfirmTwoFactor)
+				users.DELETE("/2fa", accountHandler.DisableTwoFactor)
+				users.POST("/2fa/recovery-codes", accountHandler.RegenerateRecoveryCodes)
+			}
+
+			teamMiddleware := middleware.TeamMiddleware(teamUseCase)
 			teams := protected.Group("/teams")
 			{
-				teams.GET("/me", teamHandler.GetTeam)
-				teams.PUT("/me", teamHandler.UpdateTeam)
-				teams.GET("/me/players", teamHandler.GetTeamPlayers)
+				teams.GET("", teamHandler.ListTeams)
+				teams.POST("", teamHandler.CreateTeam)
+			}
+
+			ownTeam := teams.Group("/:team_id")
+			ownTeam.Use(teamMiddleware)
+			{
+				ownTeam.GET("", teamHandler.GetTeam)
+				ownTeam.PUT("", teamHandler.UpdateTeam)
+				ownTeam.PUT("/default", teamHandler.SetDefaultTeam)
+				ownTeam.GET("/players", teamHandler.GetTeamPlayers)
+				ownTeam.GET("/lineup", lineupHandler.GetLineup)
+				ownTeam.PUT("/lineup", lineupHandler.UpdateLineup)
+				ownTeam.GET("/academy", academyHandler.GetAcademy)
+				ownTeam.POST("/academy/upgrade", facilityHandler.UpgradeAcademy)
+				ownTeam.POST("/academy/prospects/:prospect_id/promote", academyHandler.PromoteProspect)
+				ownTeam.DELETE("/academy/prospects/:prospect_id", academyHandler.ReleaseProspect)
+				ownTeam.GET("/training", trainingHandler.GetAssignments)
+				ownTeam.PUT("/training", trainingHandler.AssignTraining)
+				ownTeam.DELETE("/training/:assignment_id", trainingHandler.RemoveAssignment)
+				ownTeam.GET("/contracts", contractHandler.GetTeamPayroll)
+				ownTeam.GET("/finances", financeHandler.GetTransactions)
+				ownTeam.GET("/finances/forecast", financeHandler.GetForecast)
+				ownTeam.GET("/sponsorships", financeHandler.GetSponsorships)
+				ownTeam.POST("/sponsorships/offers/:offer_id/accept", financeHandler.AcceptSponsorship)
+				ownTeam.GET("/matches", matchHandler.GetTeamMatches)
+				ownTeam.GET("/facilities", facilityHandler.GetFacilities)
+				ownTeam.POST("/facilities/:facility/upgrade", facilityHandler.StartUpgrade)
+				ownTeam.PUT("/facilities/ticket-price", facilityHandler.UpdateTicketPrice)
+				ownTeam.GET("/stats", statsHandler.GetTeamStats)
+				ownTeam.GET("/morale", moraleHandler.GetTeamMorale)
+				ownTeam.GET("/rating-history", rankingHandler.GetRatingHistory)
+				ownTeam.POST("/friendlies", rankingHandler.ArrangeFriendly)
+				ownTeam.GET("/draft", draftHandler.GetDraft)
+				ownTeam.POST("/draft/picks", draftHandler.Pick)
+				ownTeam.GET("/scouts", scoutingHandler.GetScouts)
+				ownTeam.POST("/scouts", scoutingHandler.HireScout)
+				ownTeam.PUT("/scouts/:scout_id/assignment", scoutingHandler.AssignScout)
+				ownTeam.DELETE("/scouts/:scout_id/assignment", scoutingHandler.UnassignScout)
+				ownTeam.DELETE("/scouts/:scout_id", scoutingHandler.FireScout)
+				ownTeam.GET("/scout-reports", scoutingHandler.GetReports)
 			}
 
 			playerHandler := handlers.NewPlayerHandler(playerUseCase)
+			seasonHandler := handlers.NewSeasonHandler(seasonUseCase)
+			availabilityHandler := handlers.NewAvailabilityHandler(availabilityUseCase)
 			players := protected.Group("/players")
+			players.Use(teamMiddleware)
 			{
 				players.GET("/:id", playerHandler.GetPlayer)
 				players.PUT("/:id", playerHandler.UpdatePlayer)
+				players.GET("/:id/history", seasonHandler.GetPlayerHistory)
+				players.GET("/:id/training-history", trainingHandler.GetTrainingHistory)
+				players.GET("/:id/injury-history", availabilityHandler.GetInjuryHistory)
+				players.GET("/:id/contract", contractHandler.GetContract)
+				players.PUT("/:id/contract", contractHandler.RenegotiateContract)
+			}
+
+			seasons := protected.Group("/seasons")
+			{
+				seasons.GET("", seasonHandler.ListSeasons)
+				seasons.GET("/current", seasonHandler.GetCurrentSeason)
+			}
+
+			protected.GET("/leaderboards/:category", statsHandler.GetLeaderboard)
+			protected.GET("/rankings", rankingHandler.GetRankings)
+
+			leagueHandler := handlers.NewLeagueHandler(leagueUseCase)
+			leagues := protected.Group("/leagues")
+			leagues.Use(teamMiddleware)
+			{
+				leagues.POST("", leagueHandler.CreateLeague)
+				leagues.POST("/join", leagueHandler.JoinLeague)
+				leagues.GET("/me", leagueHandler.GetLeague)
+				leagues.PUT("/me/settings", leagueHandler.UpdateSettings)
+				leagues.POST("/me/invite-code", leagueHandler.RegenerateInviteCode)
+				leagues.POST("/me/leave", leagueHandler.LeaveLeague)
+				leagues.DELETE("/me/members/:member_id", leagueHandler.RemoveMember)
+				leagues.DELETE("/me", leagueHandler.DisbandLeague)
 			}
 
 			transferHandler := handlers.NewTransferHandler(transferUseCase)
+			verifiedMiddleware := middleware.VerifiedMiddleware(accountUseCase)
+			market := protected.Group("")
+			market.Use(teamMiddleware)
 			{
-				protected.POST("/players/:id/transfer-list", transferHandler.ListPlayer)
-				protected.DELETE("/players/:id/transfer-list", transferHandler.RemoveFromTransferList)
-				protected.GET("/transfer-list", transferHandler.GetTransferList)
-				protected.POST("/transfer-list/:listing_id/buy", transferHandler.BuyPlayer)
+				market.POST("/players/:id/transfer-list", verifiedMiddleware, transferHandler.ListPlayer)
+				market.DELETE("/players/:id/transfer-list", transferHandler.RemoveFromTransferList)
+				market.GET("/transfer-list", transferHandler.GetTransferList)
+				market.POST("/transfer-list/:listing_id/buy", verifiedMiddleware, transferHandler.BuyPlayer)
 			}
 		}
+
+		adminHandler := handlers.NewAdminHandler(adminUseCase)
+		staffMiddleware := middleware.AdminMiddleware(cfg.Admin.APIKey, middleware.AuthMiddleware(jwtKeys, revocations))
+		moderation := v1.Group("/admin")
+		moderation.Use(staffMiddleware, middleware.RequireRole(domain.RoleModerator))
+		{
+			moderation.GET("/users", adminHandler.ListUsers)
+			moderation.GET("/users/:user_id", adminHandler.GetUser)
+			moderation.POST("/users/:user_id/suspension", adminHandler.SuspendUser)
+			moderation.DELETE("/users/:user_id/suspension", adminHandler.UnsuspendUser)
+			moderation.POST("/transfer-listings/:listing_id/cancel", adminHandler.CancelListing)
+		}
+
+		admin := v1.Group("/admin")
+		admin.Use(staffMiddleware, middleware.RequireRole(domain.RoleAdmin))
+		{
+			seasonHandler := handlers.NewSeasonHandler(seasonUseCase)
+			admin.POST("/seasons/rollover", seasonHandler.Rollover)
+
+			academyHandler := handlers.NewAcademyHandler(academyUseCase)
+			admin.POST("/academy/intake", academyHandler.RunIntake)
+
+			trainingHandler := handlers.NewTrainingHandler(trainingUseCase)
+			admin.POST("/training/run", trainingHandler.RunTraining)
+
+			availabilityHandler := handlers.NewAvailabilityHandler(availabilityUseCase)
+			admin.POST("/players/:id/injuries", availabilityHandler.RecordInjury)
+			admin.POST("/players/:id/suspensions", availabilityHandler.RecordSuspension)
+			admin.POST("/availability/recover", availabilityHandler.RunRecovery)
+
+			contractHandler := handlers.NewContractHandler(contractUseCase)
+			admin.POST("/payroll/run", contractHandler.RunPayroll)
+			admin.POST("/contracts/expire", contractHandler.RunExpiry)
+
+			financeHandler := handlers.NewFinanceHandler(financeUseCase)
+			admin.POST("/prizes", financeHandler.AwardPrize)
+			admin.POST("/sponsorships/pay", financeHandler.RunSponsorshipPayments)
+
+			matchHandler := handlers.NewMatchHandler(matchUseCase)
+			admin.POST("/matches", matchHandler.ScheduleMatch)
+			admin.POST("/matches/:match_id/result", matchHandler.RecordResult)
+			admin.POST("/matches/:match_id/simulate", matchHandler.SimulateResult)
+
+			facilityHandler := handlers.NewFacilityHandler(facilityUseCase)
+			admin.POST("/construction/run", facilityHandler.RunConstruction)
+
+			botHandler := handlers.NewBotHandler(botUseCase)
+			admin.GET("/bots", botHandler.ListBots)
+			admin.POST("/bots", botHandler.CreateBot)
+			admin.DELETE("/bots/:team_id", botHandler.DeleteBot)
+			admin.POST("/bots/run", botHandler.RunBots)
+
+			scoutingHandler := handlers.NewScoutingHandler(scoutingUseCase)
+			admin.POST("/scouting/run", scoutingHandler.RunScouting)
+
+			admin.PUT("/users/:user_id/role", adminHandler.SetRole)
+			admin.POST("/teams/:team_id/budget", adminHandler.AdjustBudget)
+			admin.POST("/transfers/:transfer_id/reverse", adminHandler.ReverseTransfer)
+			admin.POST("/accounts/deletions/run", accountHandler.RunDeletions)
+		}
 	}
 
 	return router
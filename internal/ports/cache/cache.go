@@ -9,5 +9,6 @@ type Cache interface {
 	Delete(ctx context.Context, key string) error
 	DeleteByPattern(ctx context.Context, pattern string) error
 	Exists(ctx context.Context, key string) (bool, error)
+	Increment(ctx context.Context, key string, ttl int) (int64, error)
 }
 
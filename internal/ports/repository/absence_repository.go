@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"soccer-manager-api/internal/domain"
+)
+
+
+type AbsenceRepository interface {
+	Create(ctx context.Context, absence *domain.PlayerAbsence) error
+	GetByPlayerID(ctx context.Context, playerID string) ([]*domain.PlayerAbsence, error)
+	CloseOpen(ctx context.Context, playerID string, absenceType domain.AbsenceType, endedAt time.Time) error
+}
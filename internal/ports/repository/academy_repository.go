@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+
+	"soccer-manager-api/internal/domain"
+)
+
+
+type AcademyRepository interface {
+	GetByTeamID(ctx context.Context, teamID string) (*domain.Academy, error)
+	Save(ctx context.Context, academy *domain.Academy) error
+	UpdateLevel(ctx context.Context, academy *domain.Academy) error
+
+	CreateProspects(ctx context.Context, prospects []*domain.YouthProspect) error
+	GetProspectByID(ctx context.Context, id string) (*domain.YouthProspect, error)
+	GetProspectsByTeamID(ctx context.Context, teamID string) ([]*domain.YouthProspect, error)
+	DeleteProspect(ctx context.Context, id string) error
+}
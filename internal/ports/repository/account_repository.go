@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"soccer-manager-api/internal/domain"
+)
+
+
+type AccountRepository interface {
+	Export(ctx context.Context, userID string) (*domain.AccountExport, error)
+	Purge(ctx context.Context, userID string) error
+}
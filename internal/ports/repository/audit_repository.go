@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"context"
+
+	"soccer-manager-api/internal/domain"
+)
+
+
+type AuditRepository interface {
+	Create(ctx context.Context, entry *domain.AuditEntry) error
+}
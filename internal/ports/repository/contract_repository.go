@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"soccer-manager-api/internal/domain"
+)
+
+
+type ContractRepository interface {
+	Create(ctx context.Context, contract *domain.Contract) error
+	CreateBatch(ctx context.Context, contracts []*domain.Contract) error
+	GetActiveByPlayerID(ctx context.Context, playerID string) (*domain.Contract, error)
+	GetActiveByTeamID(ctx context.Context, teamID string) ([]*domain.Contract, error)
+	GetExpiring(ctx context.Context, before time.Time) ([]*domain.Contract, error)
+	Update(ctx context.Context, contract *domain.Contract) error
+	CreatePayment(ctx context.Context, payment *domain.PayrollPayment) error
+	GetPaymentsByTeamID(ctx context.Context, teamID string) ([]*domain.PayrollPayment, error)
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"soccer-manager-api/internal/domain"
+)
+
+
+type DraftRepository interface {
+	CreatePool(ctx context.Context, teamID string, players []*domain.Player) error
+	GetPool(ctx context.Context, teamID string) ([]*domain.Player, error)
+	ClaimPlayers(ctx context.Context, teamID string, players []*domain.Player) error
+	DeletePool(ctx context.Context, teamID string) error
+}
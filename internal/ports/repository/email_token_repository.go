@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"soccer-manager-api/internal/domain"
+)
+
+
+type EmailTokenRepository interface {
+	Create(ctx context.Context, token *domain.EmailToken) error
+	GetByHash(ctx context.Context, tokenHash string, purpose domain.EmailTokenPurpose) (*domain.EmailToken, error)
+	Consume(ctx context.Context, token *domain.EmailToken, user *domain.User) error
+	ExpireByUserID(ctx context.Context, userID string) error
+}
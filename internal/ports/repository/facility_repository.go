@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"soccer-manager-api/internal/domain"
+)
+
+
+type FacilityRepository interface {
+	GetByTeamID(ctx context.Context, teamID string) (*domain.Facilities, error)
+	Create(ctx context.Context, facilities *domain.Facilities) error
+	UpdateLevel(ctx context.Context, facilities *domain.Facilities, facility domain.FacilityType) error
+	UpdateTicketPrice(ctx context.Context, facilities *domain.Facilities) error
+	CreateProject(ctx context.Context, project *domain.ConstructionProject) error
+	UpdateProject(ctx context.Context, project *domain.ConstructionProject) error
+	GetProjectsByTeamID(ctx context.Context, teamID string) ([]*domain.ConstructionProject, error)
+	GetDueProjects(ctx context.Context, now time.Time) ([]*domain.ConstructionProject, error)
+}
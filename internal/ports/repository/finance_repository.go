@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"soccer-manager-api/internal/domain"
+)
+
+
+type FinanceRepository interface {
+	ApplyTransaction(ctx context.Context, transaction *domain.FinanceTransaction) error
+	GetTransactionsByTeamID(ctx context.Context, teamID string) ([]*domain.FinanceTransaction, error)
+}
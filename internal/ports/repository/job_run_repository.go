@@ -0,0 +1,11 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+
+type JobRunRepository interface {
+	Claim(ctx context.Context, name string, interval time.Duration) (bool, error)
+}
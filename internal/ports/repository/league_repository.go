@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+
+	"soccer-manager-api/internal/domain"
+)
+
+
+type LeagueRepository interface {
+	Create(ctx context.Context, league *domain.League) error
+	GetByID(ctx context.Context, id string) (*domain.League, error)
+	GetByInviteCode(ctx context.Context, code string) (*domain.League, error)
+	GetByTeamID(ctx context.Context, teamID string) (*domain.League, error)
+	Update(ctx context.Context, league *domain.League) error
+	Delete(ctx context.Context, id string) error
+
+	AddMember(ctx context.Context, leagueID, teamID string) error
+	RemoveMember(ctx context.Context, leagueID, teamID string) error
+	GetMembers(ctx context.Context, leagueID string) ([]*domain.Team, error)
+}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+
+	"soccer-manager-api/internal/domain"
+)
+
+
+type LineupRepository interface {
+	GetByTeamID(ctx context.Context, teamID string) (*domain.Lineup, error)
+	Save(ctx context.Context, lineup *domain.Lineup) error
+	RemovePlayer(ctx context.Context, playerID string) error
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"soccer-manager-api/internal/domain"
+)
+
+
+type MatchRepository interface {
+	Create(ctx context.Context, match *domain.Match) error
+	GetByID(ctx context.Context, id string) (*domain.Match, error)
+	RecordResult(ctx context.Context, match *domain.Match) error
+	GetByTeamID(ctx context.Context, teamID string) ([]*domain.Match, error)
+	GetScheduledByTeamID(ctx context.Context, teamID string, until time.Time) ([]*domain.Match, error)
+	GetDue(ctx context.Context, now time.Time) ([]*domain.Match, error)
+	DeleteScheduledByTeamID(ctx context.Context, teamID string) error
+}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"soccer-manager-api/internal/domain"
+)
+
+
+type MoraleRepository interface {
+	CreateBatch(ctx context.Context, events []*domain.MoraleEvent) error
+	GetDriverTotals(ctx context.Context, teamID string, since time.Time) ([]*domain.MoraleDriverTotal, error)
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 
 	"soccer-manager-api/internal/domain"
+
+	"github.com/google/uuid"
 )
 
 
@@ -12,7 +14,14 @@ type PlayerRepository interface {
 	CreateBatch(ctx context.Context, players []*domain.Player) error
 	GetByID(ctx context.Context, id string) (*domain.Player, error)
 	GetByTeamID(ctx context.Context, teamID string) ([]*domain.Player, error)
-	Update(ctx context.Context, player *domain.Player) error
+	GetActive(ctx context.Context) ([]*domain.Player, error)
+	GetUnavailable(ctx context.Context) ([]*domain.Player, error)
+	UpdateProfile(ctx context.Context, player *domain.Player) error
+	UpdateDevelopment(ctx context.Context, player *domain.Player) error
+	UpdateForm(ctx context.Context, player *domain.Player) error
+	UpdateAvailability(ctx context.Context, player *domain.Player) error
+	AdjustMorale(ctx context.Context, player *domain.Player, delta int) error
+	ChangeTeam(ctx context.Context, player *domain.Player, fromTeamID *uuid.UUID) error
 	Delete(ctx context.Context, id string) error
 	GetByTeamIDAndPosition(ctx context.Context, teamID string, position domain.Position) ([]*domain.Player, error)
 }
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"soccer-manager-api/internal/domain"
+)
+
+
+type RatingRepository interface {
+	Create(ctx context.Context, change *domain.RatingChange) error
+	GetByTeamID(ctx context.Context, teamID string, limit int) ([]*domain.RatingChange, error)
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"soccer-manager-api/internal/domain"
+)
+
+
+type RecoveryCodeRepository interface {
+	Replace(ctx context.Context, userID string, codes []*domain.RecoveryCode) error
+	ListUnused(ctx context.Context, userID string) ([]*domain.RecoveryCode, error)
+	MarkUsed(ctx context.Context, id string) error
+	DeleteByUserID(ctx context.Context, userID string) error
+}
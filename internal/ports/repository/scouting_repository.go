@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+
+	"soccer-manager-api/internal/domain"
+)
+
+
+type ScoutingRepository interface {
+	CreateScout(ctx context.Context, scout *domain.Scout) error
+	GetScoutByID(ctx context.Context, id string) (*domain.Scout, error)
+	GetScoutsByTeamID(ctx context.Context, teamID string) ([]*domain.Scout, error)
+	LockScoutCount(ctx context.Context, teamID string) (int, error)
+	GetAssignedScouts(ctx context.Context) ([]*domain.Scout, error)
+	UpdateScout(ctx context.Context, scout *domain.Scout) error
+	DeleteScout(ctx context.Context, id string) error
+	GetReport(ctx context.Context, teamID, playerID string) (*domain.ScoutReport, error)
+	GetReportsByTeamID(ctx context.Context, teamID string) ([]*domain.ScoutReport, error)
+	GetRegionReports(ctx context.Context, teamID, region string, limit int) ([]*domain.ScoutReport, error)
+	SaveReport(ctx context.Context, report *domain.ScoutReport) error
+}
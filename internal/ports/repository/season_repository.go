@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+
+	"soccer-manager-api/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+
+type SeasonRepository interface {
+	Create(ctx context.Context, season *domain.Season) error
+	GetCurrent(ctx context.Context) (*domain.Season, error)
+	List(ctx context.Context) ([]*domain.Season, error)
+	Complete(ctx context.Context, season *domain.Season) error
+
+	ArchivePlayers(ctx context.Context, snapshots []*domain.SeasonPlayerSnapshot) error
+	ArchivePlayerStats(ctx context.Context, seasonID uuid.UUID) (int, error)
+	GetPlayerHistory(ctx context.Context, playerID string) ([]*domain.SeasonPlayerSnapshot, error)
+}
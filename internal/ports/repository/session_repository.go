@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"soccer-manager-api/internal/domain"
+)
+
+
+type SessionRepository interface {
+	Create(ctx context.Context, session *domain.Session, token *domain.RefreshToken) error
+	GetByID(ctx context.Context, id string) (*domain.Session, error)
+	GetActiveByUserID(ctx context.Context, userID string) ([]*domain.Session, error)
+	GetRefreshToken(ctx context.Context, tokenHash string) (*domain.RefreshToken, error)
+	Rotate(ctx context.Context, session *domain.Session, used, next *domain.RefreshToken) error
+	Revoke(ctx context.Context, id string) error
+	RevokeByUserID(ctx context.Context, userID string) error
+}
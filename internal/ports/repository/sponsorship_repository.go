@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"soccer-manager-api/internal/domain"
+)
+
+
+type SponsorshipRepository interface {
+	CreateOffers(ctx context.Context, offers []*domain.SponsorshipOffer) error
+	GetOffersByTeamID(ctx context.Context, teamID string, now time.Time) ([]*domain.SponsorshipOffer, error)
+	GetOfferByID(ctx context.Context, id string) (*domain.SponsorshipOffer, error)
+	DeleteOffersByTeamID(ctx context.Context, teamID string) error
+	CreateDeal(ctx context.Context, deal *domain.SponsorshipDeal) error
+	UpdateDeal(ctx context.Context, deal *domain.SponsorshipDeal) error
+	GetActiveDealByTeamID(ctx context.Context, teamID string) (*domain.SponsorshipDeal, error)
+	GetDueDeals(ctx context.Context, now time.Time) ([]*domain.SponsorshipDeal, error)
+}
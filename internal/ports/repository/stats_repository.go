@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"soccer-manager-api/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+
+type StatsRepository interface {
+	CreateBatch(ctx context.Context, stats []*domain.PlayerMatchStats) error
+	GetPlayerTotals(ctx context.Context, playerID string) ([]*domain.PlayerStats, error)
+	GetTeamTotals(ctx context.Context, teamID string, seasonID *uuid.UUID) ([]*domain.PlayerStats, error)
+	GetLeaderboard(ctx context.Context, category domain.LeaderboardCategory, seasonID *uuid.UUID, competition string, limit int) ([]*domain.PlayerStats, error)
+	GetRecentForm(ctx context.Context, playerID string, matches int) (float64, error)
+}
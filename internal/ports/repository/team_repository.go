@@ -10,9 +10,18 @@ import (
 type TeamRepository interface {
 	Create(ctx context.Context, team *domain.Team) error
 	GetByID(ctx context.Context, id string) (*domain.Team, error)
-	GetByUserID(ctx context.Context, userID string) (*domain.Team, error)
+	GetByName(ctx context.Context, name string) (*domain.Team, error)
+	GetDefaultByUserID(ctx context.Context, userID string) (*domain.Team, error)
+	ListByUserID(ctx context.Context, userID string) ([]*domain.Team, error)
+	SetDefault(ctx context.Context, userID, teamID string) error
+	List(ctx context.Context) ([]*domain.Team, error)
+	ListBots(ctx context.Context) ([]*domain.Team, error)
 	Update(ctx context.Context, team *domain.Team) error
+	AdjustRating(ctx context.Context, teamID string, delta int) (int, error)
+	GetRankings(ctx context.Context, country string, limit int) ([]*domain.Team, error)
+	GetNearestByRating(ctx context.Context, teamID string, rating, limit int) ([]*domain.Team, error)
 	GetTotalValue(ctx context.Context, teamID string) (float64, error)
 	GetPlayerCount(ctx context.Context, teamID string) (int, error)
+	LockSquadSize(ctx context.Context, teamID string) (int, error)
 }
 
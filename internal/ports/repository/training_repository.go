@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"soccer-manager-api/internal/domain"
+)
+
+
+type TrainingRepository interface {
+	SaveAssignment(ctx context.Context, assignment *domain.TrainingAssignment) error
+	GetAssignmentByID(ctx context.Context, id string) (*domain.TrainingAssignment, error)
+	GetAssignmentsByTeamID(ctx context.Context, teamID string) ([]*domain.TrainingAssignment, error)
+	DeleteAssignment(ctx context.Context, id string) error
+
+	CreateSessions(ctx context.Context, sessions []*domain.TrainingSession) error
+	GetSessionsByPlayerID(ctx context.Context, playerID string) ([]*domain.TrainingSession, error)
+}
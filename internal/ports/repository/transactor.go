@@ -0,0 +1,8 @@
+package repository
+
+import "context"
+
+
+type Transactor interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
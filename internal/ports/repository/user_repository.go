@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"soccer-manager-api/internal/domain"
 )
@@ -12,6 +13,9 @@ type UserRepository interface {
 	GetByID(ctx context.Context, id string) (*domain.User, error)
 	GetByEmail(ctx context.Context, email string) (*domain.User, error)
 	Update(ctx context.Context, user *domain.User) error
+	AdvanceTOTPStep(ctx context.Context, id string, step int64) error
 	Delete(ctx context.Context, id string) error
+	ListDueForDeletion(ctx context.Context, before time.Time) ([]*domain.User, error)
+	Search(ctx context.Context, filter domain.UserFilter) ([]*domain.User, error)
 }
 
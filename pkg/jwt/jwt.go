@@ -14,16 +14,20 @@ var (
 )
 
 type Claims struct {
-	UserID uuid.UUID `json:"user_id"`
-	Email  string    `json:"email"`
+	UserID    uuid.UUID `json:"user_id"`
+	Email     string    `json:"email"`
+	Role      string    `json:"role,omitempty"`
+	SessionID uuid.UUID `json:"sid"`
 	jwt.RegisteredClaims
 }
 
-func GenerateToken(userID uuid.UUID, email string, secret string, expirationHours int) (string, error) {
-	expirationTime := time.Now().Add(time.Duration(expirationHours) * time.Hour)
+func GenerateToken(userID uuid.UUID, email, role string, sessionID uuid.UUID, keys *KeySet, ttl time.Duration) (string, error) {
+	expirationTime := time.Now().Add(ttl)
 	claims := &Claims{
-		UserID: userID,
-		Email:  email,
+		UserID:    userID,
+		Email:     email,
+		Role:      role,
+		SessionID: sessionID,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(expirationTime),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
@@ -32,8 +36,12 @@ func GenerateToken(userID uuid.UUID, email string, secret string, expirationHour
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	tokenString, err := token.SignedString([]byte(secret))
+	key := keys.SigningKey()
+	token := jwt.NewWithClaims(key.Method, claims)
+	if key.ID != "" {
+		token.Header["kid"] = key.ID
+	}
+	tokenString, err := token.SignedString(key.SigningKey)
 	if err != nil {
 		return "", err
 	}
@@ -41,14 +49,9 @@ func GenerateToken(userID uuid.UUID, email string, secret string, expirationHour
 	return tokenString, nil
 }
 
-func ValidateToken(tokenString string, secret string) (*Claims, error) {
+func ValidateToken(tokenString string, keys *KeySet) (*Claims, error) {
 	claims := &Claims{}
-	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, ErrInvalidToken
-		}
-		return []byte(secret), nil
-	})
+	token, err := jwt.ParseWithClaims(tokenString, claims, keys.lookup)
 
 	if err != nil {
 		if errors.Is(err, jwt.ErrTokenExpired) {
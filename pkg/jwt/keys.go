@@ -0,0 +1,155 @@
+package jwt
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const keyFileExtension = ".pem"
+
+var (
+	ErrNoSigningKey = errors.New("no signing key available")
+	ErrUnknownKey   = errors.New("unknown key id")
+)
+
+type Key struct {
+	ID         string
+	Method     jwt.SigningMethod
+	SigningKey interface{}
+	VerifyKey  interface{}
+}
+
+type KeySet struct {
+	signing *Key
+	keys    map[string]*Key
+}
+
+type JWK struct {
+	KeyType   string `json:"kty"`
+	KeyID     string `json:"kid"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+	Modulus   string `json:"n,omitempty"`
+	Exponent  string `json:"e,omitempty"`
+	Curve     string `json:"crv,omitempty"`
+	X         string `json:"x,omitempty"`
+}
+
+type JWKSet struct {
+	Keys []JWK `json:"keys"`
+}
+
+func NewHMACKeySet(secret string) *KeySet {
+	key := &Key{
+		Method:     jwt.SigningMethodHS256,
+		SigningKey: []byte(secret),
+		VerifyKey:  []byte(secret),
+	}
+	return &KeySet{signing: key, keys: map[string]*Key{"": key}}
+}
+
+func LoadKeySet(dir, signingKeyID string) (*KeySet, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*"+keyFileExtension))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+
+	set := &KeySet{keys: make(map[string]*Key)}
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		kid := strings.TrimSuffix(filepath.Base(path), keyFileExtension)
+		key, err := parseKey(kid, data)
+		if err != nil {
+			return nil, fmt.Errorf("key %s: %w", kid, err)
+		}
+		set.keys[kid] = key
+		if key.SigningKey != nil && (signingKeyID == "" || signingKeyID == kid) {
+			set.signing = key
+		}
+	}
+
+	if set.signing == nil {
+		return nil, ErrNoSigningKey
+	}
+	return set, nil
+}
+
+func (s *KeySet) SigningKey() *Key {
+	return s.signing
+}
+
+func (s *KeySet) lookup(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+	key, ok := s.keys[kid]
+	if !ok {
+		return nil, ErrUnknownKey
+	}
+	if token.Method.Alg() != key.Method.Alg() {
+		return nil, ErrInvalidToken
+	}
+	return key.VerifyKey, nil
+}
+
+func (s *KeySet) JWKS() JWKSet {
+	kids := make([]string, 0, len(s.keys))
+	for kid := range s.keys {
+		kids = append(kids, kid)
+	}
+	sort.Strings(kids)
+
+	set := JWKSet{Keys: make([]JWK, 0, len(kids))}
+	for _, kid := range kids {
+		if jwk, ok := s.keys[kid].jwk(); ok {
+			set.Keys = append(set.Keys, jwk)
+		}
+	}
+	return set
+}
+
+func (k *Key) jwk() (JWK, bool) {
+	jwk := JWK{KeyID: k.ID, Use: "sig", Algorithm: k.Method.Alg()}
+	switch public := k.VerifyKey.(type) {
+	case *rsa.PublicKey:
+		jwk.KeyType = "RSA"
+		jwk.Modulus = base64.RawURLEncoding.EncodeToString(public.N.Bytes())
+		jwk.Exponent = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(public.E)).Bytes())
+	case ed25519.PublicKey:
+		jwk.KeyType = "OKP"
+		jwk.Curve = "Ed25519"
+		jwk.X = base64.RawURLEncoding.EncodeToString(public)
+	default:
+		return JWK{}, false
+	}
+	return jwk, true
+}
+
+func parseKey(kid string, data []byte) (*Key, error) {
+	if private, err := jwt.ParseRSAPrivateKeyFromPEM(data); err == nil {
+		return &Key{ID: kid, Method: jwt.SigningMethodRS256, SigningKey: private, VerifyKey: &private.PublicKey}, nil
+	}
+	if private, err := jwt.ParseEdPrivateKeyFromPEM(data); err == nil {
+		return &Key{ID: kid, Method: jwt.SigningMethodEdDSA, SigningKey: private, VerifyKey: private.(crypto.Signer).Public()}, nil
+	}
+	if public, err := jwt.ParseRSAPublicKeyFromPEM(data); err == nil {
+		return &Key{ID: kid, Method: jwt.SigningMethodRS256, VerifyKey: public}, nil
+	}
+	if public, err := jwt.ParseEdPublicKeyFromPEM(data); err == nil {
+		return &Key{ID: kid, Method: jwt.SigningMethodEdDSA, VerifyKey: public}, nil
+	}
+	return nil, errors.New("unsupported key format, expected an RSA or Ed25519 PEM key")
+}
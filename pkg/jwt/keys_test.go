@@ -0,0 +1,196 @@
+package jwt
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func writePrivateKey(t *testing.T, dir, kid string, key interface{}) {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	assert.NoError(t, err)
+	writePEM(t, dir, kid, "PRIVATE KEY", der)
+}
+
+func writePublicKey(t *testing.T, dir, kid string, key interface{}) {
+	der, err := x509.MarshalPKIXPublicKey(key)
+	assert.NoError(t, err)
+	writePEM(t, dir, kid, "PUBLIC KEY", der)
+}
+
+func writePEM(t *testing.T, dir, kid, blockType string, der []byte) {
+	data := pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, kid+keyFileExtension), data, 0o600))
+}
+
+func TestLoadKeySet(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	edPublic, edKey, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		setup        func(dir string)
+		signingKeyID string
+		wantKID      string
+		wantAlg      string
+		wantErr      error
+	}{
+		{
+			name:    "rsa private key",
+			setup:   func(dir string) { writePrivateKey(t, dir, "rsa-1", rsaKey) },
+			wantKID: "rsa-1",
+			wantAlg: "RS256",
+		},
+		{
+			name:    "ed25519 private key",
+			setup:   func(dir string) { writePrivateKey(t, dir, "ed-1", edKey) },
+			wantKID: "ed-1",
+			wantAlg: "EdDSA",
+		},
+		{
+			name: "configured signing key wins",
+			setup: func(dir string) {
+				writePrivateKey(t, dir, "a", rsaKey)
+				writePrivateKey(t, dir, "b", edKey)
+			},
+			signingKeyID: "a",
+			wantKID:      "a",
+			wantAlg:      "RS256",
+		},
+		{
+			name: "last private key signs by default",
+			setup: func(dir string) {
+				writePrivateKey(t, dir, "a", rsaKey)
+				writePrivateKey(t, dir, "b", edKey)
+			},
+			wantKID: "b",
+			wantAlg: "EdDSA",
+		},
+		{
+			name:    "public keys only",
+			setup:   func(dir string) { writePublicKey(t, dir, "old", edPublic) },
+			wantErr: ErrNoSigningKey,
+		},
+		{
+			name:    "empty directory",
+			setup:   func(dir string) {},
+			wantErr: ErrNoSigningKey,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			tt.setup(dir)
+
+			set, err := LoadKeySet(dir, tt.signingKeyID)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantKID, set.SigningKey().ID)
+			assert.Equal(t, tt.wantAlg, set.SigningKey().Method.Alg())
+		})
+	}
+}
+
+func TestLoadKeySetRejectsInvalidKey(t *testing.T) {
+	dir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "broken"+keyFileExtension), []byte("not a key"), 0o600))
+
+	_, err := LoadKeySet(dir, "")
+	assert.Error(t, err)
+}
+
+func TestValidateTokenAcrossKeySets(t *testing.T) {
+	oldPublic, oldKey, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+	_, newKey, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+
+	oldDir := t.TempDir()
+	writePrivateKey(t, oldDir, "old", oldKey)
+	oldSet, err := LoadKeySet(oldDir, "")
+	assert.NoError(t, err)
+
+	rotatedDir := t.TempDir()
+	writePublicKey(t, rotatedDir, "old", oldPublic)
+	writePrivateKey(t, rotatedDir, "new", newKey)
+	rotatedSet, err := LoadKeySet(rotatedDir, "new")
+	assert.NoError(t, err)
+
+	otherDir := t.TempDir()
+	writePrivateKey(t, otherDir, "other", newKey)
+	otherSet, err := LoadKeySet(otherDir, "")
+	assert.NoError(t, err)
+
+	hmacSet := NewHMACKeySet("secret")
+
+	tests := []struct {
+		name    string
+		signer  *KeySet
+		keys    *KeySet
+		ttl     time.Duration
+		wantErr error
+	}{
+		{"same key set", oldSet, oldSet, time.Minute, nil},
+		{"retired key still verifies", oldSet, rotatedSet, time.Minute, nil},
+		{"unknown key id", oldSet, otherSet, time.Minute, ErrInvalidToken},
+		{"algorithm mismatch", hmacSet, rotatedSet, time.Minute, ErrInvalidToken},
+		{"hmac", hmacSet, hmacSet, time.Minute, nil},
+		{"expired", oldSet, oldSet, -time.Minute, ErrExpiredToken},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userID := uuid.New()
+			token, err := GenerateToken(userID, "user@example.com", "manager", uuid.New(), tt.signer, tt.ttl)
+			assert.NoError(t, err)
+
+			claims, err := ValidateToken(token, tt.keys)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, userID, claims.UserID)
+		})
+	}
+}
+
+func TestJWKSPublishesOnlyAsymmetricKeys(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	edPublic, _, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+
+	dir := t.TempDir()
+	writePrivateKey(t, dir, "rsa", rsaKey)
+	writePublicKey(t, dir, "ed", edPublic)
+	set, err := LoadKeySet(dir, "")
+	assert.NoError(t, err)
+
+	jwks := set.JWKS()
+	assert.Len(t, jwks.Keys, 2)
+	assert.Equal(t, "ed", jwks.Keys[0].KeyID)
+	assert.Equal(t, "OKP", jwks.Keys[0].KeyType)
+	assert.Equal(t, "Ed25519", jwks.Keys[0].Curve)
+	assert.Equal(t, "rsa", jwks.Keys[1].KeyID)
+	assert.Equal(t, "RSA", jwks.Keys[1].KeyType)
+	assert.Equal(t, "RS256", jwks.Keys[1].Algorithm)
+	assert.NotEmpty(t, jwks.Keys[1].Modulus)
+
+	assert.Empty(t, NewHMACKeySet("secret").JWKS().Keys)
+}
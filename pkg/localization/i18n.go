@@ -14,52 +14,326 @@ const (
 
 var Messages = map[string]map[string]string{
 	LangEN: {
-		"user.created":                 "User created successfully",
-		"user.login.success":           "Login successful",
-		"user.invalid_credentials":     "Invalid email or password",
-		"user.already_exists":          "User with this email already exists",
-		"team.created":                 "Team created successfully",
-		"team.updated":                 "Team updated successfully",
-		"team.not_found":               "Team not found",
-		"player.updated":               "Player updated successfully",
-		"player.not_found":             "Player not found",
-		"player.not_owned":             "Player does not belong to your team",
-		"player.listed":                "Player listed for transfer",
-		"player.already_listed":        "Player is already on transfer list",
-		"player.removed_from_list":     "Player removed from transfer list",
-		"player.not_on_list":           "Player is not on transfer list",
-		"transfer.purchased":           "Player purchased successfully",
-		"transfer.insufficient_budget": "Insufficient budget",
-		"transfer.team_full":           "Team already has maximum number of players",
-		"transfer.cannot_buy_own":      "Cannot buy your own player",
-		"transfer.listing_not_found":   "Transfer listing not found",
-		"error.internal":               "Internal server error",
-		"error.validation":             "Validation error",
-		"error.unauthorized":           "Unauthorized",
+		"user.created":                   "User created successfully",
+		"user.login.success":             "Login successful",
+		"user.invalid_credentials":       "Invalid email or password",
+		"user.already_exists":            "User with this email already exists",
+		"user.not_found":                 "User not found",
+		"user.banned":                    "This account has been banned",
+		"user.suspended":                 "User suspended; their sessions have been signed out",
+		"user.unsuspended":               "User suspension lifted",
+		"user.role_changed":              "Role updated; the user must sign in again",
+		"user.invalid_role":              "Role must be manager, moderator or admin",
+		"user.invalid_status":            "Status must be active or suspended",
+		"user.insufficient_role":         "You cannot manage a user with the same or a higher role",
+		"user.invalid_password":          "Current password is incorrect",
+		"user.password_changed":          "Password changed successfully; other sessions have been signed out",
+		"user.email_changed":             "Email changed successfully; check your new address to verify it",
+		"email.changed.subject":          "Your email address was changed",
+		"email.changed.body":             "The email address on your Soccer Manager account was changed to {email}.\n\nIf you did not make this change, reset your password immediately.",
+		"user.deletion_scheduled":        "Account scheduled for deletion; log in again before the deletion date to cancel",
+		"user.deletion_pending":          "Account deletion is already scheduled",
+		"user.deletions_completed":       "Scheduled account deletions processed",
+		"email.deletion.subject":         "Your account is scheduled for deletion",
+		"email.deletion.body":            "Your Soccer Manager account and all of its data will be deleted on {date}. Your players will be released as free agents.\n\nTo keep your account, simply log in again before that date.",
+		"auth.refreshed":                 "Token refreshed successfully",
+		"auth.logged_out":                "Logged out successfully",
+		"auth.invalid_refresh":           "Invalid or expired refresh token",
+		"auth.session_not_found":         "Session not found",
+		"auth.session_revoked":           "Session revoked successfully",
+		"auth.password_reset_sent":       "If an account exists for this email, a password reset token has been sent",
+		"auth.password_reset":            "Password reset successfully",
+		"auth.email_verified":            "Email address verified successfully",
+		"auth.verification_sent":         "Verification email sent",
+		"auth.already_verified":          "Email address is already verified",
+		"auth.invalid_email_token":       "Invalid or expired token",
+		"auth.email_not_verified":        "Verify your email address to use this feature",
+		"auth.two_factor_required":       "Enter the code from your authenticator app to finish signing in",
+		"auth.invalid_challenge":         "Sign-in attempt expired; log in again",
+		"auth.invalid_two_factor_code":   "Invalid two-factor code",
+		"auth.two_factor_setup":          "Scan the code with your authenticator app and confirm it to enable two-factor authentication",
+		"auth.two_factor_enabled":        "Two-factor authentication enabled",
+		"auth.two_factor_disabled":       "Two-factor authentication disabled",
+		"auth.two_factor_active":         "Two-factor authentication is already enabled",
+		"auth.two_factor_inactive":       "Two-factor authentication is not enabled",
+		"auth.recovery_codes_reset":      "New recovery codes generated; the old ones no longer work",
+		"auth.too_many_attempts":         "Too many login attempts. Try again in {seconds} seconds",
+		"auth.account_locked":            "Too many failed login attempts. Sign-in is locked for {seconds} seconds",
+		"email.verify.subject":           "Confirm your email address",
+		"email.verify.body":              "Welcome to Soccer Manager!\n\nConfirm your email address by opening this link:\n{link}\n\nThe link expires in {hours} hours.",
+		"email.reset.subject":            "Reset your password",
+		"email.reset.body":               "We received a request to reset your Soccer Manager password.\n\nUse this token to choose a new password:\n{token}\n\nThe token expires in {minutes} minutes. If you did not request a reset, you can ignore this email.",
+		"team.created":                   "Team created successfully",
+		"team.updated":                   "Team updated successfully",
+		"team.not_found":                 "Team not found",
+		"player.updated":                 "Player updated successfully",
+		"player.not_found":               "Player not found",
+		"player.not_owned":               "Player does not belong to your team",
+		"player.not_scouted":             "Scout this player fully to see these details",
+		"player.listed":                  "Player listed for transfer",
+		"player.already_listed":          "Player is already on transfer list",
+		"player.removed_from_list":       "Player removed from transfer list",
+		"player.not_on_list":             "Player is not on transfer list",
+		"transfer.purchased":             "Player purchased successfully",
+		"transfer.insufficient_budget":   "Insufficient budget",
+		"transfer.team_full":             "Team already has maximum number of players",
+		"transfer.cannot_buy_own":        "Cannot buy your own player",
+		"transfer.listing_not_found":     "Transfer listing not found",
+		"transfer.not_found":             "Transfer not found",
+		"transfer.listing_cancelled":     "Transfer listing cancelled",
+		"transfer.reversed":              "Transfer reversed; the player and fee have been returned",
+		"transfer.already_reversed":      "Transfer has already been reversed",
+		"transfer.not_reversible":        "Transfer cannot be reversed once the player has left the buying team",
+		"lineup.updated":                 "Lineup updated successfully",
+		"lineup.not_found":               "Lineup not found",
+		"lineup.invalid":                 "Invalid lineup",
+		"season.not_found":               "Season not found",
+		"season.rolled_over":             "Season rolled over successfully",
+		"season.already_completed":       "Season has already been rolled over",
+		"academy.prospect_promoted":      "Prospect promoted to the first team",
+		"academy.prospect_released":      "Prospect released from the academy",
+		"academy.prospect_not_found":     "Youth prospect not found",
+		"academy.intake_completed":       "Academy intake completed",
+		"training.assigned":              "Training focus assigned",
+		"training.removed":               "Training assignment removed",
+		"training.not_found":             "Training assignment not found",
+		"training.invalid":               "Invalid training assignment",
+		"training.completed":             "Training session completed",
+		"player.unavailable":             "Player is injured or suspended",
+		"player.team_changed":            "Player has changed team in the meantime, please retry",
+		"availability.injured":           "Injury recorded",
+		"availability.suspended":         "Suspension recorded",
+		"availability.invalid":           "Absence length must be positive",
+		"availability.recovered":         "Recovery check completed",
+		"contract.not_found":             "Contract not found",
+		"contract.renegotiated":          "Contract renegotiated successfully",
+		"contract.invalid":               "Contract offer rejected",
+		"contract.payroll_completed":     "Payroll completed",
+		"contract.expiry_completed":      "Contract expiry check completed",
+		"finance.invalid_prize":          "Invalid prize",
+		"finance.prize_awarded":          "Prize money awarded",
+		"finance.budget_adjusted":        "Team budget adjusted",
+		"sponsorship.not_found":          "Sponsorship not found",
+		"sponsorship.active":             "Team already has an active sponsorship deal",
+		"sponsorship.accepted":           "Sponsorship deal signed",
+		"sponsorship.payments_completed": "Sponsorship payments completed",
+		"match.not_found":                "Match not found",
+		"match.invalid":                  "Invalid match",
+		"match.already_played":           "Match has already been played",
+		"match.scheduled":                "Match scheduled",
+		"match.result_recorded":          "Match result recorded",
+		"match.simulated":                "Match simulated",
+		"facility.invalid":               "Invalid facility",
+		"facility.max_level":             "Facility is already at maximum level",
+		"facility.in_progress":           "An upgrade of this facility is already in progress",
+		"facility.invalid_price":         "Ticket price must be between 5 and 200",
+		"facility.upgrade_started":       "Facility upgrade started",
+		"facility.price_updated":         "Ticket price updated",
+		"facility.construction_run":      "Construction check completed",
+		"stats.invalid_leaderboard":      "Leaderboard must be scorers or assists, optionally filtered by a valid competition",
+		"bot.created":                    "Bot team created",
+		"bot.deleted":                    "Bot team deleted",
+		"bot.not_bot":                    "Team is not a bot team",
+		"bot.run_completed":              "Bot activity completed",
+		"ranking.friendly_arranged":      "Friendly match arranged",
+		"ranking.no_opponent":            "No opponent available for a friendly",
+		"league.created":                 "League created",
+		"league.joined":                  "Joined league",
+		"league.updated":                 "League updated",
+		"league.left":                    "Left league",
+		"league.member_removed":          "Team removed from league",
+		"league.disbanded":               "League disbanded",
+		"league.not_found":               "League not found",
+		"league.already_member":          "Team is already in a league",
+		"league.full":                    "League is full",
+		"league.not_commissioner":        "Only the commissioner can do this",
+		"league.commissioner_stays":      "The commissioner cannot leave the league",
+		"league.invalid_settings":        "League caps must be positive",
+		"transfer.outside_league":        "Your league only allows transfers between members",
+		"transfer.league_cap":            "Transfer breaks a league cap",
+		"team.not_owned":                 "Team does not belong to you",
+		"team.too_many":                  "You already manage the maximum number of teams",
+		"team.default_updated":           "Default team updated",
+		"team.name_taken":                "Team name is already taken",
+		"team.invalid_country":           "Country must be a valid ISO 3166 country",
+		"team.invalid_crest":             "Crest colours must be hex colours like #1A2B3C",
+		"draft.not_found":                "No draft in progress for this team",
+		"draft.invalid_pick":             "Invalid draft pick",
+		"draft.picked":                   "Players drafted successfully",
+		"draft.complete":                 "Draft complete, your squad is ready",
+		"scout.hired":                    "Scout hired successfully",
+		"scout.assigned":                 "Scout assigned successfully",
+		"scout.unassigned":               "Scout recalled",
+		"scout.fired":                    "Scout released",
+		"scout.not_found":                "Scout not found",
+		"scout.too_many":                 "Team already has the maximum number of scouts",
+		"scout.invalid":                  "Invalid scout skill or assignment",
+		"scout.run_completed":            "Scouting cycle completed",
+		"error.internal":                 "Internal server error",
+		"error.validation":               "Validation error",
+		"error.unauthorized":             "Unauthorized",
+		"error.forbidden":                "Forbidden",
 	},
 	LangKA: {
-		"user.created":                 "მომხმარებელი წარმატებით შეიქმნა",
-		"user.login.success":           "შესვლა წარმატებულია",
-		"user.invalid_credentials":     "არასწორი ელფოსტა ან პაროლი",
-		"user.already_exists":          "ამ ელფოსტით მომხმარებელი უკვე არსებობს",
-		"team.created":                 "გუნდი წარმატებით შეიქმნა",
-		"team.updated":                 "გუნდი განახლდა",
-		"team.not_found":               "გუნდი ვერ მოიძებნა",
-		"player.updated":               "მოთამაშე განახლდა",
-		"player.not_found":             "მოთამაშე ვერ მოიძებნა",
-		"player.not_owned":             "მოთამაშე არ ეკუთვნის თქვენს გუნდს",
-		"player.listed":                "მოთამაშე განთავსდა გადაცემის სიაში",
-		"player.already_listed":        "მოთამაშე უკვე არის გადაცემის სიაში",
-		"player.removed_from_list":     "მოთამაშე წაიშალა გადაცემის სიიდან",
-		"player.not_on_list":           "მოთამაშე არ არის გადაცემის სიაში",
-		"transfer.purchased":           "მოთამაშე წარმატებით შეიძინა",
-		"transfer.insufficient_budget": "არასაკმარისი ბიუჯეტი",
-		"transfer.team_full":           "გუნდს უკვე აქვს მაქსიმალური რაოდენობის მოთამაშე",
-		"transfer.cannot_buy_own":      "ვერ შეიძენთ საკუთარ მოთამაშეს",
-		"transfer.listing_not_found":   "გადაცემის სია ვერ მოიძებნა",
-		"error.internal":               "შიდა სერვერის შეცდომა",
-		"error.validation":             "ვალიდაციის შეცდომა",
-		"error.unauthorized":           "არაავტორიზებული",
+		"user.created":                   "მომხმარებელი წარმატებით შეიქმნა",
+		"user.login.success":             "შესვლა წარმატებულია",
+		"user.invalid_credentials":       "არასწორი ელფოსტა ან პაროლი",
+		"user.already_exists":            "ამ ელფოსტით მომხმარებელი უკვე არსებობს",
+		"user.not_found":                 "მომხმარებელი ვერ მოიძებნა",
+		"user.banned":                    "ეს ანგარიში დაბლოკილია",
+		"user.suspended":                 "მომხმარებელი შეჩერდა; მისი სესიები დასრულდა",
+		"user.unsuspended":               "მომხმარებლის შეჩერება გაუქმდა",
+		"user.role_changed":              "როლი განახლდა; მომხმარებელმა ხელახლა უნდა შევიდეს",
+		"user.invalid_role":              "როლი უნდა იყოს manager, moderator ან admin",
+		"user.invalid_status":            "სტატუსი უნდა იყოს active ან suspended",
+		"user.insufficient_role":         "თქვენ ვერ მართავთ იგივე ან უფრო მაღალი როლის მომხმარებელს",
+		"user.invalid_password":          "მიმდინარე პაროლი არასწორია",
+		"user.password_changed":          "პაროლი წარმატებით შეიცვალა; სხვა სესიები დასრულდა",
+		"user.email_changed":             "ელფოსტა წარმატებით შეიცვალა; დაადასტურეთ ახალი მისამართი",
+		"email.changed.subject":          "თქვენი ელფოსტა შეიცვალა",
+		"email.changed.body":             "თქვენი Soccer Manager-ის ანგარიშის ელფოსტა შეიცვალა: {email}.\n\nთუ ეს ცვლილება თქვენ არ განგიხორციელებიათ, დაუყოვნებლივ აღადგინეთ პაროლი.",
+		"user.deletion_scheduled":        "ანგარიში წაშლისთვის დაიგეგმა; გაუქმებისთვის შედით სისტემაში წაშლის თარიღამდე",
+		"user.deletion_pending":          "ანგარიშის წაშლა უკვე დაგეგმილია",
+		"user.deletions_completed":       "დაგეგმილი ანგარიშების წაშლა დასრულდა",
+		"email.deletion.subject":         "თქვენი ანგარიში წაშლისთვის დაიგეგმა",
+		"email.deletion.body":            "თქვენი Soccer Manager-ის ანგარიში და მისი ყველა მონაცემი წაიშლება {date}-ში. თქვენი მოთამაშეები თავისუფალ აგენტებად გადავლენ.\n\nანგარიშის შესანარჩუნებლად უბრალოდ შედით სისტემაში ამ თარიღამდე.",
+		"auth.refreshed":                 "ტოკენი წარმატებით განახლდა",
+		"auth.logged_out":                "გასვლა წარმატებით შესრულდა",
+		"auth.invalid_refresh":           "განახლების ტოკენი არასწორია ან ვადაგასულია",
+		"auth.session_not_found":         "სესია ვერ მოიძებნა",
+		"auth.session_revoked":           "სესია წარმატებით გაუქმდა",
+		"auth.password_reset_sent":       "თუ ამ ელფოსტით ანგარიში არსებობს, პაროლის აღდგენის ტოკენი გაიგზავნა",
+		"auth.password_reset":            "პაროლი წარმატებით შეიცვალა",
+		"auth.email_verified":            "ელფოსტა წარმატებით დადასტურდა",
+		"auth.verification_sent":         "დადასტურების წერილი გაიგზავნა",
+		"auth.already_verified":          "ელფოსტა უკვე დადასტურებულია",
+		"auth.invalid_email_token":       "ტოკენი არასწორია ან ვადაგასულია",
+		"auth.email_not_verified":        "ამ ფუნქციის გამოსაყენებლად დაადასტურეთ ელფოსტა",
+		"auth.two_factor_required":       "შესვლის დასასრულებლად შეიყვანეთ კოდი ავთენტიფიკატორის აპლიკაციიდან",
+		"auth.invalid_challenge":         "შესვლის მცდელობას ვადა გაუვიდა; შედით ხელახლა",
+		"auth.invalid_two_factor_code":   "ორფაქტორიანი კოდი არასწორია",
+		"auth.two_factor_setup":          "დაასკანერეთ კოდი ავთენტიფიკატორის აპლიკაციით და დაადასტურეთ ორფაქტორიანი ავთენტიფიკაციის ჩასართავად",
+		"auth.two_factor_enabled":        "ორფაქტორიანი ავთენტიფიკაცია ჩაირთო",
+		"auth.two_factor_disabled":       "ორფაქტორიანი ავთენტიფიკაცია გამოირთო",
+		"auth.two_factor_active":         "ორფაქტორიანი ავთენტიფიკაცია უკვე ჩართულია",
+		"auth.two_factor_inactive":       "ორფაქტორიანი ავთენტიფიკაცია ჩართული არ არის",
+		"auth.recovery_codes_reset":      "შეიქმნა ახალი აღდგენის კოდები; ძველი კოდები აღარ მოქმედებს",
+		"auth.too_many_attempts":         "შესვლის ძალიან ბევრი მცდელობა. სცადეთ {seconds} წამში",
+		"auth.account_locked":            "შესვლის ძალიან ბევრი წარუმატებელი მცდელობა. შესვლა დაბლოკილია {seconds} წამით",
+		"email.verify.subject":           "დაადასტურეთ ელფოსტა",
+		"email.verify.body":              "კეთილი იყოს თქვენი მობრძანება Soccer Manager-ში!\n\nელფოსტის დასადასტურებლად გახსენით ბმული:\n{link}\n\nბმულს ვადა {hours} საათში გაუვა.",
+		"email.reset.subject":            "პაროლის აღდგენა",
+		"email.reset.body":               "მივიღეთ მოთხოვნა თქვენი Soccer Manager-ის პაროლის აღდგენაზე.\n\nახალი პაროლის დასაყენებლად გამოიყენეთ ტოკენი:\n{token}\n\nტოკენს ვადა {minutes} წუთში გაუვა. თუ მოთხოვნა თქვენ არ გაგიგზავნიათ, უგულებელყავით ეს წერილი.",
+		"team.created":                   "გუნდი წარმატებით შეიქმნა",
+		"team.updated":                   "გუნდი განახლდა",
+		"team.not_found":                 "გუნდი ვერ მოიძებნა",
+		"player.updated":                 "მოთამაშე განახლდა",
+		"player.not_found":               "მოთამაშე ვერ მოიძებნა",
+		"player.not_owned":               "მოთამაშე არ ეკუთვნის თქვენს გუნდს",
+		"player.not_scouted":             "ამ მონაცემების სანახავად მოთამაშე სრულად უნდა დაიზვეროთ",
+		"player.listed":                  "მოთამაშე განთავსდა გადაცემის სიაში",
+		"player.already_listed":          "მოთამაშე უკვე არის გადაცემის სიაში",
+		"player.removed_from_list":       "მოთამაშე წაიშალა გადაცემის სიიდან",
+		"player.not_on_list":             "მოთამაშე არ არის გადაცემის სიაში",
+		"transfer.purchased":             "მოთამაშე წარმატებით შეიძინა",
+		"transfer.insufficient_budget":   "არასაკმარისი ბიუჯეტი",
+		"transfer.team_full":             "გუნდს უკვე აქვს მაქსიმალური რაოდენობის მოთამაშე",
+		"transfer.cannot_buy_own":        "ვერ შეიძენთ საკუთარ მოთამაშეს",
+		"transfer.listing_not_found":     "გადაცემის სია ვერ მოიძებნა",
+		"transfer.not_found":             "ტრანსფერი ვერ მოიძებნა",
+		"transfer.listing_cancelled":     "გადაცემის სია გაუქმდა",
+		"transfer.reversed":              "ტრანსფერი გაუქმდა; მოთამაშე და თანხა დაბრუნდა",
+		"transfer.already_reversed":      "ტრანსფერი უკვე გაუქმებულია",
+		"transfer.not_reversible":        "ტრანსფერის გაუქმება შეუძლებელია, თუ მოთამაშემ მყიდველი გუნდი დატოვა",
+		"lineup.updated":                 "შემადგენლობა განახლდა",
+		"lineup.not_found":               "შემადგენლობა ვერ მოიძებნა",
+		"lineup.invalid":                 "არასწორი შემადგენლობა",
+		"season.not_found":               "სეზონი ვერ მოიძებნა",
+		"season.rolled_over":             "სეზონი წარმატებით დასრულდა",
+		"season.already_completed":       "სეზონი უკვე დასრულებულია",
+		"academy.prospect_promoted":      "ახალგაზრდა მოთამაშე გადაყვანილია ძირითად გუნდში",
+		"academy.prospect_released":      "ახალგაზრდა მოთამაშე გათავისუფლდა აკადემიიდან",
+		"academy.prospect_not_found":     "ახალგაზრდა მოთამაშე ვერ მოიძებნა",
+		"academy.intake_completed":       "აკადემიის მიღება დასრულდა",
+		"training.assigned":              "ვარჯიშის მიმართულება დაინიშნა",
+		"training.removed":               "ვარჯიშის დავალება წაიშალა",
+		"training.not_found":             "ვარჯიშის დავალება ვერ მოიძებნა",
+		"training.invalid":               "ვარჯიშის არასწორი დავალება",
+		"training.completed":             "ვარჯიში დასრულდა",
+		"player.unavailable":             "მოთამაშე დაშავებულია ან დისკვალიფიცირებულია",
+		"player.team_changed":            "მოთამაშემ უკვე შეიცვალა გუნდი, სცადეთ თავიდან",
+		"availability.injured":           "ტრავმა დაფიქსირდა",
+		"availability.suspended":         "დისკვალიფიკაცია დაფიქსირდა",
+		"availability.invalid":           "არყოფნის ხანგრძლივობა დადებითი უნდა იყოს",
+		"availability.recovered":         "გამოჯანმრთელების შემოწმება დასრულდა",
+		"contract.not_found":             "კონტრაქტი ვერ მოიძებნა",
+		"contract.renegotiated":          "კონტრაქტი წარმატებით გადაფორმდა",
+		"contract.invalid":               "კონტრაქტის შეთავაზება უარყოფილია",
+		"contract.payroll_completed":     "ხელფასები გადახდილია",
+		"contract.expiry_completed":      "კონტრაქტების ვადის შემოწმება დასრულდა",
+		"finance.invalid_prize":          "არასწორი პრიზი",
+		"finance.prize_awarded":          "საპრიზო თანხა ჩაირიცხა",
+		"finance.budget_adjusted":        "გუნდის ბიუჯეტი შესწორდა",
+		"sponsorship.not_found":          "სპონსორობა ვერ მოიძებნა",
+		"sponsorship.active":             "გუნდს უკვე აქვს აქტიური სასპონსორო გარიგება",
+		"sponsorship.accepted":           "სასპონსორო გარიგება გაფორმდა",
+		"sponsorship.payments_completed": "სასპონსორო გადახდები დასრულდა",
+		"match.not_found":                "მატჩი ვერ მოიძებნა",
+		"match.invalid":                  "არასწორი მატჩი",
+		"match.already_played":           "მატჩი უკვე ჩატარდა",
+		"match.scheduled":                "მატჩი დაინიშნა",
+		"match.result_recorded":          "მატჩის შედეგი დაფიქსირდა",
+		"match.simulated":                "მატჩის სიმულაცია დასრულდა",
+		"facility.invalid":               "არასწორი ობიექტი",
+		"facility.max_level":             "ობიექტი უკვე მაქსიმალურ დონეზეა",
+		"facility.in_progress":           "ამ ობიექტის განახლება უკვე მიმდინარეობს",
+		"facility.invalid_price":         "ბილეთის ფასი უნდა იყოს 5-დან 200-მდე",
+		"facility.upgrade_started":       "ობიექტის განახლება დაიწყო",
+		"facility.price_updated":         "ბილეთის ფასი განახლდა",
+		"facility.construction_run":      "მშენებლობის შემოწმება დასრულდა",
+		"stats.invalid_leaderboard":      "რეიტინგი უნდა იყოს scorers ან assists, სურვილისამებრ სწორი ტურნირით",
+		"bot.created":                    "ბოტი გუნდი შეიქმნა",
+		"bot.deleted":                    "ბოტი გუნდი წაიშალა",
+		"bot.not_bot":                    "გუნდი არ არის ბოტი",
+		"bot.run_completed":              "ბოტების აქტივობა დასრულდა",
+		"ranking.friendly_arranged":      "ამხანაგური მატჩი დაინიშნა",
+		"ranking.no_opponent":            "ამხანაგური მატჩისთვის მეტოქე ვერ მოიძებნა",
+		"league.created":                 "ლიგა შეიქმნა",
+		"league.joined":                  "ლიგას შეუერთდით",
+		"league.updated":                 "ლიგა განახლდა",
+		"league.left":                    "ლიგა დატოვეთ",
+		"league.member_removed":          "გუნდი ლიგიდან ამოიშალა",
+		"league.disbanded":               "ლიგა დაიშალა",
+		"league.not_found":               "ლიგა ვერ მოიძებნა",
+		"league.already_member":          "გუნდი უკვე ლიგის წევრია",
+		"league.full":                    "ლიგა სავსეა",
+		"league.not_commissioner":        "ამის გაკეთება მხოლოდ კომისარს შეუძლია",
+		"league.commissioner_stays":      "კომისარს ლიგის დატოვება არ შეუძლია",
+		"league.invalid_settings":        "ლიგის ლიმიტები დადებითი უნდა იყოს",
+		"transfer.outside_league":        "თქვენი ლიგა მხოლოდ წევრებს შორის ტრანსფერებს უშვებს",
+		"transfer.league_cap":            "ტრანსფერი ლიგის ლიმიტს არღვევს",
+		"team.not_owned":                 "გუნდი თქვენ არ გეკუთვნით",
+		"team.too_many":                  "თქვენ უკვე მართავთ გუნდების მაქსიმალურ რაოდენობას",
+		"team.default_updated":           "ნაგულისხმევი გუნდი განახლდა",
+		"team.name_taken":                "გუნდის სახელი უკვე დაკავებულია",
+		"team.invalid_country":           "ქვეყანა უნდა იყოს ვალიდური ISO 3166 ქვეყანა",
+		"team.invalid_crest":             "ემბლემის ფერები უნდა იყოს hex ფორმატში, მაგ. #1A2B3C",
+		"draft.not_found":                "ამ გუნდისთვის დრაფტი არ მიმდინარეობს",
+		"draft.invalid_pick":             "დრაფტის არასწორი არჩევანი",
+		"draft.picked":                   "მოთამაშეები წარმატებით შეირჩა",
+		"draft.complete":                 "დრაფტი დასრულდა, თქვენი შემადგენლობა მზადაა",
+		"scout.hired":                    "სკაუტი წარმატებით დაიქირავეს",
+		"scout.assigned":                 "სკაუტს დავალება მიეცა",
+		"scout.unassigned":               "სკაუტი გამოძახებულია",
+		"scout.fired":                    "სკაუტი გათავისუფლდა",
+		"scout.not_found":                "სკაუტი ვერ მოიძებნა",
+		"scout.too_many":                 "გუნდს უკვე ჰყავს სკაუტების მაქსიმალური რაოდენობა",
+		"scout.invalid":                  "სკაუტის არასწორი უნარი ან დავალება",
+		"scout.run_completed":            "სკაუტინგის ციკლი დასრულდა",
+		"error.internal":                 "შიდა სერვერის შეცდომა",
+		"error.validation":               "ვალიდაციის შეცდომა",
+		"error.unauthorized":             "არაავტორიზებული",
+		"error.forbidden":                "აკრძალულია",
 	},
 }
 
@@ -81,6 +355,16 @@ func GetMessage(lang, key string) string {
 }
 
 
+func FormatMessage(lang, key string, params map[string]string) string {
+	msg := GetMessage(lang, key)
+	pairs := make([]string, 0, len(params)*2)
+	for name, value := range params {
+		pairs = append(pairs, "{"+name+"}", value)
+	}
+	return strings.NewReplacer(pairs...).Replace(msg)
+}
+
+
 func normalizeLang(lang string) string {
 	lang = strings.ToLower(strings.TrimSpace(lang))
 	if lang == "" {
@@ -0,0 +1,63 @@
+package namegen
+
+import (
+	"math/rand"
+)
+
+var teamNames = []string{
+	"FC United", "City FC", "Athletic Club", "Sporting FC",
+	"United FC", "City United", "Athletic United", "Sporting Club",
+}
+
+var teamPlaces = []string{
+	"Riverside", "Northgate", "Eastbrook", "Westfield", "Southport", "Kingsbridge",
+	"Ashford", "Redhill", "Oakwood", "Stonebridge", "Marston", "Fairhaven",
+	"Harrow Vale", "Lakeside", "Brookmere", "Castleton", "Greenock", "Highbury",
+}
+
+var firstNames = []string{
+	"John", "James", "Michael", "David", "Robert", "William", "Richard", "Joseph",
+	"Thomas", "Charles", "Christopher", "Daniel", "Matthew", "Anthony", "Mark",
+	"Donald", "Steven", "Paul", "Andrew", "Joshua", "Kenneth", "Kevin", "Brian",
+}
+
+var lastNames = []string{
+	"Smith", "Johnson", "Williams", "Brown", "Jones", "Garcia", "Miller", "Davis",
+	"Rodriguez", "Martinez", "Hernandez", "Lopez", "Wilson", "Anderson", "Thomas",
+	"Taylor", "Moore", "Jackson", "Martin", "Lee", "Thompson", "White", "Harris",
+}
+
+var countries = []string{
+	"Brazil", "Argentina", "Spain", "Germany", "France", "Italy", "England",
+	"Portugal", "Netherlands", "Belgium", "Croatia", "Uruguay", "Colombia",
+	"Mexico", "Chile", "Poland", "Denmark", "Sweden", "Norway", "Greece",
+}
+
+var sponsorNames = []string{
+	"Apex Energy", "Northwind Airlines", "Bluecrest Bank", "Vertex Motors",
+	"Solaris Telecom", "Ironclad Insurance", "Evergreen Foods", "Pulse Sportswear",
+}
+
+func TeamName() string {
+	return teamPlaces[rand.Intn(len(teamPlaces))] + " " + teamNames[rand.Intn(len(teamNames))]
+}
+
+func FirstName() string {
+	return firstNames[rand.Intn(len(firstNames))]
+}
+
+func LastName() string {
+	return lastNames[rand.Intn(len(lastNames))]
+}
+
+func Country() string {
+	return countries[rand.Intn(len(countries))]
+}
+
+func SponsorNames(n int) []string {
+	names := make([]string, 0, n)
+	for _, i := range rand.Perm(len(sponsorNames))[:n] {
+		names = append(names, sponsorNames[i])
+	}
+	return names
+}
@@ -0,0 +1,88 @@
+package totp
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const rfc6238Secret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestCodeMatchesRFC6238Vectors(t *testing.T) {
+	tests := []struct {
+		unix int64
+		code string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+		{20000000000, "353130"},
+	}
+
+	for _, tt := range tests {
+		code, err := Code(rfc6238Secret, Step(time.Unix(tt.unix, 0)))
+		assert.NoError(t, err)
+		assert.Equal(t, tt.code, code, "unix time %d", tt.unix)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+	current := Step(now)
+	code := func(step int64) string {
+		value, _ := Code(rfc6238Secret, step)
+		return value
+	}
+
+	tests := []struct {
+		name   string
+		secret string
+		code   string
+		step   int64
+		valid  bool
+	}{
+		{"current step", rfc6238Secret, code(current), current, true},
+		{"previous step within skew", rfc6238Secret, code(current - 1), current - 1, true},
+		{"next step within skew", rfc6238Secret, code(current + 1), current + 1, true},
+		{"outside skew", rfc6238Secret, code(current - 2), 0, false},
+		{"spaces are ignored", rfc6238Secret, code(current)[:3] + " " + code(current)[3:], current, true},
+		{"lowercase secret", strings.ToLower(rfc6238Secret), code(current), current, true},
+		{"wrong length", rfc6238Secret, "12345", 0, false},
+		{"wrong code", rfc6238Secret, "000000", 0, false},
+		{"invalid secret", "not base32!", code(current), 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			step, ok := Validate(tt.secret, tt.code, now)
+			assert.Equal(t, tt.valid, ok)
+			assert.Equal(t, tt.step, step)
+		})
+	}
+}
+
+func TestGenerateSecret(t *testing.T) {
+	first, err := GenerateSecret()
+	assert.NoError(t, err)
+	second, err := GenerateSecret()
+	assert.NoError(t, err)
+
+	assert.NotEqual(t, first, second)
+	raw, err := encoding.DecodeString(first)
+	assert.NoError(t, err)
+	assert.Len(t, raw, SecretBytes)
+}
+
+func TestURI(t *testing.T) {
+	uri := URI("Soccer Manager", "user@example.com", rfc6238Secret)
+
+	assert.True(t, strings.HasPrefix(uri, "otpauth://totp/Soccer%20Manager:user@example.com?"))
+	assert.Contains(t, uri, "secret="+rfc6238Secret)
+	assert.Contains(t, uri, "issuer=Soccer%20Manager")
+	assert.Contains(t, uri, "digits=6")
+	assert.Contains(t, uri, "period=30")
+}
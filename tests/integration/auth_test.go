@@ -6,15 +6,39 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
+	"soccer-manager-api/internal/app/academy"
+	"soccer-manager-api/internal/app/account"
+	"soccer-manager-api/internal/app/admin"
 	"soccer-manager-api/internal/app/auth"
+	"soccer-manager-api/internal/app/availability"
+	"soccer-manager-api/internal/app/bot"
+	"soccer-manager-api/internal/app/contract"
+	"soccer-manager-api/internal/app/draft"
+	"soccer-manager-api/internal/app/facility"
+	"soccer-manager-api/internal/app/finance"
+	"soccer-manager-api/internal/app/league"
+	"soccer-manager-api/internal/app/lineup"
+	"soccer-manager-api/internal/app/match"
+	"soccer-manager-api/internal/app/morale"
 	"soccer-manager-api/internal/app/player"
+	"soccer-manager-api/internal/app/ranking"
+	"soccer-manager-api/internal/app/scouting"
+	"soccer-manager-api/internal/app/season"
+	"soccer-manager-api/internal/app/stats"
 	"soccer-manager-api/internal/app/team"
+	"soccer-manager-api/internal/app/training"
 	"soccer-manager-api/internal/app/transfer"
+	"soccer-manager-api/internal/domain"
+	infraCache "soccer-manager-api/internal/infrastructure/cache"
+	memoryCache "soccer-manager-api/internal/infrastructure/cache/memory"
 	redisCache "soccer-manager-api/internal/infrastructure/cache/redis"
 	"soccer-manager-api/internal/infrastructure/config"
+	fileMailer "soccer-manager-api/internal/infrastructure/mailer/file"
 	"soccer-manager-api/internal/infrastructure/persistence/postgres"
 	httpTransport "soccer-manager-api/internal/infrastructure/transport/http"
+	"soccer-manager-api/pkg/jwt"
 	"soccer-manager-api/tests/testutil"
 
 	"github.com/gin-gonic/gin"
@@ -23,6 +47,8 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
+const testAdminKey = "test-admin-key"
+
 func setupTestServer(t *testing.T) (*httptest.Server, func()) {
 
 	db, err := testutil.SetupTestDB()
@@ -42,6 +68,28 @@ func setupTestServer(t *testing.T) (*httptest.Server, func()) {
 	teamRepo := postgres.NewTeamRepository(sqlxDB)
 	playerRepo := postgres.NewPlayerRepository(sqlxDB)
 	transferRepo := postgres.NewTransferRepository(sqlxDB)
+	lineupRepo := postgres.NewLineupRepository(sqlxDB)
+	seasonRepo := postgres.NewSeasonRepository(sqlxDB)
+	academyRepo := postgres.NewAcademyRepository(sqlxDB)
+	trainingRepo := postgres.NewTrainingRepository(sqlxDB)
+	absenceRepo := postgres.NewAbsenceRepository(sqlxDB)
+	contractRepo := postgres.NewContractRepository(sqlxDB)
+	financeRepo := postgres.NewFinanceRepository(sqlxDB)
+	sponsorshipRepo := postgres.NewSponsorshipRepository(sqlxDB)
+	matchRepo := postgres.NewMatchRepository(sqlxDB)
+	facilityRepo := postgres.NewFacilityRepository(sqlxDB)
+	statsRepo := postgres.NewStatsRepository(sqlxDB)
+	moraleRepo := postgres.NewMoraleRepository(sqlxDB)
+	ratingRepo := postgres.NewRatingRepository(sqlxDB)
+	leagueRepo := postgres.NewLeagueRepository(sqlxDB)
+	draftRepo := postgres.NewDraftRepository(sqlxDB)
+	scoutingRepo := postgres.NewScoutingRepository(sqlxDB)
+	sessionRepo := postgres.NewSessionRepository(sqlxDB)
+	emailTokenRepo := postgres.NewEmailTokenRepository(sqlxDB)
+	auditRepo := postgres.NewAuditRepository(sqlxDB)
+	accountRepo := postgres.NewAccountRepository(sqlxDB)
+	recoveryCodeRepo := postgres.NewRecoveryCodeRepository(sqlxDB)
+	transactor := postgres.NewTransactor(sqlxDB)
 
 
 	cache := redisCache.NewRedisCache(rdb)
@@ -49,25 +97,64 @@ func setupTestServer(t *testing.T) (*httptest.Server, func()) {
 
 	cfg := &config.Config{
 		JWT: config.JWTConfig{
-			Secret:          "test-secret",
-			ExpirationHours: 24,
+			Secret:             "test-secret",
+			AccessTokenMinutes: 15,
+			RefreshTokenDays:   30,
+			SessionMaxDays:     90,
+			RevocationStore:    "memory",
 		},
 		App: config.AppConfig{
 			Environment: "test",
 		},
+		Admin: config.AdminConfig{
+			APIKey: testAdminKey,
+		},
 	}
 
+	jwtKeys := jwt.NewHMACKeySet(cfg.JWT.Secret)
+	revocations := infraCache.NewRevocationStore(memoryCache.NewMemoryCache(), time.Duration(cfg.JWT.AccessTokenMinutes)*time.Minute)
+	challenges := infraCache.NewChallengeStore(memoryCache.NewMemoryCache(), domain.TwoFactorChallengeTTL)
+	loginThrottle := infraCache.NewLoginThrottle(memoryCache.NewMemoryCache(), 5, 20, 15*time.Minute)
+
 	authUseCase := auth.NewAuthUseCase(
 		userRepo,
 		teamRepo,
 		playerRepo,
-		cfg.JWT.Secret,
-		cfg.JWT.ExpirationHours,
+		contractRepo,
+		draftRepo,
+		sessionRepo,
+		recoveryCodeRepo,
+		transactor,
+		revocations,
+		challenges,
+		loginThrottle,
+		jwtKeys,
+		cfg.JWT.AccessTokenMinutes,
+		cfg.JWT.RefreshTokenDays,
+		cfg.JWT.SessionMaxDays,
 	)
 
-	teamUseCase := team.NewTeamUseCase(teamRepo, playerRepo, cache)
-	playerUseCase := player.NewPlayerUseCase(playerRepo, teamRepo, cache)
-	transferUseCase := transfer.NewTransferUseCase(transferRepo, teamRepo, playerRepo, cache)
+	accountUseCase := account.NewAccountUseCase(userRepo, emailTokenRepo, auditRepo, accountRepo, recoveryCodeRepo, teamRepo, transactor, authUseCase, fileMailer.NewFileMailer(""), cache, "http://localhost:8080", 14)
+	adminUseCase := admin.NewAdminUseCase(userRepo, teamRepo, playerRepo, transferRepo, lineupRepo, contractRepo, financeRepo, auditRepo, transactor, authUseCase, cache)
+	teamUseCase := team.NewTeamUseCase(teamRepo, playerRepo, authUseCase, cache)
+	scoutingUseCase := scouting.NewScoutingUseCase(scoutingRepo, teamRepo, playerRepo, financeRepo, transactor, cache)
+	playerUseCase := player.NewPlayerUseCase(playerRepo, teamRepo, statsRepo, scoutingUseCase, cache)
+	moraleUseCase := morale.NewMoraleUseCase(moraleRepo, playerRepo, teamRepo, transferRepo, cache)
+	transferUseCase := transfer.NewTransferUseCase(transferRepo, teamRepo, playerRepo, lineupRepo, contractRepo, leagueRepo, financeRepo, transactor, moraleUseCase, scoutingUseCase, cache)
+	lineupUseCase := lineup.NewLineupUseCase(lineupRepo, teamRepo, playerRepo, cache)
+	seasonUseCase := season.NewSeasonUseCase(seasonRepo, playerRepo, transferRepo, lineupRepo, contractRepo, transactor, scoutingUseCase, cache)
+	academyUseCase := academy.NewAcademyUseCase(academyRepo, teamRepo, playerRepo, contractRepo, transactor, cache)
+	trainingUseCase := training.NewTrainingUseCase(trainingRepo, teamRepo, playerRepo, facilityRepo, scoutingUseCase, cache)
+	availabilityUseCase := availability.NewAvailabilityUseCase(absenceRepo, playerRepo, lineupRepo, facilityRepo, cache)
+	contractUseCase := contract.NewContractUseCase(contractRepo, financeRepo, teamRepo, playerRepo, transferRepo, lineupRepo, transactor, moraleUseCase, scoutingUseCase, cache)
+	financeUseCase := finance.NewFinanceUseCase(financeRepo, sponsorshipRepo, matchRepo, contractRepo, facilityRepo, teamRepo, transactor, cache)
+	statsUseCase := stats.NewStatsUseCase(statsRepo, matchRepo, seasonRepo, lineupRepo, teamRepo, playerRepo, cache)
+	rankingUseCase := ranking.NewRankingUseCase(teamRepo, ratingRepo, matchRepo, transactor, cache)
+	leagueUseCase := league.NewLeagueUseCase(leagueRepo, teamRepo, cache)
+	draftUseCase := draft.NewDraftUseCase(draftRepo, teamRepo, playerRepo, contractRepo, transactor, cache)
+	matchUseCase := match.NewMatchUseCase(matchRepo, teamRepo, facilityRepo, transactor, financeUseCase, availabilityUseCase, statsUseCase, moraleUseCase, rankingUseCase)
+	facilityUseCase := facility.NewFacilityUseCase(facilityRepo, academyRepo, financeRepo, teamRepo, transactor, cache)
+	botUseCase := bot.NewBotUseCase(userRepo, teamRepo, playerRepo, transferRepo, lineupRepo, contractRepo, matchRepo, transactor, authUseCase, lineupUseCase, transferUseCase, cache)
 
 
 	gin.SetMode(gin.TestMode)
@@ -77,6 +164,26 @@ func setupTestServer(t *testing.T) (*httptest.Server, func()) {
 		teamUseCase,
 		playerUseCase,
 		transferUseCase,
+		lineupUseCase,
+		seasonUseCase,
+		academyUseCase,
+		trainingUseCase,
+		availabilityUseCase,
+		contractUseCase,
+		financeUseCase,
+		matchUseCase,
+		facilityUseCase,
+		statsUseCase,
+		botUseCase,
+		moraleUseCase,
+		rankingUseCase,
+		leagueUseCase,
+		draftUseCase,
+		scoutingUseCase,
+		revocations,
+		jwtKeys,
+		accountUseCase,
+		adminUseCase,
 	)
 
 	server := httptest.NewServer(router)
@@ -0,0 +1,106 @@
+package integration
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"soccer-manager-api/tests/testutil"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type apiResponse struct {
+	Success bool            `json:"success"`
+	Data    json.RawMessage `json:"data"`
+	Errors  []string        `json:"errors"`
+}
+
+func uniqueEmail(prefix string) string {
+	return prefix + "-" + uuid.NewString()[:8] + "@example.com"
+}
+
+func doRequest(t *testing.T, method, url, token string, body interface{}, headers map[string]string) (int, apiResponse) {
+	var reader *bytes.Reader
+	if body != nil {
+		jsonBody, err := json.Marshal(body)
+		assert.NoError(t, err)
+		reader = bytes.NewReader(jsonBody)
+	} else {
+		reader = bytes.NewReader(nil)
+	}
+
+	req, err := http.NewRequest(method, url, reader)
+	assert.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+
+	var result apiResponse
+	json.NewDecoder(resp.Body).Decode(&result)
+	return resp.StatusCode, result
+}
+
+func decodeData(t *testing.T, result apiResponse, target interface{}) {
+	assert.NoError(t, json.Unmarshal(result.Data, target))
+}
+
+func registerUser(t *testing.T, serverURL, email string, draft bool) string {
+	status, result := doRequest(t, "POST", serverURL+"/api/v1/auth/register", "", map[string]interface{}{
+		"email":    email,
+		"password": "password123",
+		"draft":    draft,
+	}, nil)
+	assert.Equal(t, http.StatusCreated, status)
+
+	var auth struct {
+		Token string `json:"token"`
+	}
+	decodeData(t, result, &auth)
+	return auth.Token
+}
+
+func verifyEmail(t *testing.T, email string) {
+	db, err := testutil.SetupTestDB()
+	assert.NoError(t, err)
+	defer testutil.CleanupTestDB(db)
+
+	_, err = db.Exec(`UPDATE users SET email_verified_at = NOW() WHERE email = $1`, email)
+	assert.NoError(t, err)
+}
+
+func teamBudget(t *testing.T, serverURL, token string) float64 {
+	status, result := doRequest(t, "GET", serverURL+"/api/v1/teams/me", token, nil, nil)
+	assert.Equal(t, http.StatusOK, status)
+
+	var team struct {
+		Budget float64 `json:"budget"`
+	}
+	decodeData(t, result, &team)
+	return team.Budget
+}
+
+type squadPlayer struct {
+	ID          string  `json:"id"`
+	Position    string  `json:"position"`
+	MarketValue float64 `json:"market_value"`
+}
+
+func teamPlayers(t *testing.T, serverURL, token string) []squadPlayer {
+	status, result := doRequest(t, "GET", serverURL+"/api/v1/teams/me/players", token, nil, nil)
+	assert.Equal(t, http.StatusOK, status)
+
+	var players []squadPlayer
+	decodeData(t, result, &players)
+	return players
+}
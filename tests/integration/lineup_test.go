@@ -0,0 +1,145 @@
+package integration
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func registerAndGetToken(t *testing.T, serverURL, email string) string {
+	reqBody := map[string]string{
+		"email":    email,
+		"password": "password123",
+	}
+	jsonBody, _ := json.Marshal(reqBody)
+
+	req, _ := http.NewRequest("POST", serverURL+"/api/v1/auth/register", bytes.NewBuffer(jsonBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+
+	var result struct {
+		Data struct {
+			Token string `json:"token"`
+		} `json:"data"`
+	}
+	json.NewDecoder(resp.Body).Decode(&result)
+	return result.Data.Token
+}
+
+func TestUpdateLineup(t *testing.T) {
+	server, cleanup := setupTestServer(t)
+	defer cleanup()
+
+	token := registerAndGetToken(t, server.URL, "lineup@example.com")
+
+	req, _ := http.NewRequest("GET", server.URL+"/api/v1/teams/me/players", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	resp, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+
+	var players struct {
+		Data []struct {
+			ID       string `json:"id"`
+			Position string `json:"position"`
+		} `json:"data"`
+	}
+	json.NewDecoder(resp.Body).Decode(&players)
+	resp.Body.Close()
+
+
+	needed := map[string]int{"goalkeeper": 1, "defender": 4, "midfielder": 4, "attacker": 2}
+	starters := make([]string, 0, 11)
+	substitutes := make([]string, 0, 7)
+	for _, p := range players.Data {
+		if needed[p.Position] > 0 {
+			needed[p.Position]--
+			starters = append(starters, p.ID)
+		} else if len(substitutes) < 7 {
+			substitutes = append(substitutes, p.ID)
+		}
+	}
+
+	jsonBody, _ := json.Marshal(map[string]interface{}{
+		"formation":   "4-4-2",
+		"starters":    starters,
+		"substitutes": substitutes,
+	})
+	req, _ = http.NewRequest("PUT", server.URL+"/api/v1/teams/me/lineup", bytes.NewBuffer(jsonBody))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token)
+	resp, err = http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	resp.Body.Close()
+
+
+	jsonBody, _ = json.Marshal(map[string]interface{}{
+		"formation": "4-3-3",
+		"starters":  starters,
+	})
+	req, _ = http.NewRequest("PUT", server.URL+"/api/v1/teams/me/lineup", bytes.NewBuffer(jsonBody))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token)
+	resp, err = http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	resp.Body.Close()
+}
+
+func TestSoldPlayerLeavesLineup(t *testing.T) {
+	server, cleanup := setupTestServer(t)
+	defer cleanup()
+
+	sellerEmail := uniqueEmail("lineup-seller")
+	sellerToken := registerUser(t, server.URL, sellerEmail, false)
+	verifyEmail(t, sellerEmail)
+	buyerEmail := uniqueEmail("lineup-buyer")
+	buyerToken := registerUser(t, server.URL, buyerEmail, true)
+	verifyEmail(t, buyerEmail)
+
+
+	needed := map[string]int{"goalkeeper": 1, "defender": 4, "midfielder": 4, "attacker": 2}
+	starters := make([]string, 0, 11)
+	for _, p := range teamPlayers(t, server.URL, sellerToken) {
+		if needed[p.Position] > 0 {
+			needed[p.Position]--
+			starters = append(starters, p.ID)
+		}
+	}
+	status, _ := doRequest(t, "PUT", server.URL+"/api/v1/teams/me/lineup", sellerToken, map[string]interface{}{
+		"formation": "4-4-2",
+		"starters":  starters,
+	}, nil)
+	assert.Equal(t, http.StatusOK, status)
+
+
+	playerID := starters[0]
+	status, result := doRequest(t, "POST", server.URL+"/api/v1/players/"+playerID+"/transfer-list", sellerToken, map[string]interface{}{
+		"asking_price": 1000000,
+	}, nil)
+	assert.Equal(t, http.StatusCreated, status)
+	var listing struct {
+		ID string `json:"id"`
+	}
+	decodeData(t, result, &listing)
+
+	status, _ = doRequest(t, "POST", server.URL+"/api/v1/transfer-list/"+listing.ID+"/buy", buyerToken, nil, nil)
+	assert.Equal(t, http.StatusOK, status)
+
+
+	status, result = doRequest(t, "GET", server.URL+"/api/v1/teams/me/lineup", sellerToken, nil, nil)
+	assert.Equal(t, http.StatusOK, status)
+	var lineup struct {
+		Starters []string `json:"starters"`
+		Valid    bool     `json:"valid"`
+	}
+	decodeData(t, result, &lineup)
+	assert.NotContains(t, lineup.Starters, playerID)
+	assert.False(t, lineup.Valid)
+}
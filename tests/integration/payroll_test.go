@@ -0,0 +1,49 @@
+package integration
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPayrollChargesOncePerPeriod(t *testing.T) {
+	server, cleanup := setupTestServer(t)
+	defer cleanup()
+
+	token := registerUser(t, server.URL, uniqueEmail("payroll"), false)
+	adminHeaders := map[string]string{"X-Admin-Key": testAdminKey}
+
+
+	status, _ := doRequest(t, "POST", server.URL+"/api/v1/admin/payroll/run", "", nil, adminHeaders)
+	assert.Equal(t, http.StatusOK, status)
+	budget := teamBudget(t, server.URL, token)
+
+	status, _ = doRequest(t, "POST", server.URL+"/api/v1/admin/payroll/run", "", nil, adminHeaders)
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, budget, teamBudget(t, server.URL, token))
+
+
+	status, result := doRequest(t, "GET", server.URL+"/api/v1/teams/me/finances", token, nil, nil)
+	assert.Equal(t, http.StatusOK, status)
+	var transactions []struct {
+		Category string  `json:"category"`
+		Amount   float64 `json:"amount"`
+	}
+	decodeData(t, result, &transactions)
+	wages := 0
+	for _, transaction := range transactions {
+		if transaction.Category == "wages" {
+			wages++
+		}
+	}
+	assert.Equal(t, 1, wages)
+}
+
+func TestPayrollRequiresAdminKey(t *testing.T) {
+	server, cleanup := setupTestServer(t)
+	defer cleanup()
+
+	status, _ := doRequest(t, "POST", server.URL+"/api/v1/admin/payroll/run", "", nil, map[string]string{"X-Admin-Key": "wrong"})
+	assert.Equal(t, http.StatusForbidden, status)
+}
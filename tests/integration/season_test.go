@@ -0,0 +1,113 @@
+package integration
+
+import (
+	"net/http"
+	"testing"
+
+	"soccer-manager-api/tests/testutil"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func teamID(t *testing.T, serverURL, token string) string {
+	status, result := doRequest(t, "GET", serverURL+"/api/v1/teams/me", token, nil, nil)
+	assert.Equal(t, http.StatusOK, status)
+
+	var team struct {
+		ID string `json:"id"`
+	}
+	decodeData(t, result, &team)
+	return team.ID
+}
+
+func TestSeasonRollover(t *testing.T) {
+	server, cleanup := setupTestServer(t)
+	defer cleanup()
+
+	db, err := testutil.SetupTestDB()
+	assert.NoError(t, err)
+	defer testutil.CleanupTestDB(db)
+
+	email := uniqueEmail("rollover")
+	token := registerUser(t, server.URL, email, false)
+	verifyEmail(t, email)
+	opponentToken := registerUser(t, server.URL, uniqueEmail("rollover-opponent"), false)
+
+	players := teamPlayers(t, server.URL, token)
+	assert.True(t, len(players) >= 2)
+	veteranID, agingID := players[0].ID, players[1].ID
+
+
+	_, err = db.Exec(`UPDATE players SET age = 40 WHERE id = $1`, veteranID)
+	assert.NoError(t, err)
+	_, err = db.Exec(`UPDATE players SET age = 31 WHERE id = $1`, agingID)
+	assert.NoError(t, err)
+	var agingValue float64
+	assert.NoError(t, db.QueryRow(`SELECT market_value FROM players WHERE id = $1`, agingID).Scan(&agingValue))
+
+	status, result := doRequest(t, "POST", server.URL+"/api/v1/players/"+veteranID+"/transfer-list", token, map[string]interface{}{
+		"asking_price": 1000000,
+	}, nil)
+	assert.Equal(t, http.StatusCreated, status)
+	var listing struct {
+		ID string `json:"id"`
+	}
+	decodeData(t, result, &listing)
+
+
+	var seasonID string
+	assert.NoError(t, db.QueryRow(`SELECT id FROM seasons WHERE status = 'active'`).Scan(&seasonID))
+	var matchID string
+	assert.NoError(t, db.QueryRow(`
+		INSERT INTO matches (home_team_id, away_team_id, competition, status, scheduled_at, home_goals, played_at)
+		VALUES ($1, $2, 'friendly', 'played', NOW(), 2, NOW())
+		RETURNING id
+	`, teamID(t, server.URL, token), teamID(t, server.URL, opponentToken)).Scan(&matchID))
+	_, err = db.Exec(`
+		INSERT INTO player_match_stats (match_id, player_id, team_id, season_id, competition, goals, rating)
+		SELECT $1, p.id, p.team_id, $2, 'friendly', 2, 8.5 FROM players p WHERE p.id = $3
+	`, matchID, seasonID, agingID)
+	assert.NoError(t, err)
+
+
+	status, result = doRequest(t, "POST", server.URL+"/api/v1/admin/seasons/rollover", "", nil, map[string]string{"X-Admin-Key": testAdminKey})
+	assert.Equal(t, http.StatusOK, status)
+	var rollover struct {
+		CompletedSeason struct {
+			ID string `json:"id"`
+		} `json:"completed_season"`
+		PlayersRetired int `json:"players_retired"`
+		StatsArchived  int `json:"stats_archived"`
+	}
+	decodeData(t, result, &rollover)
+	assert.Equal(t, seasonID, rollover.CompletedSeason.ID)
+	assert.GreaterOrEqual(t, rollover.PlayersRetired, 1)
+	assert.GreaterOrEqual(t, rollover.StatsArchived, 1)
+
+
+	var retired bool
+	assert.NoError(t, db.QueryRow(`SELECT team_id IS NULL AND retired_at IS NOT NULL FROM players WHERE id = $1`, veteranID).Scan(&retired))
+	assert.True(t, retired)
+	var listingStatus string
+	assert.NoError(t, db.QueryRow(`SELECT status FROM transfer_listings WHERE id = $1`, listing.ID).Scan(&listingStatus))
+	assert.Equal(t, "cancelled", listingStatus)
+	for _, player := range teamPlayers(t, server.URL, token) {
+		assert.NotEqual(t, veteranID, player.ID)
+	}
+
+
+	var age int
+	var value float64
+	assert.NoError(t, db.QueryRow(`SELECT age, market_value FROM players WHERE id = $1`, agingID).Scan(&age, &value))
+	assert.Equal(t, 32, age)
+	assert.InDelta(t, agingValue*0.80, value, 0.01)
+
+
+	var goals, appearances int
+	assert.NoError(t, db.QueryRow(`
+		SELECT goals, appearances FROM season_player_stats
+		WHERE season_id = $1 AND player_id = $2 AND competition = 'friendly'
+	`, seasonID, agingID).Scan(&goals, &appearances))
+	assert.Equal(t, 2, goals)
+	assert.Equal(t, 1, appearances)
+}
@@ -0,0 +1,90 @@
+package integration
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuyListedPlayer(t *testing.T) {
+	server, cleanup := setupTestServer(t)
+	defer cleanup()
+
+
+	sellerEmail := uniqueEmail("seller")
+	sellerToken := registerUser(t, server.URL, sellerEmail, false)
+	verifyEmail(t, sellerEmail)
+	buyerEmail := uniqueEmail("buyer")
+	buyerToken := registerUser(t, server.URL, buyerEmail, true)
+	verifyEmail(t, buyerEmail)
+
+	sellerPlayers := teamPlayers(t, server.URL, sellerToken)
+	assert.NotEmpty(t, sellerPlayers)
+	playerID := sellerPlayers[0].ID
+	sellerBudget := teamBudget(t, server.URL, sellerToken)
+	buyerBudget := teamBudget(t, server.URL, buyerToken)
+
+
+	const askingPrice = 1000000.0
+	status, _ := doRequest(t, "POST", server.URL+"/api/v1/players/"+playerID+"/transfer-list", sellerToken, map[string]interface{}{
+		"asking_price": askingPrice,
+	}, nil)
+	assert.Equal(t, http.StatusCreated, status)
+
+	status, _ = doRequest(t, "POST", server.URL+"/api/v1/players/"+playerID+"/transfer-list", sellerToken, map[string]interface{}{
+		"asking_price": askingPrice,
+	}, nil)
+	assert.Equal(t, http.StatusConflict, status)
+
+
+	status, result := doRequest(t, "GET", server.URL+"/api/v1/transfer-list", buyerToken, nil, nil)
+	assert.Equal(t, http.StatusOK, status)
+	var listings []struct {
+		ID       string `json:"id"`
+		PlayerID string `json:"player_id"`
+	}
+	decodeData(t, result, &listings)
+	listingID := ""
+	for _, listing := range listings {
+		if listing.PlayerID == playerID {
+			listingID = listing.ID
+		}
+	}
+	assert.NotEmpty(t, listingID)
+
+
+	status, _ = doRequest(t, "POST", server.URL+"/api/v1/transfer-list/"+listingID+"/buy", buyerToken, nil, nil)
+	assert.Equal(t, http.StatusOK, status)
+
+	status, _ = doRequest(t, "POST", server.URL+"/api/v1/transfer-list/"+listingID+"/buy", buyerToken, nil, nil)
+	assert.NotEqual(t, http.StatusOK, status)
+
+
+	bought := false
+	for _, player := range teamPlayers(t, server.URL, buyerToken) {
+		if player.ID == playerID {
+			bought = true
+		}
+	}
+	assert.True(t, bought)
+	for _, player := range teamPlayers(t, server.URL, sellerToken) {
+		assert.NotEqual(t, playerID, player.ID)
+	}
+	assert.InDelta(t, buyerBudget-askingPrice, teamBudget(t, server.URL, buyerToken), 0.01)
+	assert.InDelta(t, sellerBudget+askingPrice, teamBudget(t, server.URL, sellerToken), 0.01)
+}
+
+func TestListingRequiresVerifiedEmail(t *testing.T) {
+	server, cleanup := setupTestServer(t)
+	defer cleanup()
+
+	token := registerUser(t, server.URL, uniqueEmail("unverified"), false)
+	players := teamPlayers(t, server.URL, token)
+	assert.NotEmpty(t, players)
+
+	status, _ := doRequest(t, "POST", server.URL+"/api/v1/players/"+players[0].ID+"/transfer-list", token, map[string]interface{}{
+		"asking_price": 1000000,
+	}, nil)
+	assert.Equal(t, http.StatusForbidden, status)
+}
@@ -0,0 +1,100 @@
+package integration
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"soccer-manager-api/pkg/totp"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func enableTwoFactor(t *testing.T, serverURL, email string) string {
+	token := registerUser(t, serverURL, email, false)
+	verifyEmail(t, email)
+
+	status, result := doRequest(t, "POST", serverURL+"/api/v1/users/me/2fa", token, map[string]string{
+		"current_password": "password123",
+	}, nil)
+	assert.Equal(t, http.StatusOK, status)
+	var setup struct {
+		Secret string `json:"secret"`
+	}
+	decodeData(t, result, &setup)
+
+	code, err := totp.Code(setup.Secret, totp.Step(time.Now()))
+	assert.NoError(t, err)
+	status, _ = doRequest(t, "POST", serverURL+"/api/v1/users/me/2fa/confirm", token, map[string]string{
+		"code": code,
+	}, nil)
+	assert.Equal(t, http.StatusOK, status)
+	return setup.Secret
+}
+
+func loginChallenge(t *testing.T, serverURL, email string) string {
+	status, result := doRequest(t, "POST", serverURL+"/api/v1/auth/login", "", map[string]string{
+		"email":    email,
+		"password": "password123",
+	}, nil)
+	assert.Equal(t, http.StatusOK, status)
+
+	var login struct {
+		TwoFactorRequired bool `json:"two_factor_required"`
+		Challenge         struct {
+			ChallengeToken string `json:"challenge_token"`
+		} `json:"challenge"`
+	}
+	decodeData(t, result, &login)
+	assert.True(t, login.TwoFactorRequired)
+	return login.Challenge.ChallengeToken
+}
+
+func verifyChallenge(t *testing.T, serverURL, challengeToken, code string) int {
+	status, _ := doRequest(t, "POST", serverURL+"/api/v1/auth/2fa/verify", "", map[string]string{
+		"challenge_token": challengeToken,
+		"code":            code,
+	}, nil)
+	return status
+}
+
+func TestTwoFactorLogin(t *testing.T) {
+	server, cleanup := setupTestServer(t)
+	defer cleanup()
+
+	email := uniqueEmail("twofactor")
+	secret := enableTwoFactor(t, server.URL, email)
+	challengeToken := loginChallenge(t, server.URL, email)
+
+
+	assert.Equal(t, http.StatusUnauthorized, verifyChallenge(t, server.URL, challengeToken, "000000"))
+	time.Sleep(2 * time.Second)
+
+	usedCode, err := totp.Code(secret, totp.Step(time.Now()))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, verifyChallenge(t, server.URL, challengeToken, usedCode))
+	time.Sleep(3 * time.Second)
+
+
+	nextCode, err := totp.Code(secret, totp.Step(time.Now())+1)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, verifyChallenge(t, server.URL, challengeToken, nextCode))
+}
+
+func TestTwoFactorFailuresThrottleLogin(t *testing.T) {
+	server, cleanup := setupTestServer(t)
+	defer cleanup()
+
+	email := uniqueEmail("twofactor-throttle")
+	enableTwoFactor(t, server.URL, email)
+	challengeToken := loginChallenge(t, server.URL, email)
+
+	assert.Equal(t, http.StatusUnauthorized, verifyChallenge(t, server.URL, challengeToken, "000000"))
+	assert.Contains(t, []int{http.StatusTooManyRequests, http.StatusLocked}, verifyChallenge(t, server.URL, challengeToken, "000000"))
+
+	status, _ := doRequest(t, "POST", server.URL+"/api/v1/auth/login", "", map[string]string{
+		"email":    email,
+		"password": "password123",
+	}, nil)
+	assert.Contains(t, []int{http.StatusTooManyRequests, http.StatusLocked}, status)
+}